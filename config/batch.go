@@ -0,0 +1,23 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+)
+
+const defaultMaxBatchSize = 100
+
+func NewMaxBatchSize() (int, error) {
+	value := os.Getenv("MAX_BATCH_SIZE")
+	if value == "" {
+		return defaultMaxBatchSize, nil
+	}
+
+	size, err := strconv.Atoi(value)
+	if err != nil || size < 1 {
+		return 0, fmt.Errorf("MAX_BATCH_SIZE must be a positive integer, got %q", value)
+	}
+
+	return size, nil
+}
@@ -0,0 +1,31 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"time"
+)
+
+const defaultRefreshInterval = time.Hour
+
+func NewSuggestRefreshInterval() (time.Duration, error) {
+	return newRefreshInterval("SUGGEST_REFRESH_INTERVAL")
+}
+
+func NewLocateRefreshInterval() (time.Duration, error) {
+	return newRefreshInterval("LOCATE_REFRESH_INTERVAL")
+}
+
+func newRefreshInterval(key string) (time.Duration, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultRefreshInterval, nil
+	}
+
+	interval, err := time.ParseDuration(value)
+	if err != nil || interval <= 0 {
+		return 0, fmt.Errorf("%s must be a positive duration, got %q", key, value)
+	}
+
+	return interval, nil
+}
@@ -0,0 +1,29 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type batchGetRequest struct {
+	IDs []string `json:"ids"`
+}
+
+func bindBatchIDs(c echo.Context, maxBatchSize int) ([]string, error) {
+	var request batchGetRequest
+	if err := c.Bind(&request); err != nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "Request body must be a JSON object with an ids array.")
+	}
+
+	if len(request.IDs) == 0 {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "Request body must list at least one ID.")
+	}
+
+	if len(request.IDs) > maxBatchSize {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("At most %d IDs can be read in one batch.", maxBatchSize))
+	}
+
+	return request.IDs, nil
+}
@@ -0,0 +1,53 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/erikrios/ponorogo-regency-api/model"
+	"github.com/erikrios/ponorogo-regency-api/service"
+	"github.com/labstack/echo/v4"
+)
+
+type codesController struct {
+	service service.CodeService
+}
+
+func NewCodesController(service service.CodeService) *codesController {
+	return &codesController{service: service}
+}
+
+func (cc *codesController) Route(g *echo.Group) {
+	group := g.Group("/codes")
+	group.GET("/:code", cc.decode)
+}
+
+// Decode        godoc
+// @Summary      Decode Code
+// @Description  Break down an administrative code of any level into its province, regency, district and village parts, flagging the unknown ones
+// @Tags         codes
+// @Accept       json
+// @Produce      json
+// @Param        code  path      string  true  "Administrative code of 2, 4, 7 or 10 digits"
+// @Success      200   {object}  codeResponse
+// @Failure      400   {object}  echo.HTTPError
+// @Failure      500   {object}  echo.HTTPError
+// @Router       /codes/{code} [get]
+func (cc *codesController) decode(c echo.Context) error {
+	code := c.Param("code")
+
+	decoded, err := cc.service.Decode(c.Request().Context(), code)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	response := model.NewResponse("success", fmt.Sprintf("successfully decode code %s", code), decoded)
+	return c.JSON(http.StatusOK, response)
+}
+
+// codeResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
+type codeResponse struct {
+	Status  string     `json:"status"`
+	Message string     `json:"message"`
+	Data    model.Code `json:"data"`
+}
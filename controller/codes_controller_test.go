@@ -0,0 +1,137 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/erikrios/ponorogo-regency-api/model"
+	"github.com/erikrios/ponorogo-regency-api/service"
+	"github.com/erikrios/ponorogo-regency-api/service/mocks"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCodesController(t *testing.T) {
+	t.Run("TestNewCodesController", func(t *testing.T) {
+		mockService := &mocks.CodeService{}
+		controller := NewCodesController(mockService)
+		assert.NotNil(t, controller)
+	})
+
+	t.Run("TestRoute", func(t *testing.T) {
+		mockService := &mocks.CodeService{}
+		controller := NewCodesController(mockService)
+		g := echo.New().Group("/api/v1")
+		controller.Route(g)
+		assert.NotNil(t, controller)
+	})
+
+	t.Run("TestDecode", func(t *testing.T) {
+		mockService := &mocks.CodeService{}
+
+		dummyCode := model.Code{
+			Code:  "3502999",
+			Level: "district",
+			Parts: []model.CodePart{
+				{Level: "province", Code: "35", Name: "JAWA TIMUR", Known: true},
+				{Level: "regency", Code: "3502", Name: "KABUPATEN PONOROGO", Known: true},
+				{Level: "district", Code: "3502999"},
+			},
+		}
+
+		t.Run("success scenario", func(t *testing.T) {
+			mockService.On("Decode", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), dummyCode.Code).Return(
+				func(ctx context.Context, code string) model.Code {
+					return dummyCode
+				},
+				func(ctx context.Context, code string) error {
+					return nil
+				},
+			).Once()
+
+			t.Run("it should return 200 status code with the decoded parts, when there is no error", func(t *testing.T) {
+				controller := NewCodesController(mockService)
+
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodGet, "/api/v1/codes/3502999", nil)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+				c.SetPath("/:code")
+				c.SetParamNames("code")
+				c.SetParamValues(dummyCode.Code)
+
+				if assert.NoError(t, controller.decode(c)) {
+					assert.Equal(t, http.StatusOK, rec.Code)
+
+					var response model.Response[model.Code]
+					if assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response)) {
+						assert.Equal(t, "success", response.Status)
+						assert.Equal(t, "successfully decode code 3502999", response.Message)
+						assert.Equal(t, dummyCode, response.Data)
+					}
+				}
+			})
+		})
+
+		t.Run("failed scenario", func(t *testing.T) {
+			mockService.On("Decode", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string")).Return(
+				func(ctx context.Context, code string) model.Code {
+					return model.Code{}
+				},
+				func(ctx context.Context, code string) error {
+					if code == "350" {
+						return &service.IDError{Level: "administrative", Field: "code", ID: code, Reason: "must consist of 2, 4, 7 or 10 digits"}
+					}
+					return service.ErrRepository
+				},
+			).Twice()
+
+			testCases := []struct {
+				name               string
+				code               string
+				expectedStatusCode int
+				expectedMessage    string
+			}{
+				{
+					name:               "it should return 400 status code with valid response, when given code is malformed",
+					code:               "350",
+					expectedStatusCode: http.StatusBadRequest,
+					expectedMessage:    `Invalid administrative ID "350": it must consist of 2, 4, 7 or 10 digits.`,
+				},
+				{
+					name:               "it should return 500 status code with valid response, when error happened",
+					code:               "3502",
+					expectedStatusCode: http.StatusInternalServerError,
+					expectedMessage:    "Something went wrong.",
+				},
+			}
+
+			for _, testCase := range testCases {
+				t.Run(testCase.name, func(t *testing.T) {
+					controller := NewCodesController(mockService)
+
+					e := echo.New()
+					req := httptest.NewRequest(http.MethodGet, "/api/v1/codes", nil)
+					rec := httptest.NewRecorder()
+					c := e.NewContext(req, rec)
+					c.SetPath("/:code")
+					c.SetParamNames("code")
+					c.SetParamValues(testCase.code)
+
+					gotError := controller.decode(c)
+					if assert.Error(t, gotError) {
+						if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+							assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+							assert.Equal(t, testCase.expectedMessage, echoHTTPError.Message)
+						}
+					}
+				})
+			}
+		})
+	})
+}
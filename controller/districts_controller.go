@@ -10,18 +10,22 @@ import (
 )
 
 type districtsController struct {
-	service service.DistrictService
+	service      service.DistrictService
+	maxBatchSize int
 }
 
-func NewDistrictsController(service service.DistrictService) *districtsController {
-	return &districtsController{service: service}
+func NewDistrictsController(service service.DistrictService, maxBatchSize int) *districtsController {
+	return &districtsController{service: service, maxBatchSize: maxBatchSize}
 }
 
 func (d *districtsController) Route(g *echo.Group) {
 	group := g.Group("/districts")
+	group.POST("\\:batchGet", d.batchGet)
 	group.GET("", d.getAll)
 	group.GET("/:id", d.getByID)
 	group.GET("/:id/villages", d.getVillagesByDistrictID)
+	group.GET("/:id/geometry", d.getGeometry)
+	group.GET("/:id/villages/geometry", d.getVillagesGeometry)
 	group.GET("/villages", d.getVillagesByDistrictName)
 }
 
@@ -32,20 +36,47 @@ func (d *districtsController) Route(g *echo.Group) {
 // @Accept       json
 // @Produce      json
 // @Param        keyword  query     string  false  "district name search by keyword"
+// @Param        match    query     string  false  "how keyword is matched, fuzzy ranks typo-tolerant matches by their score, from 0 to 1"  Enums(contains, fuzzy)
+// @Param        page     query     int     false  "page number, starting from 1"
+// @Param        limit    query     int     false  "maximum number of items per page, from 1 to 100 (default 50)"
+// @Param        after    query     string  false  "only return items after this ID, can't be combined with page"
+// @Param        sort     query     string  false  "sort by id or name, descending when prefixed with -"  Enums(id, -id, name, -name)
+// @Param        name     query     string  false  "only return items whose name contains this value"
+// @Param        regency_id query     string  false  "only return items of this regency"
+// @Param        province_id query     string  false  "only return items of this province"
+// @Param        fields   query     string  false  "comma-separated fields of each item to return, such as id,name"
+// @Param        expand   query     string  false  "comma-separated parents to embed in each item, all of them when omitted"
 // @Success      200      {object}  districtsResponse
+// @Failure      400      {object}  echo.HTTPError
 // @Failure      500  {object}  echo.HTTPError
 // @Router       /districts [get]
 func (d *districtsController) getAll(c echo.Context) error {
 	keyword := c.QueryParam("keyword")
 
-	districts, err := d.service.GetAll(c.Request().Context(), keyword)
+	query, err := bindListQuery(c)
+	if err != nil {
+		return err
+	}
+
+	sparse, err := bindSparseFields(c, &query, "id", "name", "display_name", "latitude", "longitude", "regency", "score")
+	if err != nil {
+		return err
+	}
+
+	districts, total, err := d.service.GetAll(c.Request().Context(), keyword, query)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	items, err := project(districts, sparse)
 	if err != nil {
 		return newErrorResponse(err)
 	}
 
-	districtsResponse := map[string]any{"districts": districts}
+	districtsResponse := map[string]any{"districts": items}
 
-	response := model.NewResponse("success", "successfully get districts", districtsResponse)
+	pagination := newPagination(c, query, total, districts, func(district model.District) string { return district.ID })
+	response := model.NewResponse("success", "successfully get districts", districtsResponse).WithPagination(pagination)
 	return c.JSON(http.StatusOK, response)
 }
 
@@ -57,6 +88,7 @@ func (d *districtsController) getAll(c echo.Context) error {
 // @Produce      json
 // @Param        id   path      int  true  "District ID"
 // @Success      200  {object}  districtResponse
+// @Failure      400  {object}  echo.HTTPError
 // @Failure      404  {object}  echo.HTTPError
 // @Failure      500  {object}  echo.HTTPError
 // @Router       /districts/{id} [get]
@@ -79,20 +111,49 @@ func (p *districtsController) getByID(c echo.Context) error {
 // @Accept       json
 // @Produce      json
 // @Param        id   path      int  true  "District ID"
-// @Success      200  {object}  villagesResponse
+// @Param        page     query     int     false  "page number, starting from 1"
+// @Param        limit    query     int     false  "maximum number of items per page, from 1 to 100 (default 50)"
+// @Param        after    query     string  false  "only return items after this ID, can't be combined with page"
+// @Param        sort     query     string  false  "sort by id or name, descending when prefixed with -"  Enums(id, -id, name, -name)
+// @Param        name     query     string  false  "only return items whose name contains this value"
+// @Param        district_id query     string  false  "only return villages of this district"
+// @Param        regency_id query     string  false  "only return items of this regency"
+// @Param        province_id query     string  false  "only return items of this province"
+// @Param        type     query     string  false  "only return villages of this type"  Enums(desa, kelurahan)
+// @Param        fields   query     string  false  "comma-separated fields of each item to return, such as id,name"
+// @Param        expand   query     string  false  "comma-separated parents to embed in each item, all of them when omitted"
+// @Success      200      {object}  villagesResponse
+// @Failure      400      {object}  echo.HTTPError
+// @Failure      404      {object}  echo.HTTPError
 // @Failure      500      {object}  echo.HTTPError
 // @Router       /districts/{id}/villages [get]
 func (p *districtsController) getVillagesByDistrictID(c echo.Context) error {
 	id := c.Param("id")
 
-	villages, err := p.service.GetVillagesByDistrictID(c.Request().Context(), id)
+	query, err := bindListQuery(c)
+	if err != nil {
+		return err
+	}
+
+	sparse, err := bindSparseFields(c, &query, "id", "name", "display_name", "type", "latitude", "longitude", "postal_codes", "district")
+	if err != nil {
+		return err
+	}
+
+	villages, total, err := p.service.GetVillagesByDistrictID(c.Request().Context(), id, query)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	items, err := project(villages, sparse)
 	if err != nil {
 		return newErrorResponse(err)
 	}
 
-	villagesResponse := map[string]any{"villages": villages}
+	villagesResponse := map[string]any{"villages": items}
 
-	response := model.NewResponse("success", fmt.Sprintf("successfully get villages with district ID %s", id), villagesResponse)
+	pagination := newPagination(c, query, total, villages, func(village model.Village) string { return village.ID })
+	response := model.NewResponse("success", fmt.Sprintf("successfully get villages with district ID %s", id), villagesResponse).WithPagination(pagination)
 	return c.JSON(http.StatusOK, response)
 }
 
@@ -103,28 +164,139 @@ func (p *districtsController) getVillagesByDistrictID(c echo.Context) error {
 // @Accept       json
 // @Produce      json
 // @Param        keyword  query     string  false  "district name search by keyword"
+// @Param        page     query     int     false  "page number, starting from 1"
+// @Param        limit    query     int     false  "maximum number of items per page, from 1 to 100 (default 50)"
+// @Param        after    query     string  false  "only return items after this ID, can't be combined with page"
+// @Param        sort     query     string  false  "sort by id or name, descending when prefixed with -"  Enums(id, -id, name, -name)
+// @Param        name     query     string  false  "only return items whose name contains this value"
+// @Param        district_id query     string  false  "only return villages of this district"
+// @Param        regency_id query     string  false  "only return items of this regency"
+// @Param        province_id query     string  false  "only return items of this province"
+// @Param        type     query     string  false  "only return villages of this type"  Enums(desa, kelurahan)
+// @Param        fields   query     string  false  "comma-separated fields of each item to return, such as id,name"
+// @Param        expand   query     string  false  "comma-separated parents to embed in each item, all of them when omitted"
 // @Success      200      {object}  villagesResponse
+// @Failure      400      {object}  echo.HTTPError
 // @Failure      500      {object}  echo.HTTPError
 // @Router       /districts/villages [get]
 func (p *districtsController) getVillagesByDistrictName(c echo.Context) error {
 	keyword := c.QueryParam("keyword")
 
-	villages, err := p.service.GetVillagesByDistrictName(c.Request().Context(), keyword)
+	query, err := bindListQuery(c)
+	if err != nil {
+		return err
+	}
+
+	sparse, err := bindSparseFields(c, &query, "id", "name", "display_name", "type", "latitude", "longitude", "postal_codes", "district")
+	if err != nil {
+		return err
+	}
+
+	villages, total, err := p.service.GetVillagesByDistrictName(c.Request().Context(), keyword, query)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	items, err := project(villages, sparse)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	villagesResponse := map[string]any{"villages": items}
+
+	pagination := newPagination(c, query, total, villages, func(village model.Village) string { return village.ID })
+	response := model.NewResponse("success", fmt.Sprintf("successfully get villages with district keyword name %s", keyword), villagesResponse).WithPagination(pagination)
+	return c.JSON(http.StatusOK, response)
+}
+
+// GetGeometry   godoc
+// @Summary      Get District Geometry
+// @Description  Get the boundary of a district as a GeoJSON Feature, whose geometry is null when the boundary isn't known
+// @Tags         districts
+// @Accept       json
+// @Produce      application/geo+json
+// @Param        id         path      int     true   "District ID"
+// @Param        tolerance  query     number  false  "simplify the geometry, dropping the positions closer than this many degrees to the simplified lines"
+// @Success      200        {object}  geojson.Feature
+// @Failure      400        {object}  echo.HTTPError
+// @Failure      404        {object}  echo.HTTPError
+// @Failure      500        {object}  echo.HTTPError
+// @Router       /districts/{id}/geometry [get]
+func (d *districtsController) getGeometry(c echo.Context) error {
+	tolerance, err := bindTolerance(c)
+	if err != nil {
+		return err
+	}
+
+	feature, err := d.service.GetGeometry(c.Request().Context(), c.Param("id"), tolerance)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	return geoJSON(c, feature)
+}
+
+// GetVillagesGeometry godoc
+// @Summary      Get Villages Geometry by District ID
+// @Description  Get the boundaries of the villages of a district as a GeoJSON FeatureCollection, for whole-district exports
+// @Tags         districts
+// @Accept       json
+// @Produce      application/geo+json
+// @Param        id         path      int     true   "District ID"
+// @Param        tolerance  query     number  false  "simplify the geometries, dropping the positions closer than this many degrees to the simplified lines"
+// @Success      200        {object}  geojson.FeatureCollection
+// @Failure      400        {object}  echo.HTTPError
+// @Failure      404        {object}  echo.HTTPError
+// @Failure      500        {object}  echo.HTTPError
+// @Router       /districts/{id}/villages/geometry [get]
+func (d *districtsController) getVillagesGeometry(c echo.Context) error {
+	tolerance, err := bindTolerance(c)
+	if err != nil {
+		return err
+	}
+
+	collection, err := d.service.GetVillagesGeometry(c.Request().Context(), c.Param("id"), tolerance)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	return geoJSON(c, collection)
+}
+
+// BatchGet      godoc
+// @Summary      Batch Get Districts
+// @Description  Get the districts with the given IDs in one request, listing the IDs that don't belong to any district and the malformed ones
+// @Tags         districts
+// @Accept       json
+// @Produce      json
+// @Param        body  body      batchGetRequest  true  "District IDs, at most MAX_BATCH_SIZE (default 100) of them"
+// @Success      200   {object}  districtsBatchResponse
+// @Failure      400   {object}  echo.HTTPError
+// @Failure      500   {object}  echo.HTTPError
+// @Router       /districts:batchGet [post]
+func (d *districtsController) batchGet(c echo.Context) error {
+	ids, err := bindBatchIDs(c, d.maxBatchSize)
+	if err != nil {
+		return err
+	}
+
+	districts, missing, invalid, err := d.service.GetByIDs(c.Request().Context(), ids)
 	if err != nil {
 		return newErrorResponse(err)
 	}
 
-	villagesResponse := map[string]any{"villages": villages}
+	districtsResponse := map[string]any{"districts": districts, "missing": missing, "invalid": invalid}
 
-	response := model.NewResponse("success", fmt.Sprintf("successfully get villages with district keyword name %s", keyword), villagesResponse)
+	response := model.NewResponse("success", fmt.Sprintf("successfully get %d of %d districts", len(districts), len(districts)+len(missing)+len(invalid)), districtsResponse)
 	return c.JSON(http.StatusOK, response)
 }
 
 // districtsResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
 type districtsResponse struct {
-	Status  string        `json:"status"`
-	Message string        `json:"message"`
-	Data    districtsData `json:"data"`
+	Status     string           `json:"status"`
+	Message    string           `json:"message"`
+	Data       districtsData    `json:"data"`
+	Pagination model.Pagination `json:"pagination"`
 }
 
 type districtsData struct {
@@ -137,3 +309,16 @@ type districtResponse struct {
 	Message string         `json:"message"`
 	Data    model.District `json:"data"`
 }
+
+// districtsBatchResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
+type districtsBatchResponse struct {
+	Status  string             `json:"status"`
+	Message string             `json:"message"`
+	Data    districtsBatchData `json:"data"`
+}
+
+type districtsBatchData struct {
+	Districts []model.District `json:"districts"`
+	Missing   []string         `json:"missing"`
+	Invalid   []string         `json:"invalid"`
+}
@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
+	"github.com/erikrios/ponorogo-regency-api/geojson"
 	"github.com/erikrios/ponorogo-regency-api/model"
 	"github.com/erikrios/ponorogo-regency-api/service"
 	"github.com/erikrios/ponorogo-regency-api/service/mocks"
@@ -19,13 +21,13 @@ import (
 func TestDistrictsController(t *testing.T) {
 	t.Run("TestNewDistrictsController", func(t *testing.T) {
 		mockService := &mocks.DistrictService{}
-		controller := NewDistrictsController(mockService)
+		controller := NewDistrictsController(mockService, 100)
 		assert.NotNil(t, controller)
 	})
 
 	t.Run("TestRoute", func(t *testing.T) {
 		mockService := &mocks.DistrictService{}
-		controller := NewDistrictsController(mockService)
+		controller := NewDistrictsController(mockService, 100)
 		g := echo.New().Group("/api/v1")
 		controller.Route(g)
 		assert.NotNil(t, controller)
@@ -50,17 +52,20 @@ func TestDistrictsController(t *testing.T) {
 		}
 
 		t.Run("success scenario", func(t *testing.T) {
-			mockService.On("GetAll", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string")).Return(
-				func(ctx context.Context, keyword string) []model.District {
+			mockService.On("GetAll", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string"), mock.AnythingOfType("model.ListQuery")).Return(
+				func(ctx context.Context, keyword string, query model.ListQuery) []model.District {
 					return dummyDistricts
 				},
-				func(ctx context.Context, keyword string) error {
+				func(ctx context.Context, keyword string, query model.ListQuery) int {
+					return len(dummyDistricts)
+				},
+				func(ctx context.Context, keyword string, query model.ListQuery) error {
 					return nil
 				},
 			).Once()
 
 			t.Run("it should return 200 status code with valid response, when there is no error", func(t *testing.T) {
-				controller := NewDistrictsController(mockService)
+				controller := NewDistrictsController(mockService, 100)
 
 				e := echo.New()
 				req := httptest.NewRequest(http.MethodGet, "/api/v1/districts?keyword=Bungkal", nil)
@@ -103,17 +108,20 @@ func TestDistrictsController(t *testing.T) {
 		})
 
 		t.Run("failed scenario", func(t *testing.T) {
-			mockService.On("GetAll", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string")).Return(
-				func(ctx context.Context, keyword string) []model.District {
+			mockService.On("GetAll", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string"), mock.AnythingOfType("model.ListQuery")).Return(
+				func(ctx context.Context, keyword string, query model.ListQuery) []model.District {
 					return []model.District{}
 				},
-				func(ctx context.Context, keyword string) error {
+				func(ctx context.Context, keyword string, query model.ListQuery) int {
+					return 0
+				},
+				func(ctx context.Context, keyword string, query model.ListQuery) error {
 					return service.ErrRepository
 				},
 			).Once()
 
 			t.Run("it should return 500 status code with valid response, when error happened", func(t *testing.T) {
-				controller := NewDistrictsController(mockService)
+				controller := NewDistrictsController(mockService, 100)
 
 				e := echo.New()
 				req := httptest.NewRequest(http.MethodGet, "/api/v1/districts?keyword=Bungkal", nil)
@@ -158,7 +166,7 @@ func TestDistrictsController(t *testing.T) {
 			).Once()
 
 			t.Run("it should return 200 status code with valid response, when there is no error", func(t *testing.T) {
-				controller := NewDistrictsController(mockService)
+				controller := NewDistrictsController(mockService, 100)
 
 				e := echo.New()
 				req := httptest.NewRequest(http.MethodGet, "/api/v1/districts", nil)
@@ -227,7 +235,7 @@ func TestDistrictsController(t *testing.T) {
 
 			for _, testCase := range testCases {
 				t.Run(testCase.name, func(t *testing.T) {
-					controller := NewDistrictsController(mockService)
+					controller := NewDistrictsController(mockService, 100)
 
 					e := echo.New()
 					req := httptest.NewRequest(http.MethodGet, "/api/v1/districts", nil)
@@ -249,6 +257,128 @@ func TestDistrictsController(t *testing.T) {
 		})
 	})
 
+	t.Run("TestBatchGet", func(t *testing.T) {
+		mockService := &mocks.DistrictService{}
+
+		dummyDistricts := []model.District{
+			{
+				ID:   "3502010",
+				Name: "NGRAYUN",
+				Regency: model.Regency{
+					ID:       "3502",
+					Name:     "KABUPATEN PONOROGO",
+					Province: model.Province{ID: "35", Name: "JAWA TIMUR"},
+				},
+			},
+		}
+
+		mockService.On("GetByIDs", mock.Anything, []string{"3502010", "3502999"}).Return(
+			func(ctx context.Context, ids []string) []model.District {
+				return dummyDistricts
+			},
+			func(ctx context.Context, ids []string) []string {
+				return []string{"3502999"}
+			},
+			func(ctx context.Context, ids []string) []string {
+				return []string{}
+			},
+			func(ctx context.Context, ids []string) error {
+				return nil
+			},
+		).Once()
+		mockService.On("GetByIDs", mock.Anything, []string{"350201"}).Return(
+			func(ctx context.Context, ids []string) []model.District {
+				return []model.District{}
+			},
+			func(ctx context.Context, ids []string) []string {
+				return []string{}
+			},
+			func(ctx context.Context, ids []string) []string {
+				return []string{"350201"}
+			},
+			func(ctx context.Context, ids []string) error {
+				return nil
+			},
+		).Once()
+
+		e := echo.New()
+		NewDistrictsController(mockService, 2).Route(e.Group("/api/v1"))
+
+		t.Run("it should return 200 status code with the found districts and the missing IDs, when there is no error", func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/districts:batchGet", strings.NewReader(`{"ids": ["3502010", "3502999"]}`))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, http.StatusOK, rec.Code)
+
+			var response model.Response[districtsBatchData]
+			if assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response)) {
+				assert.Equal(t, "successfully get 1 of 2 districts", response.Message)
+				assert.Equal(t, dummyDistricts, response.Data.Districts)
+				assert.Equal(t, []string{"3502999"}, response.Data.Missing)
+				assert.Empty(t, response.Data.Invalid)
+			}
+		})
+
+		t.Run("it should return 200 status code with the malformed IDs, when some IDs are malformed", func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/districts:batchGet", strings.NewReader(`{"ids": ["350201"]}`))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, http.StatusOK, rec.Code)
+
+			var response model.Response[districtsBatchData]
+			if assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response)) {
+				assert.Equal(t, "successfully get 0 of 1 districts", response.Message)
+				assert.Empty(t, response.Data.Districts)
+				assert.Equal(t, []string{"350201"}, response.Data.Invalid)
+			}
+		})
+
+		testCases := []struct {
+			name            string
+			body            string
+			expectedMessage string
+		}{
+			{
+				name:            "it should return 400 status code, when the body isn't JSON",
+				body:            `ids=3502010`,
+				expectedMessage: "Request body must be a JSON object with an ids array.",
+			},
+			{
+				name:            "it should return 400 status code, when the body lists no ID",
+				body:            `{"ids": []}`,
+				expectedMessage: "Request body must list at least one ID.",
+			},
+			{
+				name:            "it should return 400 status code, when the body lists more IDs than the maximum batch size",
+				body:            `{"ids": ["3502010", "3502010", "3502010"]}`,
+				expectedMessage: "At most 2 IDs can be read in one batch.",
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				req := httptest.NewRequest(http.MethodPost, "/api/v1/districts:batchGet", strings.NewReader(testCase.body))
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+				rec := httptest.NewRecorder()
+
+				e.ServeHTTP(rec, req)
+
+				assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+				response := make(map[string]any)
+				if assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response)) {
+					assert.Equal(t, testCase.expectedMessage, response["message"])
+				}
+			})
+		}
+	})
+
 	t.Run("TestGetVillagesByDistrictID", func(t *testing.T) {
 		mockService := &mocks.DistrictService{}
 
@@ -272,17 +402,20 @@ func TestDistrictsController(t *testing.T) {
 		}
 
 		t.Run("success scenario", func(t *testing.T) {
-			mockService.On("GetVillagesByDistrictID", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string")).Return(
-				func(ctx context.Context, districtID string) []model.Village {
+			mockService.On("GetVillagesByDistrictID", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string"), mock.AnythingOfType("model.ListQuery")).Return(
+				func(ctx context.Context, districtID string, query model.ListQuery) []model.Village {
 					return dummyVillages
 				},
-				func(ctx context.Context, districtID string) error {
+				func(ctx context.Context, districtID string, query model.ListQuery) int {
+					return len(dummyVillages)
+				},
+				func(ctx context.Context, districtID string, query model.ListQuery) error {
 					return nil
 				},
 			).Once()
 
 			t.Run("it should return 200 status code with valid response, when there is no error", func(t *testing.T) {
-				controller := NewDistrictsController(mockService)
+				controller := NewDistrictsController(mockService, 100)
 
 				e := echo.New()
 				req := httptest.NewRequest(http.MethodGet, "/api/v1/districts", nil)
@@ -332,17 +465,24 @@ func TestDistrictsController(t *testing.T) {
 		})
 
 		t.Run("failed scenario", func(t *testing.T) {
-			mockService.On("GetVillagesByDistrictID", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string")).Return(
-				func(ctx context.Context, districtID string) []model.Village {
+			mockService.On("GetVillagesByDistrictID", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string"), mock.AnythingOfType("model.ListQuery")).Return(
+				func(ctx context.Context, districtID string, query model.ListQuery) []model.Village {
 					return []model.Village{}
 				},
-				func(ctx context.Context, districtID string) error {
-					return service.ErrRepository
+				func(ctx context.Context, districtID string, query model.ListQuery) int {
+					return 0
 				},
-			).Once()
+				func(ctx context.Context, districtID string, query model.ListQuery) error {
+					if districtID != dummyVillages[0].District.ID {
+						return service.ErrDataNotFound
+					} else {
+						return service.ErrRepository
+					}
+				},
+			).Twice()
 
 			t.Run("it should return 500 status code with valid response, when error happened", func(t *testing.T) {
-				controller := NewDistrictsController(mockService)
+				controller := NewDistrictsController(mockService, 100)
 
 				e := echo.New()
 				req := httptest.NewRequest(http.MethodGet, "/api/v1/districts", nil)
@@ -360,6 +500,26 @@ func TestDistrictsController(t *testing.T) {
 					}
 				}
 			})
+
+			t.Run("it should return 404 status code, when the district doesn't exist", func(t *testing.T) {
+				controller := NewDistrictsController(mockService, 100)
+
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodGet, "/api/v1/districts", nil)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+				c.SetPath("/:id/villages")
+				c.SetParamNames("id")
+				c.SetParamValues("3502999")
+
+				gotError := controller.getVillagesByDistrictID(c)
+				if assert.Error(t, gotError) {
+					if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+						assert.Equal(t, http.StatusNotFound, echoHTTPError.Code)
+						assert.Equal(t, "Resource with given ID not found.", echoHTTPError.Message)
+					}
+				}
+			})
 		})
 	})
 
@@ -386,17 +546,20 @@ func TestDistrictsController(t *testing.T) {
 		}
 
 		t.Run("success scenario", func(t *testing.T) {
-			mockService.On("GetVillagesByDistrictName", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string")).Return(
-				func(ctx context.Context, keyword string) []model.Village {
+			mockService.On("GetVillagesByDistrictName", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string"), mock.AnythingOfType("model.ListQuery")).Return(
+				func(ctx context.Context, keyword string, query model.ListQuery) []model.Village {
 					return dummyVillages
 				},
-				func(ctx context.Context, keyword string) error {
+				func(ctx context.Context, keyword string, query model.ListQuery) int {
+					return len(dummyVillages)
+				},
+				func(ctx context.Context, keyword string, query model.ListQuery) error {
 					return nil
 				},
 			).Once()
 
 			t.Run("it should return 200 status code with valid response, when there is no error", func(t *testing.T) {
-				controller := NewDistrictsController(mockService)
+				controller := NewDistrictsController(mockService, 100)
 
 				e := echo.New()
 				req := httptest.NewRequest(http.MethodGet, "/api/v1/districts/villages?keyword=Bungkal", nil)
@@ -443,17 +606,20 @@ func TestDistrictsController(t *testing.T) {
 		})
 
 		t.Run("failed scenario", func(t *testing.T) {
-			mockService.On("GetVillagesByDistrictName", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string")).Return(
-				func(ctx context.Context, keyword string) []model.Village {
+			mockService.On("GetVillagesByDistrictName", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string"), mock.AnythingOfType("model.ListQuery")).Return(
+				func(ctx context.Context, keyword string, query model.ListQuery) []model.Village {
 					return []model.Village{}
 				},
-				func(ctx context.Context, keyword string) error {
+				func(ctx context.Context, keyword string, query model.ListQuery) int {
+					return 0
+				},
+				func(ctx context.Context, keyword string, query model.ListQuery) error {
 					return service.ErrRepository
 				},
 			).Once()
 
 			t.Run("it should return 500 status code with valid response, when error happened", func(t *testing.T) {
-				controller := NewDistrictsController(mockService)
+				controller := NewDistrictsController(mockService, 100)
 
 				e := echo.New()
 				req := httptest.NewRequest(http.MethodGet, "/api/v1/districts/villages?keyword=Bungkal", nil)
@@ -470,4 +636,183 @@ func TestDistrictsController(t *testing.T) {
 			})
 		})
 	})
+
+	t.Run("TestGetGeometry", func(t *testing.T) {
+		mockService := &mocks.DistrictService{}
+
+		geometry := geojson.Geometry{
+			Type:     geojson.TypePolygon,
+			Polygons: []geojson.Polygon{{{{111.4, -7.9}, {111.5, -7.9}, {111.5, -7.8}, {111.4, -7.9}}}},
+		}
+		dummyFeature := geojson.NewFeature("3502010", &geometry, map[string]any{"id": "3502010", "name": "NGRAYUN", "display_name": "Ngrayun"})
+
+		t.Run("success scenario", func(t *testing.T) {
+			mockService.On("GetGeometry", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), "3502010", 0.001).Return(
+				func(ctx context.Context, id string, tolerance float64) geojson.Feature {
+					return dummyFeature
+				},
+				func(ctx context.Context, id string, tolerance float64) error {
+					return nil
+				},
+			).Once()
+
+			t.Run("it should return 200 status code with the bare feature, when there is no error", func(t *testing.T) {
+				controller := NewDistrictsController(mockService, 100)
+
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodGet, "/api/v1/districts/3502010/geometry?tolerance=0.001", nil)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+				c.SetPath("/:id/geometry")
+				c.SetParamNames("id")
+				c.SetParamValues("3502010")
+
+				if assert.NoError(t, controller.getGeometry(c)) {
+					assert.Equal(t, http.StatusOK, rec.Code)
+					assert.Equal(t, mimeGeoJSON, rec.Header().Get(echo.HeaderContentType))
+					assert.JSONEq(t, `{
+						"type": "Feature",
+						"id": "3502010",
+						"geometry": {"type": "Polygon", "coordinates": [[[111.4, -7.9], [111.5, -7.9], [111.5, -7.8], [111.4, -7.9]]]},
+						"properties": {"id": "3502010", "name": "NGRAYUN", "display_name": "Ngrayun"}
+					}`, rec.Body.String())
+				}
+			})
+		})
+
+		t.Run("failed scenario", func(t *testing.T) {
+			mockService.On("GetGeometry", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string"), mock.AnythingOfType("float64")).Return(
+				func(ctx context.Context, id string, tolerance float64) geojson.Feature {
+					return geojson.Feature{}
+				},
+				func(ctx context.Context, id string, tolerance float64) error {
+					if tolerance < 0 {
+						return &service.QueryError{Reason: "tolerance must be a non-negative number of degrees"}
+					}
+					return service.ErrDataNotFound
+				},
+			).Twice()
+
+			testCases := []struct {
+				name               string
+				target             string
+				expectedStatusCode int
+				expectedMessage    string
+			}{
+				{
+					name:               "it should return 400 status code with valid response, when tolerance isn't a number",
+					target:             "/api/v1/districts/3502010/geometry?tolerance=coarse",
+					expectedStatusCode: http.StatusBadRequest,
+					expectedMessage:    "Query param tolerance must be a number.",
+				},
+				{
+					name:               "it should return 400 status code with valid response, when tolerance is negative",
+					target:             "/api/v1/districts/3502010/geometry?tolerance=-1",
+					expectedStatusCode: http.StatusBadRequest,
+					expectedMessage:    "Invalid query: tolerance must be a non-negative number of degrees.",
+				},
+				{
+					name:               "it should return 404 status code with valid response, when given ID not found",
+					target:             "/api/v1/districts/3502010/geometry",
+					expectedStatusCode: http.StatusNotFound,
+					expectedMessage:    "Resource with given ID not found.",
+				},
+			}
+
+			for _, testCase := range testCases {
+				t.Run(testCase.name, func(t *testing.T) {
+					controller := NewDistrictsController(mockService, 100)
+
+					e := echo.New()
+					req := httptest.NewRequest(http.MethodGet, testCase.target, nil)
+					rec := httptest.NewRecorder()
+					c := e.NewContext(req, rec)
+					c.SetPath("/:id/geometry")
+					c.SetParamNames("id")
+					c.SetParamValues("3502010")
+
+					gotError := controller.getGeometry(c)
+					if assert.Error(t, gotError) {
+						if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+							assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+							assert.Equal(t, testCase.expectedMessage, echoHTTPError.Message)
+						}
+					}
+				})
+			}
+		})
+	})
+
+	t.Run("TestGetVillagesGeometry", func(t *testing.T) {
+		mockService := &mocks.DistrictService{}
+
+		dummyCollection := geojson.NewFeatureCollection([]geojson.Feature{
+			geojson.NewFeature("3502010001", nil, map[string]any{"id": "3502010001", "name": "BAOSANKIDUL", "display_name": "Baosankidul"}),
+		})
+
+		t.Run("success scenario", func(t *testing.T) {
+			mockService.On("GetVillagesGeometry", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), "3502010", 0.0).Return(
+				func(ctx context.Context, id string, tolerance float64) geojson.FeatureCollection {
+					return dummyCollection
+				},
+				func(ctx context.Context, id string, tolerance float64) error {
+					return nil
+				},
+			).Once()
+
+			t.Run("it should return 200 status code with the bare feature collection, when there is no error", func(t *testing.T) {
+				controller := NewDistrictsController(mockService, 100)
+
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodGet, "/api/v1/districts/3502010/villages/geometry", nil)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+				c.SetPath("/:id/villages/geometry")
+				c.SetParamNames("id")
+				c.SetParamValues("3502010")
+
+				if assert.NoError(t, controller.getVillagesGeometry(c)) {
+					assert.Equal(t, http.StatusOK, rec.Code)
+					assert.Equal(t, mimeGeoJSON, rec.Header().Get(echo.HeaderContentType))
+					assert.JSONEq(t, `{
+						"type": "FeatureCollection",
+						"features": [
+							{"type": "Feature", "id": "3502010001", "geometry": null, "properties": {"id": "3502010001", "name": "BAOSANKIDUL", "display_name": "Baosankidul"}}
+						]
+					}`, rec.Body.String())
+				}
+			})
+		})
+
+		t.Run("failed scenario", func(t *testing.T) {
+			mockService.On("GetVillagesGeometry", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), "3502010", 0.0).Return(
+				func(ctx context.Context, id string, tolerance float64) geojson.FeatureCollection {
+					return geojson.FeatureCollection{}
+				},
+				func(ctx context.Context, id string, tolerance float64) error {
+					return service.ErrRepository
+				},
+			).Once()
+
+			t.Run("it should return 500 status code with valid response, when error happened", func(t *testing.T) {
+				controller := NewDistrictsController(mockService, 100)
+
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodGet, "/api/v1/districts/3502010/villages/geometry", nil)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+				c.SetPath("/:id/villages/geometry")
+				c.SetParamNames("id")
+				c.SetParamValues("3502010")
+
+				gotError := controller.getVillagesGeometry(c)
+				if assert.Error(t, gotError) {
+					if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+						assert.Equal(t, http.StatusInternalServerError, echoHTTPError.Code)
+						assert.Equal(t, "Something went wrong.", echoHTTPError.Message)
+					}
+				}
+			})
+		})
+	})
 }
@@ -2,6 +2,7 @@ package controller
 
 import (
 	"errors"
+	"fmt"
 	"log"
 	"net/http"
 
@@ -12,10 +13,21 @@ import (
 func newErrorResponse(err error) *echo.HTTPError {
 	var statusCode int
 	var message string
+	var queryErr *service.QueryError
+	var idErr *service.IDError
 
 	if errors.Is(err, service.ErrDataNotFound) {
 		statusCode = http.StatusNotFound
 		message = "Resource with given ID not found."
+	} else if errors.Is(err, service.ErrNotLocated) {
+		statusCode = http.StatusNotFound
+		message = "No village or district contains the given point."
+	} else if errors.As(err, &idErr) {
+		statusCode = http.StatusBadRequest
+		message = fmt.Sprintf("Invalid %s ID %q: it %s.", idErr.Level, idErr.ID, idErr.Reason)
+	} else if errors.As(err, &queryErr) {
+		statusCode = http.StatusBadRequest
+		message = fmt.Sprintf("Invalid query: %s.", queryErr.Reason)
 	} else if errors.Is(err, service.ErrRepository) {
 		statusCode = http.StatusInternalServerError
 		message = "Something went wrong."
@@ -0,0 +1,120 @@
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/erikrios/ponorogo-regency-api/model"
+	"github.com/labstack/echo/v4"
+)
+
+var parentFields = []string{"district", "regency", "province"}
+
+type sparseFields struct {
+	fields map[string]bool
+	expand map[string]bool
+}
+
+func bindSparseFields(c echo.Context, query *model.ListQuery, allowed ...string) (sparse sparseFields, err error) {
+	params := c.QueryParams()
+
+	if _, ok := params["fields"]; ok {
+		sparse.fields = make(map[string]bool)
+		for _, field := range splitParam(c.QueryParam("fields")) {
+			if !contains(allowed, field) {
+				err = echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Query param fields can't contain %q.", field))
+				return
+			}
+			sparse.fields[field] = true
+		}
+	}
+
+	if _, ok := params["expand"]; ok {
+		query.Expand = splitParam(c.QueryParam("expand"))
+	} else if sparse.fields != nil {
+		query.Expand = make([]string, 0)
+		for _, field := range parentFields {
+			if sparse.fields[field] {
+				query.Expand = append(query.Expand, field)
+			}
+		}
+	}
+
+	if query.Expand != nil {
+		sparse.expand = make(map[string]bool)
+		for _, parent := range query.Expand {
+			sparse.expand[parent] = true
+		}
+	}
+
+	return
+}
+
+func project[T any](items []T, sparse sparseFields) (any, error) {
+	if sparse.fields == nil && sparse.expand == nil {
+		return items, nil
+	}
+
+	data, err := json.Marshal(items)
+	if err != nil {
+		return nil, err
+	}
+
+	var projected []map[string]any
+	if err := json.Unmarshal(data, &projected); err != nil {
+		return nil, err
+	}
+
+	for _, item := range projected {
+		if sparse.fields != nil {
+			for field := range item {
+				if !sparse.fields[field] {
+					delete(item, field)
+				}
+			}
+		}
+
+		if sparse.expand != nil {
+			prune(item, sparse.expand)
+		}
+	}
+
+	return projected, nil
+}
+
+func prune(item map[string]any, expand map[string]bool) (kept bool) {
+	for _, field := range parentFields {
+		parent, ok := item[field].(map[string]any)
+		if !ok {
+			continue
+		}
+
+		if prune(parent, expand) || expand[field] {
+			kept = true
+		} else {
+			delete(item, field)
+		}
+	}
+	return
+}
+
+func splitParam(param string) []string {
+	values := make([]string, 0)
+	for _, value := range strings.Split(param, ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,130 @@
+package controller
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/erikrios/ponorogo-regency-api/model"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSparseFields(t *testing.T) {
+	newContext := func(target string) echo.Context {
+		req := httptest.NewRequest(http.MethodGet, target, nil)
+		return echo.New().NewContext(req, httptest.NewRecorder())
+	}
+
+	dummyVillages := []model.Village{
+		{
+			ID:          "3502010001",
+			Name:        "BAOSANKIDUL",
+			DisplayName: "Baosankidul",
+			Type:        "desa",
+			District: model.District{
+				ID:          "3502010",
+				Name:        "NGRAYUN",
+				DisplayName: "Ngrayun",
+				Regency: model.Regency{
+					ID:          "3502",
+					Name:        "KABUPATEN PONOROGO",
+					DisplayName: "Kabupaten Ponorogo",
+					Province: model.Province{
+						ID:          "35",
+						Name:        "JAWA TIMUR",
+						DisplayName: "Jawa Timur",
+					},
+				},
+			},
+		},
+	}
+
+	t.Run("TestBindSparseFields", func(t *testing.T) {
+		t.Run("it should select nothing, when there is no fields and expand query param", func(t *testing.T) {
+			var query model.ListQuery
+			got, err := bindSparseFields(newContext("/api/v1/villages"), &query, "id", "name", "district")
+
+			assert.NoError(t, err)
+			assert.Equal(t, sparseFields{}, got)
+			assert.Nil(t, query.Expand)
+		})
+
+		t.Run("it should expand no parent, when given fields without parent", func(t *testing.T) {
+			var query model.ListQuery
+			got, err := bindSparseFields(newContext("/api/v1/villages?fields=id,name"), &query, "id", "name", "district")
+
+			assert.NoError(t, err)
+			assert.Equal(t, map[string]bool{"id": true, "name": true}, got.fields)
+			assert.Equal(t, []string{}, query.Expand)
+		})
+
+		t.Run("it should expand the parents listed in fields, when given fields without expand", func(t *testing.T) {
+			var query model.ListQuery
+			_, err := bindSparseFields(newContext("/api/v1/villages?fields=id,district"), &query, "id", "name", "district")
+
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"district"}, query.Expand)
+		})
+
+		t.Run("it should expand the given parents, when given expand", func(t *testing.T) {
+			var query model.ListQuery
+			got, err := bindSparseFields(newContext("/api/v1/villages?expand=district,regency"), &query, "id", "name", "district")
+
+			assert.NoError(t, err)
+			assert.Nil(t, got.fields)
+			assert.Equal(t, []string{"district", "regency"}, query.Expand)
+		})
+
+		t.Run("it should return bad request error, when given unknown field", func(t *testing.T) {
+			var query model.ListQuery
+			_, err := bindSparseFields(newContext("/api/v1/villages?fields=id,population"), &query, "id", "name", "district")
+
+			if assert.Error(t, err) {
+				if assert.IsType(t, &echo.HTTPError{}, err) {
+					assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+				}
+			}
+		})
+	})
+
+	t.Run("TestProject", func(t *testing.T) {
+		t.Run("it should return the items as they are, when nothing is selected", func(t *testing.T) {
+			got, err := project(dummyVillages, sparseFields{})
+
+			assert.NoError(t, err)
+			assert.Equal(t, dummyVillages, got)
+		})
+
+		t.Run("it should keep only the selected fields, when given fields", func(t *testing.T) {
+			got, err := project(dummyVillages, sparseFields{fields: map[string]bool{"id": true, "name": true}, expand: map[string]bool{}})
+
+			assert.NoError(t, err)
+			assert.Equal(t, []map[string]any{{"id": "3502010001", "name": "BAOSANKIDUL"}}, got)
+		})
+
+		t.Run("it should keep the parents up to the expanded one, when given expand", func(t *testing.T) {
+			got, err := project(dummyVillages, sparseFields{expand: map[string]bool{"regency": true}})
+
+			assert.NoError(t, err)
+			assert.Equal(t, []map[string]any{
+				{
+					"id":           "3502010001",
+					"name":         "BAOSANKIDUL",
+					"display_name": "Baosankidul",
+					"type":         "desa",
+					"district": map[string]any{
+						"id":           "3502010",
+						"name":         "NGRAYUN",
+						"display_name": "Ngrayun",
+						"regency": map[string]any{
+							"id":           "3502",
+							"name":         "KABUPATEN PONOROGO",
+							"display_name": "Kabupaten Ponorogo",
+						},
+					},
+				},
+			}, got)
+		})
+	})
+}
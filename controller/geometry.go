@@ -0,0 +1,42 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+const mimeGeoJSON = "application/geo+json"
+
+func bindTolerance(c echo.Context) (tolerance float64, err error) {
+	param := c.QueryParam("tolerance")
+	if param == "" {
+		return
+	}
+
+	if tolerance, err = strconv.ParseFloat(param, 64); err != nil {
+		err = echo.NewHTTPError(http.StatusBadRequest, "Query param tolerance must be a number.")
+	}
+	return
+}
+
+// bindPoint leaves the ranges of lat and lng to be checked by the services.
+func bindPoint(c echo.Context) (lat, lng float64, err error) {
+	lat, latErr := strconv.ParseFloat(c.QueryParam("lat"), 64)
+	lng, lngErr := strconv.ParseFloat(c.QueryParam("lng"), 64)
+	if latErr != nil || lngErr != nil {
+		err = echo.NewHTTPError(http.StatusBadRequest, "Query params lat and lng must be numbers.")
+	}
+	return
+}
+
+func geoJSON(c echo.Context, object any) error {
+	body, err := json.Marshal(object)
+	if err != nil {
+		return err
+	}
+
+	return c.Blob(http.StatusOK, mimeGeoJSON, body)
+}
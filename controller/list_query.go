@@ -0,0 +1,65 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/erikrios/ponorogo-regency-api/model"
+	"github.com/labstack/echo/v4"
+)
+
+var listParams = map[string]bool{
+	"keyword": true,
+	"page":    true,
+	"limit":   true,
+	"after":   true,
+	"sort":    true,
+	"fields":  true,
+	"expand":  true,
+	"match":   true,
+}
+
+func bindListQuery(c echo.Context) (query model.ListQuery, err error) {
+	query.Page = 1
+	query.Limit = defaultLimit
+	query.After = c.QueryParam("after")
+	query.Sort = c.QueryParam("sort")
+	query.Match = c.QueryParam("match")
+
+	if query.Match != "" && query.Match != model.MatchContains && query.Match != model.MatchFuzzy {
+		err = echo.NewHTTPError(http.StatusBadRequest, "Query param match must be contains or fuzzy.")
+		return
+	}
+
+	if page := c.QueryParam("page"); page != "" {
+		if query.After != "" {
+			err = echo.NewHTTPError(http.StatusBadRequest, "Query params page and after can't be used together.")
+			return
+		}
+
+		if query.Page, err = strconv.Atoi(page); err != nil || query.Page < 1 {
+			err = echo.NewHTTPError(http.StatusBadRequest, "Query param page must be a positive integer.")
+			return
+		}
+	}
+
+	if limit := c.QueryParam("limit"); limit != "" {
+		if query.Limit, err = strconv.Atoi(limit); err != nil || query.Limit < 1 || query.Limit > maxLimit {
+			err = echo.NewHTTPError(http.StatusBadRequest, "Query param limit must be an integer between 1 and 100.")
+			return
+		}
+	}
+
+	for param, values := range c.QueryParams() {
+		if listParams[param] || len(values) == 0 {
+			continue
+		}
+
+		if query.Filters == nil {
+			query.Filters = make(map[string]string)
+		}
+		query.Filters[param] = values[0]
+	}
+
+	return
+}
@@ -0,0 +1,90 @@
+package controller
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/erikrios/ponorogo-regency-api/model"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListQuery(t *testing.T) {
+	newContext := func(target string) echo.Context {
+		req := httptest.NewRequest(http.MethodGet, target, nil)
+		return echo.New().NewContext(req, httptest.NewRecorder())
+	}
+
+	t.Run("TestBindListQuery", func(t *testing.T) {
+		t.Run("it should return default query, when there is no query param", func(t *testing.T) {
+			got, err := bindListQuery(newContext("/api/v1/villages"))
+			assert.NoError(t, err)
+			assert.Equal(t, model.ListQuery{Page: 1, Limit: defaultLimit}, got)
+		})
+
+		t.Run("it should return given query, when query params are valid", func(t *testing.T) {
+			got, err := bindListQuery(newContext("/api/v1/villages?page=3&limit=10"))
+			assert.NoError(t, err)
+			assert.Equal(t, model.ListQuery{Page: 3, Limit: 10}, got)
+
+			got, err = bindListQuery(newContext("/api/v1/villages?after=3502010001&limit=10"))
+			assert.NoError(t, err)
+			assert.Equal(t, model.ListQuery{Page: 1, Limit: 10, After: "3502010001"}, got)
+		})
+
+		t.Run("it should take the other query params as filters, when given filters and sort", func(t *testing.T) {
+			got, err := bindListQuery(newContext("/api/v1/villages?district_id=3502010&name=WONO&sort=-name&keyword=wo"))
+			assert.NoError(t, err)
+			assert.Equal(t, model.ListQuery{
+				Page:    1,
+				Limit:   defaultLimit,
+				Sort:    "-name",
+				Filters: map[string]string{"district_id": "3502010", "name": "WONO"},
+			}, got)
+		})
+
+		t.Run("it should read the match mode, when given match", func(t *testing.T) {
+			got, err := bindListQuery(newContext("/api/v1/villages?keyword=babdan&match=fuzzy"))
+			assert.NoError(t, err)
+			assert.Equal(t, model.ListQuery{Page: 1, Limit: defaultLimit, Match: model.MatchFuzzy}, got)
+		})
+
+		testCases := []struct {
+			name   string
+			target string
+		}{
+			{
+				name:   "it should return bad request error, when match is unknown",
+				target: "/api/v1/villages?keyword=babdan&match=regex",
+			},
+			{
+				name:   "it should return bad request error, when page is not a number",
+				target: "/api/v1/villages?page=abc",
+			},
+			{
+				name:   "it should return bad request error, when page is less than 1",
+				target: "/api/v1/villages?page=0",
+			},
+			{
+				name:   "it should return bad request error, when limit is more than max limit",
+				target: "/api/v1/villages?limit=101",
+			},
+			{
+				name:   "it should return bad request error, when page and after are both given",
+				target: "/api/v1/villages?page=2&after=3502010001",
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				_, err := bindListQuery(newContext(testCase.target))
+				if assert.Error(t, err) {
+					if assert.IsType(t, &echo.HTTPError{}, err) {
+						assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+					}
+				}
+			})
+		}
+	})
+}
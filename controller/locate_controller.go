@@ -0,0 +1,62 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/erikrios/ponorogo-regency-api/model"
+	"github.com/erikrios/ponorogo-regency-api/service"
+	"github.com/labstack/echo/v4"
+)
+
+type locateController struct {
+	service service.LocateService
+}
+
+func NewLocateController(service service.LocateService) *locateController {
+	return &locateController{service: service}
+}
+
+func (l *locateController) Route(g *echo.Group) {
+	g.GET("/locate", l.locate)
+}
+
+// Locate        godoc
+// @Summary      Locate
+// @Description  Get the village, district, regency and province whose boundaries contain a point, without the village when only the district boundary contains it
+// @Tags         locate
+// @Accept       json
+// @Produce      json
+// @Param        lat  query     number  true  "latitude of the point, from -90 to 90"
+// @Param        lng  query     number  true  "longitude of the point, from -180 to 180"
+// @Success      200  {object}  locateResponse
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /locate [get]
+func (l *locateController) locate(c echo.Context) error {
+	lat, lng, err := bindPoint(c)
+	if err != nil {
+		return err
+	}
+
+	location, err := l.service.Locate(c.Request().Context(), lat, lng)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	message := fmt.Sprintf("successfully locate district with ID %s", location.District.ID)
+	if location.Village != nil {
+		message = fmt.Sprintf("successfully locate village with ID %s", location.Village.ID)
+	}
+
+	response := model.NewResponse("success", message, location)
+	return c.JSON(http.StatusOK, response)
+}
+
+// locateResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
+type locateResponse struct {
+	Status  string         `json:"status"`
+	Message string         `json:"message"`
+	Data    model.Location `json:"data"`
+}
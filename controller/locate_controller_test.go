@@ -0,0 +1,181 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/erikrios/ponorogo-regency-api/model"
+	"github.com/erikrios/ponorogo-regency-api/service"
+	"github.com/erikrios/ponorogo-regency-api/service/mocks"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestLocateController(t *testing.T) {
+	t.Run("TestNewLocateController", func(t *testing.T) {
+		mockService := &mocks.LocateService{}
+		controller := NewLocateController(mockService)
+		assert.NotNil(t, controller)
+	})
+
+	t.Run("TestRoute", func(t *testing.T) {
+		mockService := &mocks.LocateService{}
+		controller := NewLocateController(mockService)
+		g := echo.New().Group("/api/v1")
+		controller.Route(g)
+		assert.NotNil(t, controller)
+	})
+
+	t.Run("TestLocate", func(t *testing.T) {
+		mockService := &mocks.LocateService{}
+
+		dummyLocation := model.Location{
+			Village:  &model.Village{ID: "3502180001", Name: "KERTOSARI", Type: "kelurahan"},
+			District: model.District{ID: "3502180", Name: "BABADAN"},
+			Regency:  model.Regency{ID: "3502", Name: "KABUPATEN PONOROGO"},
+			Province: model.Province{ID: "35", Name: "JAWA TIMUR"},
+		}
+
+		t.Run("success scenario", func(t *testing.T) {
+			mockService.On("Locate", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), -7.87, 111.47).Return(
+				func(ctx context.Context, lat, lng float64) model.Location {
+					return dummyLocation
+				},
+				func(ctx context.Context, lat, lng float64) error {
+					return nil
+				},
+			).Once()
+
+			t.Run("it should return 200 status code with the location, when there is no error", func(t *testing.T) {
+				controller := NewLocateController(mockService)
+
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodGet, "/api/v1/locate?lat=-7.87&lng=111.47", nil)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+
+				if assert.NoError(t, controller.locate(c)) {
+					assert.Equal(t, http.StatusOK, rec.Code)
+
+					var response model.Response[model.Location]
+					if assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response)) {
+						assert.Equal(t, "success", response.Status)
+						assert.Equal(t, "successfully locate village with ID 3502180001", response.Message)
+						assert.Equal(t, dummyLocation, response.Data)
+					}
+				}
+			})
+		})
+
+		t.Run("district scenario", func(t *testing.T) {
+			districtLocation := dummyLocation
+			districtLocation.Village = nil
+
+			mockService.On("Locate", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), -7.8, 111.47).Return(
+				func(ctx context.Context, lat, lng float64) model.Location {
+					return districtLocation
+				},
+				func(ctx context.Context, lat, lng float64) error {
+					return nil
+				},
+			).Once()
+
+			t.Run("it should return 200 status code with the location without village, when only a district contains the point", func(t *testing.T) {
+				controller := NewLocateController(mockService)
+
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodGet, "/api/v1/locate?lat=-7.8&lng=111.47", nil)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+
+				if assert.NoError(t, controller.locate(c)) {
+					assert.Equal(t, http.StatusOK, rec.Code)
+					assert.NotContains(t, rec.Body.String(), `"village"`)
+
+					var response model.Response[model.Location]
+					if assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response)) {
+						assert.Equal(t, "successfully locate district with ID 3502180", response.Message)
+						assert.Equal(t, districtLocation, response.Data)
+					}
+				}
+			})
+		})
+
+		t.Run("failed scenario", func(t *testing.T) {
+			mockService.On("Locate", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), -7.8, 111.47).Return(
+				func(ctx context.Context, lat, lng float64) model.Location {
+					return model.Location{}
+				},
+				func(ctx context.Context, lat, lng float64) error {
+					return service.ErrNotLocated
+				},
+			).Once()
+			mockService.On("Locate", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), -7.87, 111.47).Return(
+				func(ctx context.Context, lat, lng float64) model.Location {
+					return model.Location{}
+				},
+				func(ctx context.Context, lat, lng float64) error {
+					return service.ErrRepository
+				},
+			).Once()
+
+			testCases := []struct {
+				name               string
+				target             string
+				expectedStatusCode int
+				expectedMessage    string
+			}{
+				{
+					name:               "it should return 400 status code with valid response, when lng is missing",
+					target:             "/api/v1/locate?lat=-7.87",
+					expectedStatusCode: http.StatusBadRequest,
+					expectedMessage:    "Query params lat and lng must be numbers.",
+				},
+				{
+					name:               "it should return 400 status code with valid response, when lat isn't a number",
+					target:             "/api/v1/locate?lat=south&lng=111.47",
+					expectedStatusCode: http.StatusBadRequest,
+					expectedMessage:    "Query params lat and lng must be numbers.",
+				},
+				{
+					name:               "it should return 404 status code with valid response, when no village or district contains the point",
+					target:             "/api/v1/locate?lat=-7.8&lng=111.47",
+					expectedStatusCode: http.StatusNotFound,
+					expectedMessage:    "No village or district contains the given point.",
+				},
+				{
+					name:               "it should return 500 status code with valid response, when error happened",
+					target:             "/api/v1/locate?lat=-7.87&lng=111.47",
+					expectedStatusCode: http.StatusInternalServerError,
+					expectedMessage:    "Something went wrong.",
+				},
+			}
+
+			for _, testCase := range testCases {
+				t.Run(testCase.name, func(t *testing.T) {
+					controller := NewLocateController(mockService)
+
+					e := echo.New()
+					req := httptest.NewRequest(http.MethodGet, testCase.target, nil)
+					rec := httptest.NewRecorder()
+					c := e.NewContext(req, rec)
+
+					gotError := controller.locate(c)
+					if assert.Error(t, gotError) {
+						if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+							assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+							assert.Equal(t, testCase.expectedMessage, echoHTTPError.Message)
+						}
+					}
+				})
+			}
+
+			mockService.AssertExpectations(t)
+		})
+	})
+}
@@ -0,0 +1,56 @@
+package controller
+
+import (
+	"strconv"
+
+	"github.com/erikrios/ponorogo-regency-api/model"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	defaultLimit = 50
+	maxLimit     = 100
+)
+
+// newPagination only links forward for lists read with the after cursor.
+func newPagination[T any](c echo.Context, query model.ListQuery, total int, items []T, idOf func(T) string) *model.Pagination {
+	pagination := &model.Pagination{
+		Limit: query.Limit,
+		Total: total,
+	}
+
+	if query.After != "" {
+		if len(items) == query.Limit {
+			pagination.Next = pageLink(c, "after", idOf(items[len(items)-1]))
+		}
+		return pagination
+	}
+
+	pagination.Page = query.Page
+
+	if query.Page*query.Limit < total {
+		pagination.Next = pageLink(c, "page", strconv.Itoa(query.Page+1))
+	}
+
+	if query.Page > 1 {
+		prev := query.Page - 1
+		if lastPage := (total + query.Limit - 1) / query.Limit; prev > lastPage {
+			prev = lastPage
+		}
+		if prev > 0 {
+			pagination.Prev = pageLink(c, "page", strconv.Itoa(prev))
+		}
+	}
+
+	return pagination
+}
+
+func pageLink(c echo.Context, key string, value string) string {
+	u := *c.Request().URL
+	values := u.Query()
+	values.Del("page")
+	values.Del("after")
+	values.Set(key, value)
+	u.RawQuery = values.Encode()
+	return u.RequestURI()
+}
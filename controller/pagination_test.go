@@ -0,0 +1,54 @@
+package controller
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/erikrios/ponorogo-regency-api/model"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPagination(t *testing.T) {
+	newContext := func(target string) echo.Context {
+		req := httptest.NewRequest(http.MethodGet, target, nil)
+		return echo.New().NewContext(req, httptest.NewRecorder())
+	}
+
+	t.Run("TestNewPagination", func(t *testing.T) {
+		idOf := func(id string) string { return id }
+
+		t.Run("it should link to the next and previous pages, when given page is in the middle", func(t *testing.T) {
+			c := newContext("/api/v1/villages?keyword=wono&page=2&limit=2")
+			got := newPagination(c, model.ListQuery{Page: 2, Limit: 2}, 5, []string{"3", "4"}, idOf)
+
+			assert.Equal(t, &model.Pagination{
+				Page:  2,
+				Limit: 2,
+				Total: 5,
+				Next:  "/api/v1/villages?keyword=wono&limit=2&page=3",
+				Prev:  "/api/v1/villages?keyword=wono&limit=2&page=1",
+			}, got)
+		})
+
+		t.Run("it should not link to the next page, when given page is the last one", func(t *testing.T) {
+			c := newContext("/api/v1/villages?page=3&limit=2")
+			got := newPagination(c, model.ListQuery{Page: 3, Limit: 2}, 5, []string{"5"}, idOf)
+
+			assert.Empty(t, got.Next)
+			assert.Equal(t, "/api/v1/villages?limit=2&page=2", got.Prev)
+		})
+
+		t.Run("it should link to the next cursor, when given after and the page is full", func(t *testing.T) {
+			c := newContext("/api/v1/villages?after=1&limit=2")
+			got := newPagination(c, model.ListQuery{Page: 1, Limit: 2, After: "1"}, 5, []string{"2", "3"}, idOf)
+
+			assert.Equal(t, &model.Pagination{
+				Limit: 2,
+				Total: 5,
+				Next:  "/api/v1/villages?after=3&limit=2",
+			}, got)
+		})
+	})
+}
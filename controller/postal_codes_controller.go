@@ -0,0 +1,56 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/erikrios/ponorogo-regency-api/model"
+	"github.com/erikrios/ponorogo-regency-api/service"
+	"github.com/labstack/echo/v4"
+)
+
+type postalCodesController struct {
+	service service.VillageService
+}
+
+func NewPostalCodesController(service service.VillageService) *postalCodesController {
+	return &postalCodesController{service: service}
+}
+
+func (p *postalCodesController) Route(g *echo.Group) {
+	group := g.Group("/postal-codes")
+	group.GET("/:code", p.getVillages)
+}
+
+// GetVillages   godoc
+// @Summary      Get Villages by Postal Code
+// @Description  Get every village served by a postal code
+// @Tags         postal-codes
+// @Accept       json
+// @Produce      json
+// @Param        code  path      string  true  "Postal code of 5 digits"
+// @Success      200   {object}  postalCodeVillagesResponse
+// @Failure      400   {object}  echo.HTTPError
+// @Failure      404   {object}  echo.HTTPError
+// @Failure      500   {object}  echo.HTTPError
+// @Router       /postal-codes/{code} [get]
+func (p *postalCodesController) getVillages(c echo.Context) error {
+	code := c.Param("code")
+
+	villages, err := p.service.GetByPostalCode(c.Request().Context(), code)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	villagesResponse := map[string]any{"villages": villages}
+
+	response := model.NewResponse("success", fmt.Sprintf("successfully get villages with postal code %s", code), villagesResponse)
+	return c.JSON(http.StatusOK, response)
+}
+
+// postalCodeVillagesResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
+type postalCodeVillagesResponse struct {
+	Status  string       `json:"status"`
+	Message string       `json:"message"`
+	Data    villagesData `json:"data"`
+}
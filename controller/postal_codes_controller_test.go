@@ -0,0 +1,154 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/erikrios/ponorogo-regency-api/model"
+	"github.com/erikrios/ponorogo-regency-api/service"
+	"github.com/erikrios/ponorogo-regency-api/service/mocks"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPostalCodesController(t *testing.T) {
+	t.Run("TestNewPostalCodesController", func(t *testing.T) {
+		mockService := &mocks.VillageService{}
+		controller := NewPostalCodesController(mockService)
+		assert.NotNil(t, controller)
+	})
+
+	t.Run("TestRoute", func(t *testing.T) {
+		mockService := &mocks.VillageService{}
+		controller := NewPostalCodesController(mockService)
+		g := echo.New().Group("/api/v1")
+		controller.Route(g)
+		assert.NotNil(t, controller)
+	})
+
+	t.Run("TestGetVillages", func(t *testing.T) {
+		mockService := &mocks.VillageService{}
+
+		postalCode := "63472"
+		dummyVillages := []model.Village{
+			{ID: "3502080003", Name: "TOTOKAN", Type: "desa", PostalCodes: []string{postalCode}, District: model.District{ID: "3502080", Name: "MLARAK"}},
+			{ID: "3502080007", Name: "NGLUMPANG", Type: "desa", PostalCodes: []string{postalCode}, District: model.District{ID: "3502080", Name: "MLARAK"}},
+		}
+
+		t.Run("success scenario", func(t *testing.T) {
+			mockService.On("GetByPostalCode", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), postalCode).Return(
+				func(ctx context.Context, code string) []model.Village {
+					return dummyVillages
+				},
+				func(ctx context.Context, code string) error {
+					return nil
+				},
+			).Once()
+
+			t.Run("it should return 200 status code with the villages, when there is no error", func(t *testing.T) {
+				controller := NewPostalCodesController(mockService)
+
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodGet, "/api/v1/postal-codes/63472", nil)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+				c.SetPath("/:code")
+				c.SetParamNames("code")
+				c.SetParamValues(postalCode)
+
+				if assert.NoError(t, controller.getVillages(c)) {
+					assert.Equal(t, http.StatusOK, rec.Code)
+
+					var response model.Response[map[string][]model.Village]
+					if assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response)) {
+						assert.Equal(t, "success", response.Status)
+						assert.Equal(t, "successfully get villages with postal code 63472", response.Message)
+						assert.Equal(t, dummyVillages, response.Data["villages"])
+					}
+				}
+			})
+		})
+
+		t.Run("failed scenario", func(t *testing.T) {
+			mockService.On("GetByPostalCode", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), "6347").Return(
+				func(ctx context.Context, code string) []model.Village {
+					return nil
+				},
+				func(ctx context.Context, code string) error {
+					return &service.IDError{Level: "postal", Field: "code", ID: code, Reason: "must consist of 5 digits, not starting with 0"}
+				},
+			).Once()
+			mockService.On("GetByPostalCode", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), "63499").Return(
+				func(ctx context.Context, code string) []model.Village {
+					return nil
+				},
+				func(ctx context.Context, code string) error {
+					return service.ErrDataNotFound
+				},
+			).Once()
+			mockService.On("GetByPostalCode", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), postalCode).Return(
+				func(ctx context.Context, code string) []model.Village {
+					return nil
+				},
+				func(ctx context.Context, code string) error {
+					return service.ErrRepository
+				},
+			).Once()
+
+			testCases := []struct {
+				name               string
+				code               string
+				expectedStatusCode int
+				expectedMessage    string
+			}{
+				{
+					name:               "it should return 400 status code with valid response, when the code is invalid",
+					code:               "6347",
+					expectedStatusCode: http.StatusBadRequest,
+					expectedMessage:    `Invalid postal ID "6347": it must consist of 5 digits, not starting with 0.`,
+				},
+				{
+					name:               "it should return 404 status code with valid response, when the code serves no village",
+					code:               "63499",
+					expectedStatusCode: http.StatusNotFound,
+					expectedMessage:    "Resource with given ID not found.",
+				},
+				{
+					name:               "it should return 500 status code with valid response, when error happened",
+					code:               postalCode,
+					expectedStatusCode: http.StatusInternalServerError,
+					expectedMessage:    "Something went wrong.",
+				},
+			}
+
+			for _, testCase := range testCases {
+				t.Run(testCase.name, func(t *testing.T) {
+					controller := NewPostalCodesController(mockService)
+
+					e := echo.New()
+					req := httptest.NewRequest(http.MethodGet, "/api/v1/postal-codes", nil)
+					rec := httptest.NewRecorder()
+					c := e.NewContext(req, rec)
+					c.SetPath("/:code")
+					c.SetParamNames("code")
+					c.SetParamValues(testCase.code)
+
+					gotError := controller.getVillages(c)
+					if assert.Error(t, gotError) {
+						if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+							assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+							assert.Equal(t, testCase.expectedMessage, echoHTTPError.Message)
+						}
+					}
+				})
+			}
+
+			mockService.AssertExpectations(t)
+		})
+	})
+}
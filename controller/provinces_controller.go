@@ -21,6 +21,8 @@ func (p *provincesController) Route(g *echo.Group) {
 	group := g.Group("/provinces")
 	group.GET("", p.getAll)
 	group.GET("/:id", p.getByID)
+	group.GET("/:id/regencies", p.getRegenciesByProvinceID)
+	group.GET("/:id/tree", p.getTree)
 }
 
 // GetAll	       godoc
@@ -30,19 +32,44 @@ func (p *provincesController) Route(g *echo.Group) {
 // @Accept       json
 // @Produce      json
 // @Param        keyword  query     string  false  "province name search by keyword"
+// @Param        match    query     string  false  "how keyword is matched, fuzzy ranks typo-tolerant matches by their score, from 0 to 1"  Enums(contains, fuzzy)
+// @Param        page     query     int     false  "page number, starting from 1"
+// @Param        limit    query     int     false  "maximum number of items per page, from 1 to 100 (default 50)"
+// @Param        after    query     string  false  "only return items after this ID, can't be combined with page"
+// @Param        sort     query     string  false  "sort by id or name, descending when prefixed with -"  Enums(id, -id, name, -name)
+// @Param        name     query     string  false  "only return items whose name contains this value"
+// @Param        fields   query     string  false  "comma-separated fields of each item to return, such as id,name"
+// @Param        expand   query     string  false  "comma-separated parents to embed in each item, all of them when omitted"
 // @Success      200      {object}  provincesResponse
+// @Failure      400      {object}  echo.HTTPError
 // @Failure      500      {object}  echo.HTTPError
 // @Router       /provinces [get]
 func (p *provincesController) getAll(c echo.Context) error {
 	keyword := c.QueryParam("keyword")
 
-	provinces, err := p.service.GetAll(c.Request().Context(), keyword)
+	query, err := bindListQuery(c)
+	if err != nil {
+		return err
+	}
+
+	sparse, err := bindSparseFields(c, &query, "id", "name", "display_name", "score")
+	if err != nil {
+		return err
+	}
+
+	provinces, total, err := p.service.GetAll(c.Request().Context(), keyword, query)
 	if err != nil {
 		return newErrorResponse(err)
 	}
 
-	provincesResponse := map[string]any{"provinces": provinces}
-	response := model.NewResponse("success", "successfully get provinces", provincesResponse)
+	items, err := project(provinces, sparse)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	provincesResponse := map[string]any{"provinces": items}
+	pagination := newPagination(c, query, total, provinces, func(province model.Province) string { return province.ID })
+	response := model.NewResponse("success", "successfully get provinces", provincesResponse).WithPagination(pagination)
 	return c.JSON(http.StatusOK, response)
 }
 
@@ -54,6 +81,7 @@ func (p *provincesController) getAll(c echo.Context) error {
 // @Produce      json
 // @Param        id   path      int  true  "Province ID"
 // @Success      200  {object}  provinceResponse
+// @Failure      400  {object}  echo.HTTPError
 // @Failure      404  {object}  echo.HTTPError
 // @Failure      500  {object}  echo.HTTPError
 // @Router       /provinces/{id} [get]
@@ -69,11 +97,91 @@ func (p *provincesController) getByID(c echo.Context) error {
 	return c.JSON(http.StatusOK, response)
 }
 
+// GetRegenciesByProvinceID     godoc
+// @Summary      Get Regencies by Province ID
+// @Description  Get regencies by province ID
+// @Tags         provinces
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Province ID"
+// @Param        page     query     int     false  "page number, starting from 1"
+// @Param        limit    query     int     false  "maximum number of items per page, from 1 to 100 (default 50)"
+// @Param        after    query     string  false  "only return items after this ID, can't be combined with page"
+// @Param        sort     query     string  false  "sort by id or name, descending when prefixed with -"  Enums(id, -id, name, -name)
+// @Param        name     query     string  false  "only return items whose name contains this value"
+// @Param        fields   query     string  false  "comma-separated fields of each item to return, such as id,name"
+// @Param        expand   query     string  false  "comma-separated parents to embed in each item, all of them when omitted"
+// @Success      200      {object}  regenciesResponse
+// @Failure      400      {object}  echo.HTTPError
+// @Failure      404      {object}  echo.HTTPError
+// @Failure      500      {object}  echo.HTTPError
+// @Router       /provinces/{id}/regencies [get]
+func (p *provincesController) getRegenciesByProvinceID(c echo.Context) error {
+	id := c.Param("id")
+
+	query, err := bindListQuery(c)
+	if err != nil {
+		return err
+	}
+
+	sparse, err := bindSparseFields(c, &query, "id", "name", "display_name", "province")
+	if err != nil {
+		return err
+	}
+
+	regencies, total, err := p.service.GetRegenciesByProvinceID(c.Request().Context(), id, query)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	items, err := project(regencies, sparse)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	regenciesResponse := map[string]any{"regencies": items}
+
+	pagination := newPagination(c, query, total, regencies, func(regency model.Regency) string { return regency.ID })
+	response := model.NewResponse("success", fmt.Sprintf("successfully get regencies with province ID %s", id), regenciesResponse).WithPagination(pagination)
+	return c.JSON(http.StatusOK, response)
+}
+
+// GetTree       godoc
+// @Summary      Get Province Tree
+// @Description  Get a province with its regencies, districts and villages nested
+// @Tags         provinces
+// @Accept       json
+// @Produce      json
+// @Param        id     path      int  true   "Province ID"
+// @Param        depth  query     int  false  "number of levels below the province, from 0 to 3 (default 3)"
+// @Success      200    {object}  provinceTreeResponse
+// @Failure      400    {object}  echo.HTTPError
+// @Failure      404    {object}  echo.HTTPError
+// @Failure      500    {object}  echo.HTTPError
+// @Router       /provinces/{id}/tree [get]
+func (p *provincesController) getTree(c echo.Context) error {
+	id := c.Param("id")
+
+	depth, err := bindDepth(c, provinceTreeDepth)
+	if err != nil {
+		return err
+	}
+
+	tree, err := p.service.GetTree(c.Request().Context(), id, depth)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	response := model.NewResponse("success", fmt.Sprintf("successfully get tree of province with ID %s", id), tree)
+	return c.JSON(http.StatusOK, response)
+}
+
 // provincesResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
 type provincesResponse struct {
-	Status  string        `json:"status"`
-	Message string        `json:"message"`
-	Data    provincesData `json:"data"`
+	Status     string           `json:"status"`
+	Message    string           `json:"message"`
+	Data       provincesData    `json:"data"`
+	Pagination model.Pagination `json:"pagination"`
 }
 
 type provincesData struct {
@@ -86,3 +194,10 @@ type provinceResponse struct {
 	Message string         `json:"message"`
 	Data    model.Province `json:"data"`
 }
+
+// provinceTreeResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
+type provinceTreeResponse struct {
+	Status  string             `json:"status"`
+	Message string             `json:"message"`
+	Data    model.ProvinceTree `json:"data"`
+}
@@ -42,11 +42,14 @@ func TestProvincesController(t *testing.T) {
 		}
 
 		t.Run("success scenario", func(t *testing.T) {
-			mockService.On("GetAll", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string")).Return(
-				func(ctx context.Context, keyword string) []model.Province {
+			mockService.On("GetAll", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string"), mock.AnythingOfType("model.ListQuery")).Return(
+				func(ctx context.Context, keyword string, query model.ListQuery) []model.Province {
 					return dummyProvinces
 				},
-				func(ctx context.Context, keyword string) error {
+				func(ctx context.Context, keyword string, query model.ListQuery) int {
+					return len(dummyProvinces)
+				},
+				func(ctx context.Context, keyword string, query model.ListQuery) error {
 					return nil
 				},
 			).Once()
@@ -87,11 +90,14 @@ func TestProvincesController(t *testing.T) {
 		})
 
 		t.Run("failed scenario", func(t *testing.T) {
-			mockService.On("GetAll", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string")).Return(
-				func(ctx context.Context, keyword string) []model.Province {
+			mockService.On("GetAll", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string"), mock.AnythingOfType("model.ListQuery")).Return(
+				func(ctx context.Context, keyword string, query model.ListQuery) []model.Province {
 					return []model.Province{}
 				},
-				func(ctx context.Context, keyword string) error {
+				func(ctx context.Context, keyword string, query model.ListQuery) int {
+					return 0
+				},
+				func(ctx context.Context, keyword string, query model.ListQuery) error {
 					return service.ErrRepository
 				},
 			).Once()
@@ -170,12 +176,15 @@ func TestProvincesController(t *testing.T) {
 					return model.Province{}
 				},
 				func(ctx context.Context, id string) error {
+					if len(id) != len(dummyProvince.ID) {
+						return &service.IDError{Level: "province", Field: "id", ID: id, Reason: "must consist of exactly 2 digits"}
+					}
 					if id != dummyProvince.ID {
 						return service.ErrDataNotFound
 					}
 					return service.ErrRepository
 				},
-			).Twice()
+			).Times(3)
 
 			testCases := []struct {
 				name               string
@@ -185,10 +194,16 @@ func TestProvincesController(t *testing.T) {
 			}{
 				{
 					name:               "it should return 404 status code with valid response, when given ID not found",
-					id:                 dummyProvince.ID + "1",
+					id:                 "90",
 					expectedStatusCode: http.StatusNotFound,
 					expectedMessage:    "Resource with given ID not found.",
 				},
+				{
+					name:               "it should return 400 status code with valid response, when given ID is malformed",
+					id:                 dummyProvince.ID + "1",
+					expectedStatusCode: http.StatusBadRequest,
+					expectedMessage:    `Invalid province ID "351": it must consist of exactly 2 digits.`,
+				},
 				{
 					name:               "it should return 500 status code with valid response, when error happened",
 					id:                 dummyProvince.ID,
@@ -220,4 +235,199 @@ func TestProvincesController(t *testing.T) {
 			}
 		})
 	})
+
+	t.Run("TestGetRegenciesByProvinceID", func(t *testing.T) {
+		mockService := &mocks.ProvinceService{}
+
+		dummyRegencies := []model.Regency{
+			{
+				ID:       "3502",
+				Name:     "KABUPATEN PONOROGO",
+				Province: model.Province{ID: "35", Name: "JAWA TIMUR"},
+			},
+		}
+
+		t.Run("success scenario", func(t *testing.T) {
+			mockService.On("GetRegenciesByProvinceID", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), "35", mock.AnythingOfType("model.ListQuery")).Return(
+				func(ctx context.Context, id string, query model.ListQuery) []model.Regency {
+					return dummyRegencies
+				},
+				func(ctx context.Context, id string, query model.ListQuery) int {
+					return len(dummyRegencies)
+				},
+				func(ctx context.Context, id string, query model.ListQuery) error {
+					return nil
+				},
+			).Once()
+
+			t.Run("it should return 200 status code with valid response, when there is no error", func(t *testing.T) {
+				controller := NewProvincesController(mockService)
+
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodGet, "/api/v1/provinces/35/regencies", nil)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+				c.SetPath("/:id/regencies")
+				c.SetParamNames("id")
+				c.SetParamValues("35")
+
+				if assert.NoError(t, controller.getRegenciesByProvinceID(c)) {
+					assert.Equal(t, http.StatusOK, rec.Code)
+
+					response := make(map[string]any)
+					if assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response)) {
+						data := response["data"].(map[string]any)["regencies"].([]any)
+
+						assert.Equal(t, "success", response["status"])
+						assert.Equal(t, "successfully get regencies with province ID 35", response["message"])
+						if assert.Len(t, data, len(dummyRegencies)) {
+							gotRegency := data[0].(map[string]any)
+							assert.Equal(t, dummyRegencies[0].ID, gotRegency["id"])
+							assert.Equal(t, dummyRegencies[0].Name, gotRegency["name"])
+							assert.Equal(t, "35", gotRegency["province"].(map[string]any)["id"])
+						}
+					}
+				}
+			})
+		})
+
+		t.Run("failed scenario", func(t *testing.T) {
+			mockService.On("GetRegenciesByProvinceID", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), "90", mock.AnythingOfType("model.ListQuery")).Return(
+				func(ctx context.Context, id string, query model.ListQuery) []model.Regency {
+					return nil
+				},
+				func(ctx context.Context, id string, query model.ListQuery) int {
+					return 0
+				},
+				func(ctx context.Context, id string, query model.ListQuery) error {
+					return service.ErrDataNotFound
+				},
+			).Once()
+
+			t.Run("it should return 404 status code, when the province doesn't exist", func(t *testing.T) {
+				controller := NewProvincesController(mockService)
+
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodGet, "/api/v1/provinces/90/regencies", nil)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+				c.SetPath("/:id/regencies")
+				c.SetParamNames("id")
+				c.SetParamValues("90")
+
+				gotError := controller.getRegenciesByProvinceID(c)
+				if assert.Error(t, gotError) {
+					if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+						assert.Equal(t, http.StatusNotFound, echoHTTPError.Code)
+						assert.Equal(t, "Resource with given ID not found.", echoHTTPError.Message)
+					}
+				}
+			})
+		})
+	})
+
+	t.Run("TestGetTree", func(t *testing.T) {
+		mockService := &mocks.ProvinceService{}
+
+		dummyTree := model.ProvinceTree{
+			ID:   "35",
+			Name: "JAWA TIMUR",
+			Regencies: []model.RegencyTree{
+				{
+					ID:   "3502",
+					Name: "KABUPATEN PONOROGO",
+				},
+			},
+		}
+
+		t.Run("success scenario", func(t *testing.T) {
+			mockService.On("GetTree", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), dummyTree.ID, 1).Return(
+				func(ctx context.Context, id string, depth int) model.ProvinceTree {
+					return dummyTree
+				},
+				func(ctx context.Context, id string, depth int) error {
+					return nil
+				},
+			).Once()
+
+			t.Run("it should return 200 status code with valid response, when there is no error", func(t *testing.T) {
+				controller := NewProvincesController(mockService)
+
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodGet, "/api/v1/provinces/35/tree?depth=1", nil)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+				c.SetPath("/:id/tree")
+				c.SetParamNames("id")
+				c.SetParamValues(dummyTree.ID)
+
+				if assert.NoError(t, controller.getTree(c)) {
+					assert.Equal(t, http.StatusOK, rec.Code)
+
+					response := make(map[string]any)
+					if assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response)) {
+						data := response["data"].(map[string]any)
+						regencies := data["regencies"].([]any)
+
+						assert.Equal(t, "success", response["status"])
+						assert.Equal(t, "successfully get tree of province with ID 35", response["message"])
+						assert.Equal(t, dummyTree.ID, data["id"])
+						if assert.Len(t, regencies, 1) {
+							assert.Equal(t, "3502", regencies[0].(map[string]any)["id"])
+							assert.NotContains(t, regencies[0], "districts")
+						}
+					}
+				}
+			})
+		})
+
+		t.Run("failed scenario", func(t *testing.T) {
+			mockService.On("GetTree", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string"), 3).Return(
+				func(ctx context.Context, id string, depth int) model.ProvinceTree {
+					return model.ProvinceTree{}
+				},
+				func(ctx context.Context, id string, depth int) error {
+					return service.ErrDataNotFound
+				},
+			).Once()
+
+			testCases := []struct {
+				name               string
+				target             string
+				expectedStatusCode int
+			}{
+				{
+					name:               "it should return 400 status code, when given depth out of range",
+					target:             "/api/v1/provinces/35/tree?depth=4",
+					expectedStatusCode: http.StatusBadRequest,
+				},
+				{
+					name:               "it should return 404 status code, when province not found",
+					target:             "/api/v1/provinces/36/tree",
+					expectedStatusCode: http.StatusNotFound,
+				},
+			}
+
+			for _, testCase := range testCases {
+				t.Run(testCase.name, func(t *testing.T) {
+					controller := NewProvincesController(mockService)
+
+					e := echo.New()
+					req := httptest.NewRequest(http.MethodGet, testCase.target, nil)
+					rec := httptest.NewRecorder()
+					c := e.NewContext(req, rec)
+					c.SetPath("/:id/tree")
+					c.SetParamNames("id")
+					c.SetParamValues("36")
+
+					gotError := controller.getTree(c)
+					if assert.Error(t, gotError) {
+						if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+							assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+						}
+					}
+				})
+			}
+		})
+	})
 }
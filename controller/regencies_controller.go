@@ -21,6 +21,8 @@ func (r *regenciesController) Route(g *echo.Group) {
 	group := g.Group("/regencies")
 	group.GET("", r.getAll)
 	group.GET("/:id", r.getByID)
+	group.GET("/:id/districts", r.getDistrictsByRegencyID)
+	group.GET("/:id/tree", r.getTree)
 }
 
 // GetAll	     	 godoc
@@ -30,20 +32,46 @@ func (r *regenciesController) Route(g *echo.Group) {
 // @Accept       json
 // @Produce      json
 // @Param        keyword  query     string  false  "regency name search by keyword"
+// @Param        match    query     string  false  "how keyword is matched, fuzzy ranks typo-tolerant matches by their score, from 0 to 1"  Enums(contains, fuzzy)
+// @Param        page     query     int     false  "page number, starting from 1"
+// @Param        limit    query     int     false  "maximum number of items per page, from 1 to 100 (default 50)"
+// @Param        after    query     string  false  "only return items after this ID, can't be combined with page"
+// @Param        sort     query     string  false  "sort by id or name, descending when prefixed with -"  Enums(id, -id, name, -name)
+// @Param        name     query     string  false  "only return items whose name contains this value"
+// @Param        province_id query     string  false  "only return items of this province"
+// @Param        fields   query     string  false  "comma-separated fields of each item to return, such as id,name"
+// @Param        expand   query     string  false  "comma-separated parents to embed in each item, all of them when omitted"
 // @Success      200      {object}  regenciesResponse
+// @Failure      400      {object}  echo.HTTPError
 // @Failure      500      {object}  echo.HTTPError
 // @Router       /regencies [get]
 func (r *regenciesController) getAll(c echo.Context) error {
 	keyword := c.QueryParam("keyword")
 
-	regencies, err := r.service.GetAll(c.Request().Context(), keyword)
+	query, err := bindListQuery(c)
+	if err != nil {
+		return err
+	}
+
+	sparse, err := bindSparseFields(c, &query, "id", "name", "display_name", "province", "score")
+	if err != nil {
+		return err
+	}
+
+	regencies, total, err := r.service.GetAll(c.Request().Context(), keyword, query)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	items, err := project(regencies, sparse)
 	if err != nil {
 		return newErrorResponse(err)
 	}
 
-	regenciesResponse := map[string]any{"regencies": regencies}
+	regenciesResponse := map[string]any{"regencies": items}
 
-	response := model.NewResponse("success", "successfully get regencies", regenciesResponse)
+	pagination := newPagination(c, query, total, regencies, func(regency model.Regency) string { return regency.ID })
+	response := model.NewResponse("success", "successfully get regencies", regenciesResponse).WithPagination(pagination)
 	return c.JSON(http.StatusOK, response)
 }
 
@@ -55,6 +83,7 @@ func (r *regenciesController) getAll(c echo.Context) error {
 // @Produce      json
 // @Param        id   path      int  true  "Regency ID"
 // @Success      200  {object}  regencyResponse
+// @Failure      400  {object}  echo.HTTPError
 // @Failure      404  {object}  echo.HTTPError
 // @Failure      500  {object}  echo.HTTPError
 // @Router       /regencies/{id} [get]
@@ -70,11 +99,92 @@ func (r *regenciesController) getByID(c echo.Context) error {
 	return c.JSON(http.StatusOK, response)
 }
 
+// GetDistrictsByRegencyID     godoc
+// @Summary      Get Districts by Regency ID
+// @Description  Get districts by regency ID
+// @Tags         regencies
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Regency ID"
+// @Param        page     query     int     false  "page number, starting from 1"
+// @Param        limit    query     int     false  "maximum number of items per page, from 1 to 100 (default 50)"
+// @Param        after    query     string  false  "only return items after this ID, can't be combined with page"
+// @Param        sort     query     string  false  "sort by id or name, descending when prefixed with -"  Enums(id, -id, name, -name)
+// @Param        name     query     string  false  "only return items whose name contains this value"
+// @Param        province_id query     string  false  "only return items of this province"
+// @Param        fields   query     string  false  "comma-separated fields of each item to return, such as id,name"
+// @Param        expand   query     string  false  "comma-separated parents to embed in each item, all of them when omitted"
+// @Success      200      {object}  districtsResponse
+// @Failure      400      {object}  echo.HTTPError
+// @Failure      404      {object}  echo.HTTPError
+// @Failure      500      {object}  echo.HTTPError
+// @Router       /regencies/{id}/districts [get]
+func (r *regenciesController) getDistrictsByRegencyID(c echo.Context) error {
+	id := c.Param("id")
+
+	query, err := bindListQuery(c)
+	if err != nil {
+		return err
+	}
+
+	sparse, err := bindSparseFields(c, &query, "id", "name", "display_name", "latitude", "longitude", "regency")
+	if err != nil {
+		return err
+	}
+
+	districts, total, err := r.service.GetDistrictsByRegencyID(c.Request().Context(), id, query)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	items, err := project(districts, sparse)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	districtsResponse := map[string]any{"districts": items}
+
+	pagination := newPagination(c, query, total, districts, func(district model.District) string { return district.ID })
+	response := model.NewResponse("success", fmt.Sprintf("successfully get districts with regency ID %s", id), districtsResponse).WithPagination(pagination)
+	return c.JSON(http.StatusOK, response)
+}
+
+// GetTree       godoc
+// @Summary      Get Regency Tree
+// @Description  Get a regency with its province, districts and villages nested
+// @Tags         regencies
+// @Accept       json
+// @Produce      json
+// @Param        id     path      int  true   "Regency ID"
+// @Param        depth  query     int  false  "number of levels below the regency, from 0 to 2 (default 2)"
+// @Success      200    {object}  regencyTreeResponse
+// @Failure      400    {object}  echo.HTTPError
+// @Failure      404    {object}  echo.HTTPError
+// @Failure      500    {object}  echo.HTTPError
+// @Router       /regencies/{id}/tree [get]
+func (r *regenciesController) getTree(c echo.Context) error {
+	id := c.Param("id")
+
+	depth, err := bindDepth(c, regencyTreeDepth)
+	if err != nil {
+		return err
+	}
+
+	tree, err := r.service.GetTree(c.Request().Context(), id, depth)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	response := model.NewResponse("success", fmt.Sprintf("successfully get tree of regency with ID %s", id), tree)
+	return c.JSON(http.StatusOK, response)
+}
+
 // regenciesResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
 type regenciesResponse struct {
-	Status  string        `json:"status"`
-	Message string        `json:"message"`
-	Data    regenciesData `json:"data"`
+	Status     string           `json:"status"`
+	Message    string           `json:"message"`
+	Data       regenciesData    `json:"data"`
+	Pagination model.Pagination `json:"pagination"`
 }
 
 type regenciesData struct {
@@ -87,3 +197,10 @@ type regencyResponse struct {
 	Message string        `json:"message"`
 	Data    model.Regency `json:"data"`
 }
+
+// regencyTreeResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
+type regencyTreeResponse struct {
+	Status  string            `json:"status"`
+	Message string            `json:"message"`
+	Data    model.RegencyTree `json:"data"`
+}
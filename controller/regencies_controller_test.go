@@ -46,11 +46,14 @@ func TestRegenciesController(t *testing.T) {
 		}
 
 		t.Run("success scenario", func(t *testing.T) {
-			mockService.On("GetAll", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string")).Return(
-				func(ctx context.Context, keyword string) []model.Regency {
+			mockService.On("GetAll", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string"), mock.AnythingOfType("model.ListQuery")).Return(
+				func(ctx context.Context, keyword string, query model.ListQuery) []model.Regency {
 					return dummyRegencies
 				},
-				func(ctx context.Context, keyword string) error {
+				func(ctx context.Context, keyword string, query model.ListQuery) int {
+					return len(dummyRegencies)
+				},
+				func(ctx context.Context, keyword string, query model.ListQuery) error {
 					return nil
 				},
 			).Once()
@@ -95,11 +98,14 @@ func TestRegenciesController(t *testing.T) {
 		})
 
 		t.Run("failed scenario", func(t *testing.T) {
-			mockService.On("GetAll", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string")).Return(
-				func(ctx context.Context, keyword string) []model.Regency {
+			mockService.On("GetAll", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string"), mock.AnythingOfType("model.ListQuery")).Return(
+				func(ctx context.Context, keyword string, query model.ListQuery) []model.Regency {
 					return []model.Regency{}
 				},
-				func(ctx context.Context, keyword string) error {
+				func(ctx context.Context, keyword string, query model.ListQuery) int {
+					return 0
+				},
+				func(ctx context.Context, keyword string, query model.ListQuery) error {
 					return service.ErrRepository
 				},
 			).Once()
@@ -234,4 +240,175 @@ func TestRegenciesController(t *testing.T) {
 			}
 		})
 	})
+
+	t.Run("TestGetDistrictsByRegencyID", func(t *testing.T) {
+		mockService := &mocks.RegencyService{}
+
+		dummyDistricts := []model.District{
+			{
+				ID:   "3502010",
+				Name: "NGRAYUN",
+				Regency: model.Regency{
+					ID:       "3502",
+					Name:     "KABUPATEN PONOROGO",
+					Province: model.Province{ID: "35", Name: "JAWA TIMUR"},
+				},
+			},
+		}
+
+		t.Run("success scenario", func(t *testing.T) {
+			mockService.On("GetDistrictsByRegencyID", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), "3502", mock.AnythingOfType("model.ListQuery")).Return(
+				func(ctx context.Context, id string, query model.ListQuery) []model.District {
+					return dummyDistricts
+				},
+				func(ctx context.Context, id string, query model.ListQuery) int {
+					return len(dummyDistricts)
+				},
+				func(ctx context.Context, id string, query model.ListQuery) error {
+					return nil
+				},
+			).Once()
+
+			t.Run("it should return 200 status code with valid response, when there is no error", func(t *testing.T) {
+				controller := NewRegenciesController(mockService)
+
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodGet, "/api/v1/regencies/3502/districts", nil)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+				c.SetPath("/:id/districts")
+				c.SetParamNames("id")
+				c.SetParamValues("3502")
+
+				if assert.NoError(t, controller.getDistrictsByRegencyID(c)) {
+					assert.Equal(t, http.StatusOK, rec.Code)
+
+					response := make(map[string]any)
+					if assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response)) {
+						data := response["data"].(map[string]any)["districts"].([]any)
+
+						assert.Equal(t, "success", response["status"])
+						assert.Equal(t, "successfully get districts with regency ID 3502", response["message"])
+						if assert.Len(t, data, len(dummyDistricts)) {
+							gotDistrict := data[0].(map[string]any)
+							assert.Equal(t, dummyDistricts[0].ID, gotDistrict["id"])
+							assert.Equal(t, dummyDistricts[0].Name, gotDistrict["name"])
+							assert.Equal(t, "3502", gotDistrict["regency"].(map[string]any)["id"])
+						}
+					}
+				}
+			})
+		})
+
+		t.Run("failed scenario", func(t *testing.T) {
+			mockService.On("GetDistrictsByRegencyID", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), "9999", mock.AnythingOfType("model.ListQuery")).Return(
+				func(ctx context.Context, id string, query model.ListQuery) []model.District {
+					return nil
+				},
+				func(ctx context.Context, id string, query model.ListQuery) int {
+					return 0
+				},
+				func(ctx context.Context, id string, query model.ListQuery) error {
+					return service.ErrDataNotFound
+				},
+			).Once()
+
+			t.Run("it should return 404 status code, when the regency doesn't exist", func(t *testing.T) {
+				controller := NewRegenciesController(mockService)
+
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodGet, "/api/v1/regencies/9999/districts", nil)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+				c.SetPath("/:id/districts")
+				c.SetParamNames("id")
+				c.SetParamValues("9999")
+
+				gotError := controller.getDistrictsByRegencyID(c)
+				if assert.Error(t, gotError) {
+					if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+						assert.Equal(t, http.StatusNotFound, echoHTTPError.Code)
+						assert.Equal(t, "Resource with given ID not found.", echoHTTPError.Message)
+					}
+				}
+			})
+		})
+	})
+
+	t.Run("TestGetTree", func(t *testing.T) {
+		mockService := &mocks.RegencyService{}
+
+		dummyTree := model.RegencyTree{
+			ID:       "3502",
+			Name:     "KABUPATEN PONOROGO",
+			Province: &model.Province{ID: "35", Name: "JAWA TIMUR"},
+			Districts: []model.DistrictTree{
+				{
+					ID:       "3502010",
+					Name:     "NGRAYUN",
+					Villages: []model.VillageTree{{ID: "3502010001", Name: "BAOSANKIDUL"}},
+				},
+			},
+		}
+
+		t.Run("success scenario", func(t *testing.T) {
+			mockService.On("GetTree", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), dummyTree.ID, 2).Return(
+				func(ctx context.Context, id string, depth int) model.RegencyTree {
+					return dummyTree
+				},
+				func(ctx context.Context, id string, depth int) error {
+					return nil
+				},
+			).Once()
+
+			t.Run("it should return 200 status code with the whole tree, when depth is not given", func(t *testing.T) {
+				controller := NewRegenciesController(mockService)
+
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodGet, "/api/v1/regencies/3502/tree", nil)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+				c.SetPath("/:id/tree")
+				c.SetParamNames("id")
+				c.SetParamValues(dummyTree.ID)
+
+				if assert.NoError(t, controller.getTree(c)) {
+					assert.Equal(t, http.StatusOK, rec.Code)
+
+					response := make(map[string]any)
+					if assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response)) {
+						data := response["data"].(map[string]any)
+						district := data["districts"].([]any)[0].(map[string]any)
+						village := district["villages"].([]any)[0].(map[string]any)
+
+						assert.Equal(t, "35", data["province"].(map[string]any)["id"])
+						assert.Equal(t, "3502010", district["id"])
+						assert.Equal(t, "BAOSANKIDUL", village["name"])
+					}
+				}
+			})
+		})
+
+		t.Run("failed scenario", func(t *testing.T) {
+			t.Run("it should return 400 status code, when given depth is not a number", func(t *testing.T) {
+				controller := NewRegenciesController(mockService)
+
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodGet, "/api/v1/regencies/3502/tree?depth=all", nil)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+				c.SetPath("/:id/tree")
+				c.SetParamNames("id")
+				c.SetParamValues(dummyTree.ID)
+
+				gotError := controller.getTree(c)
+				if assert.Error(t, gotError) {
+					if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+						assert.Equal(t, http.StatusBadRequest, echoHTTPError.Code)
+						assert.Equal(t, "Query param depth must be an integer between 0 and 2.", echoHTTPError.Message)
+					}
+				}
+			})
+		})
+	})
 }
@@ -0,0 +1,80 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/erikrios/ponorogo-regency-api/model"
+	"github.com/erikrios/ponorogo-regency-api/service"
+	"github.com/labstack/echo/v4"
+)
+
+type searchController struct {
+	service service.SearchService
+}
+
+func NewSearchController(service service.SearchService) *searchController {
+	return &searchController{service: service}
+}
+
+func (s *searchController) Route(g *echo.Group) {
+	g.GET("/search", s.search)
+}
+
+// Search        godoc
+// @Summary      Search
+// @Description  Search the provinces, regencies, districts and villages whose name contains the keyword at once, most relevant first
+// @Tags         search
+// @Accept       json
+// @Produce      json
+// @Param        q      query     string  true   "keyword the names must contain"
+// @Param        level  query     string  false  "comma-separated levels to search, all of them when omitted, such as district,village"
+// @Param        limit  query     int     false  "maximum number of hits, from 1 to 100 (default 50)"
+// @Success      200    {object}  searchResponse
+// @Failure      400    {object}  echo.HTTPError
+// @Failure      500    {object}  echo.HTTPError
+// @Router       /search [get]
+func (s *searchController) search(c echo.Context) error {
+	q := strings.TrimSpace(c.QueryParam("q"))
+	if q == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "Query param q is required.")
+	}
+
+	var levels []string
+	if level := c.QueryParam("level"); level != "" {
+		levels = strings.Split(level, ",")
+	}
+
+	limit := defaultLimit
+	if param := c.QueryParam("limit"); param != "" {
+		var err error
+		if limit, err = strconv.Atoi(param); err != nil || limit < 1 || limit > maxLimit {
+			return echo.NewHTTPError(http.StatusBadRequest, "Query param limit must be an integer between 1 and 100.")
+		}
+	}
+
+	hits, total, err := s.service.Search(c.Request().Context(), q, levels, limit)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	searchResponse := map[string]any{"hits": hits}
+
+	pagination := &model.Pagination{Limit: limit, Total: total}
+	response := model.NewResponse("success", fmt.Sprintf("successfully search %q", q), searchResponse).WithPagination(pagination)
+	return c.JSON(http.StatusOK, response)
+}
+
+// searchResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
+type searchResponse struct {
+	Status     string           `json:"status"`
+	Message    string           `json:"message"`
+	Data       searchData       `json:"data"`
+	Pagination model.Pagination `json:"pagination"`
+}
+
+type searchData struct {
+	Hits []model.SearchHit `json:"hits"`
+}
@@ -0,0 +1,146 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/erikrios/ponorogo-regency-api/model"
+	"github.com/erikrios/ponorogo-regency-api/service"
+	"github.com/erikrios/ponorogo-regency-api/service/mocks"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSearchController(t *testing.T) {
+	t.Run("TestNewSearchController", func(t *testing.T) {
+		mockService := &mocks.SearchService{}
+		controller := NewSearchController(mockService)
+		assert.NotNil(t, controller)
+	})
+
+	t.Run("TestRoute", func(t *testing.T) {
+		mockService := &mocks.SearchService{}
+		controller := NewSearchController(mockService)
+		g := echo.New().Group("/api/v1")
+		controller.Route(g)
+		assert.NotNil(t, controller)
+	})
+
+	t.Run("TestSearch", func(t *testing.T) {
+		mockService := &mocks.SearchService{}
+
+		dummyHits := []model.SearchHit{
+			{Level: "district", ID: "3502010", Name: "NGRAYUN", Path: "NGRAYUN, KABUPATEN PONOROGO, JAWA TIMUR", Score: 1},
+		}
+
+		t.Run("success scenario", func(t *testing.T) {
+			mockService.On("Search", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), "ngrayun", []string{"district", "village"}, 10).Return(
+				func(ctx context.Context, q string, levels []string, limit int) []model.SearchHit {
+					return dummyHits
+				},
+				func(ctx context.Context, q string, levels []string, limit int) int {
+					return len(dummyHits)
+				},
+				func(ctx context.Context, q string, levels []string, limit int) error {
+					return nil
+				},
+			).Once()
+
+			t.Run("it should return 200 status code with the hits, when there is no error", func(t *testing.T) {
+				controller := NewSearchController(mockService)
+
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodGet, "/api/v1/search?q=ngrayun&level=district,village&limit=10", nil)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+
+				if assert.NoError(t, controller.search(c)) {
+					assert.Equal(t, http.StatusOK, rec.Code)
+
+					var response model.Response[map[string][]model.SearchHit]
+					if assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response)) {
+						assert.Equal(t, "success", response.Status)
+						assert.Equal(t, `successfully search "ngrayun"`, response.Message)
+						assert.Equal(t, dummyHits, response.Data["hits"])
+						assert.Equal(t, &model.Pagination{Limit: 10, Total: 1}, response.Pagination)
+					}
+				}
+			})
+		})
+
+		t.Run("failed scenario", func(t *testing.T) {
+			mockService.On("Search", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), "ngrayun", mock.Anything, defaultLimit).Return(
+				func(ctx context.Context, q string, levels []string, limit int) []model.SearchHit {
+					return nil
+				},
+				func(ctx context.Context, q string, levels []string, limit int) int {
+					return 0
+				},
+				func(ctx context.Context, q string, levels []string, limit int) error {
+					if len(levels) > 0 {
+						return &service.QueryError{Reason: `unknown level "hamlet"`}
+					}
+					return service.ErrRepository
+				},
+			).Twice()
+
+			testCases := []struct {
+				name               string
+				target             string
+				expectedStatusCode int
+				expectedMessage    string
+			}{
+				{
+					name:               "it should return 400 status code with valid response, when q is missing",
+					target:             "/api/v1/search?level=village",
+					expectedStatusCode: http.StatusBadRequest,
+					expectedMessage:    "Query param q is required.",
+				},
+				{
+					name:               "it should return 400 status code with valid response, when limit is out of range",
+					target:             "/api/v1/search?q=ngrayun&limit=101",
+					expectedStatusCode: http.StatusBadRequest,
+					expectedMessage:    "Query param limit must be an integer between 1 and 100.",
+				},
+				{
+					name:               "it should return 400 status code with valid response, when level is unknown",
+					target:             "/api/v1/search?q=ngrayun&level=hamlet",
+					expectedStatusCode: http.StatusBadRequest,
+					expectedMessage:    `Invalid query: unknown level "hamlet".`,
+				},
+				{
+					name:               "it should return 500 status code with valid response, when error happened",
+					target:             "/api/v1/search?q=ngrayun",
+					expectedStatusCode: http.StatusInternalServerError,
+					expectedMessage:    "Something went wrong.",
+				},
+			}
+
+			for _, testCase := range testCases {
+				t.Run(testCase.name, func(t *testing.T) {
+					controller := NewSearchController(mockService)
+
+					e := echo.New()
+					req := httptest.NewRequest(http.MethodGet, testCase.target, nil)
+					rec := httptest.NewRecorder()
+					c := e.NewContext(req, rec)
+
+					gotError := controller.search(c)
+					if assert.Error(t, gotError) {
+						if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+							assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+							assert.Equal(t, testCase.expectedMessage, echoHTTPError.Message)
+						}
+					}
+				})
+			}
+
+			mockService.AssertExpectations(t)
+		})
+	})
+}
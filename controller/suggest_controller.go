@@ -0,0 +1,74 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/erikrios/ponorogo-regency-api/model"
+	"github.com/erikrios/ponorogo-regency-api/service"
+	"github.com/labstack/echo/v4"
+)
+
+const defaultSuggestLimit = 10
+
+type suggestController struct {
+	service service.SuggestService
+}
+
+func NewSuggestController(service service.SuggestService) *suggestController {
+	return &suggestController{service: service}
+}
+
+func (s *suggestController) Route(g *echo.Group) {
+	g.GET("/suggest", s.suggest)
+}
+
+// Suggest       godoc
+// @Summary      Suggest
+// @Description  Suggest the provinces, regencies, districts and villages having a word starting with the prefix, for typeahead
+// @Tags         suggest
+// @Accept       json
+// @Produce      json
+// @Param        prefix  query     string  true   "prefix of a word of the names"
+// @Param        limit   query     int     false  "maximum number of suggestions, from 1 to 100 (default 10)"
+// @Success      200     {object}  suggestResponse
+// @Failure      400     {object}  echo.HTTPError
+// @Failure      500     {object}  echo.HTTPError
+// @Router       /suggest [get]
+func (s *suggestController) suggest(c echo.Context) error {
+	prefix := strings.TrimSpace(c.QueryParam("prefix"))
+	if prefix == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "Query param prefix is required.")
+	}
+
+	limit := defaultSuggestLimit
+	if param := c.QueryParam("limit"); param != "" {
+		var err error
+		if limit, err = strconv.Atoi(param); err != nil || limit < 1 || limit > maxLimit {
+			return echo.NewHTTPError(http.StatusBadRequest, "Query param limit must be an integer between 1 and 100.")
+		}
+	}
+
+	suggestions, err := s.service.Suggest(c.Request().Context(), prefix, limit)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	suggestResponse := map[string]any{"suggestions": suggestions}
+
+	response := model.NewResponse("success", fmt.Sprintf("successfully suggest %q", prefix), suggestResponse)
+	return c.JSON(http.StatusOK, response)
+}
+
+// suggestResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
+type suggestResponse struct {
+	Status  string      `json:"status"`
+	Message string      `json:"message"`
+	Data    suggestData `json:"data"`
+}
+
+type suggestData struct {
+	Suggestions []model.Suggestion `json:"suggestions"`
+}
@@ -0,0 +1,130 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/erikrios/ponorogo-regency-api/model"
+	"github.com/erikrios/ponorogo-regency-api/service"
+	"github.com/erikrios/ponorogo-regency-api/service/mocks"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSuggestController(t *testing.T) {
+	t.Run("TestNewSuggestController", func(t *testing.T) {
+		mockService := &mocks.SuggestService{}
+		controller := NewSuggestController(mockService)
+		assert.NotNil(t, controller)
+	})
+
+	t.Run("TestRoute", func(t *testing.T) {
+		mockService := &mocks.SuggestService{}
+		controller := NewSuggestController(mockService)
+		g := echo.New().Group("/api/v1")
+		controller.Route(g)
+		assert.NotNil(t, controller)
+	})
+
+	t.Run("TestSuggest", func(t *testing.T) {
+		mockService := &mocks.SuggestService{}
+
+		dummySuggestions := []model.Suggestion{
+			{Level: "village", ID: "3502010001", Name: "BAOSANKIDUL", Path: "BAOSANKIDUL, NGRAYUN, KABUPATEN PONOROGO, JAWA TIMUR"},
+		}
+
+		t.Run("success scenario", func(t *testing.T) {
+			mockService.On("Suggest", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), "baos", defaultSuggestLimit).Return(
+				func(ctx context.Context, prefix string, limit int) []model.Suggestion {
+					return dummySuggestions
+				},
+				func(ctx context.Context, prefix string, limit int) error {
+					return nil
+				},
+			).Once()
+
+			t.Run("it should return 200 status code with the suggestions, when there is no error", func(t *testing.T) {
+				controller := NewSuggestController(mockService)
+
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodGet, "/api/v1/suggest?prefix=baos", nil)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+
+				if assert.NoError(t, controller.suggest(c)) {
+					assert.Equal(t, http.StatusOK, rec.Code)
+
+					var response model.Response[map[string][]model.Suggestion]
+					if assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response)) {
+						assert.Equal(t, "success", response.Status)
+						assert.Equal(t, `successfully suggest "baos"`, response.Message)
+						assert.Equal(t, dummySuggestions, response.Data["suggestions"])
+					}
+				}
+			})
+		})
+
+		t.Run("failed scenario", func(t *testing.T) {
+			mockService.On("Suggest", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), "baos", 5).Return(
+				func(ctx context.Context, prefix string, limit int) []model.Suggestion {
+					return nil
+				},
+				func(ctx context.Context, prefix string, limit int) error {
+					return service.ErrRepository
+				},
+			).Once()
+
+			testCases := []struct {
+				name               string
+				target             string
+				expectedStatusCode int
+				expectedMessage    string
+			}{
+				{
+					name:               "it should return 400 status code with valid response, when prefix is missing",
+					target:             "/api/v1/suggest?limit=5",
+					expectedStatusCode: http.StatusBadRequest,
+					expectedMessage:    "Query param prefix is required.",
+				},
+				{
+					name:               "it should return 400 status code with valid response, when limit isn't an integer",
+					target:             "/api/v1/suggest?prefix=baos&limit=all",
+					expectedStatusCode: http.StatusBadRequest,
+					expectedMessage:    "Query param limit must be an integer between 1 and 100.",
+				},
+				{
+					name:               "it should return 500 status code with valid response, when error happened",
+					target:             "/api/v1/suggest?prefix=baos&limit=5",
+					expectedStatusCode: http.StatusInternalServerError,
+					expectedMessage:    "Something went wrong.",
+				},
+			}
+
+			for _, testCase := range testCases {
+				t.Run(testCase.name, func(t *testing.T) {
+					controller := NewSuggestController(mockService)
+
+					e := echo.New()
+					req := httptest.NewRequest(http.MethodGet, testCase.target, nil)
+					rec := httptest.NewRecorder()
+					c := e.NewContext(req, rec)
+
+					gotError := controller.suggest(c)
+					if assert.Error(t, gotError) {
+						if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+							assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+							assert.Equal(t, testCase.expectedMessage, echoHTTPError.Message)
+						}
+					}
+				})
+			}
+
+			mockService.AssertExpectations(t)
+		})
+	})
+}
@@ -0,0 +1,26 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	provinceTreeDepth = 3
+	regencyTreeDepth  = 2
+)
+
+func bindDepth(c echo.Context, max int) (depth int, err error) {
+	depth = max
+
+	if param := c.QueryParam("depth"); param != "" {
+		if depth, err = strconv.Atoi(param); err != nil || depth < 0 || depth > max {
+			err = echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Query param depth must be an integer between 0 and %d.", max))
+		}
+	}
+
+	return
+}
@@ -3,24 +3,31 @@ package controller
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/erikrios/ponorogo-regency-api/model"
 	"github.com/erikrios/ponorogo-regency-api/service"
 	"github.com/labstack/echo/v4"
 )
 
+const defaultNearestLimit = 10
+
 type villagesController struct {
-	service service.VillageService
+	service      service.VillageService
+	maxBatchSize int
 }
 
-func NewVillagesController(service service.VillageService) *villagesController {
-	return &villagesController{service: service}
+func NewVillagesController(service service.VillageService, maxBatchSize int) *villagesController {
+	return &villagesController{service: service, maxBatchSize: maxBatchSize}
 }
 
 func (v *villagesController) Route(g *echo.Group) {
 	group := g.Group("/villages")
+	group.POST("\\:batchGet", v.batchGet)
 	group.GET("", v.getAll)
+	group.GET("/nearest", v.getNearest)
 	group.GET("/:id", v.getByID)
+	group.GET("/:id/geometry", v.getGeometry)
 }
 
 // GetAll	       godoc
@@ -30,20 +37,49 @@ func (v *villagesController) Route(g *echo.Group) {
 // @Accept       json
 // @Produce      json
 // @Param        keyword  query     string  false  "village name search by keyword"
+// @Param        match    query     string  false  "how keyword is matched, fuzzy ranks typo-tolerant matches by their score, from 0 to 1"  Enums(contains, fuzzy)
+// @Param        page     query     int     false  "page number, starting from 1"
+// @Param        limit    query     int     false  "maximum number of items per page, from 1 to 100 (default 50)"
+// @Param        after    query     string  false  "only return items after this ID, can't be combined with page"
+// @Param        sort     query     string  false  "sort by id or name, descending when prefixed with -"  Enums(id, -id, name, -name)
+// @Param        name     query     string  false  "only return items whose name contains this value"
+// @Param        district_id query     string  false  "only return villages of this district"
+// @Param        regency_id query     string  false  "only return items of this regency"
+// @Param        province_id query     string  false  "only return items of this province"
+// @Param        type     query     string  false  "only return villages of this type"  Enums(desa, kelurahan)
+// @Param        fields   query     string  false  "comma-separated fields of each item to return, such as id,name"
+// @Param        expand   query     string  false  "comma-separated parents to embed in each item, all of them when omitted"
 // @Success      200      {object}  villagesResponse
+// @Failure      400      {object}  echo.HTTPError
 // @Failure      500      {object}  echo.HTTPError
 // @Router       /villages [get]
 func (v *villagesController) getAll(c echo.Context) error {
 	keyword := c.QueryParam("keyword")
 
-	villages, err := v.service.GetAll(c.Request().Context(), keyword)
+	query, err := bindListQuery(c)
+	if err != nil {
+		return err
+	}
+
+	sparse, err := bindSparseFields(c, &query, "id", "name", "display_name", "type", "latitude", "longitude", "postal_codes", "district", "score")
+	if err != nil {
+		return err
+	}
+
+	villages, total, err := v.service.GetAll(c.Request().Context(), keyword, query)
 	if err != nil {
 		return newErrorResponse(err)
 	}
 
-	villagesResponse := map[string]any{"villages": villages}
+	items, err := project(villages, sparse)
+	if err != nil {
+		return newErrorResponse(err)
+	}
 
-	response := model.NewResponse("success", "successfully get villages", villagesResponse)
+	villagesResponse := map[string]any{"villages": items}
+
+	pagination := newPagination(c, query, total, villages, func(village model.Village) string { return village.ID })
+	response := model.NewResponse("success", "successfully get villages", villagesResponse).WithPagination(pagination)
 	return c.JSON(http.StatusOK, response)
 }
 
@@ -55,6 +91,7 @@ func (v *villagesController) getAll(c echo.Context) error {
 // @Produce      json
 // @Param        id   path      int  true  "Village ID"
 // @Success      200  {object}  villageResponse
+// @Failure      400  {object}  echo.HTTPError
 // @Failure      404  {object}  echo.HTTPError
 // @Failure      500  {object}  echo.HTTPError
 // @Router       /villages/{id} [get]
@@ -70,20 +107,133 @@ func (v *villagesController) getByID(c echo.Context) error {
 	return c.JSON(http.StatusOK, response)
 }
 
+// GetNearest    godoc
+// @Summary      Get Nearest Villages
+// @Description  Get the villages whose centroid is the closest to a point, nearest first, with their distance in meters
+// @Tags         villages
+// @Accept       json
+// @Produce      json
+// @Param        lat    query     number  true   "latitude of the point, from -90 to 90"
+// @Param        lng    query     number  true   "longitude of the point, from -180 to 180"
+// @Param        limit  query     int     false  "maximum number of villages, from 1 to 100 (default 10)"
+// @Success      200    {object}  villagesNearestResponse
+// @Failure      400    {object}  echo.HTTPError
+// @Failure      500    {object}  echo.HTTPError
+// @Router       /villages/nearest [get]
+func (v *villagesController) getNearest(c echo.Context) error {
+	lat, lng, err := bindPoint(c)
+	if err != nil {
+		return err
+	}
+
+	limit := defaultNearestLimit
+	if param := c.QueryParam("limit"); param != "" {
+		if limit, err = strconv.Atoi(param); err != nil || limit < 1 || limit > maxLimit {
+			return echo.NewHTTPError(http.StatusBadRequest, "Query param limit must be an integer between 1 and 100.")
+		}
+	}
+
+	villages, err := v.service.GetNearest(c.Request().Context(), lat, lng, limit)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	villagesResponse := map[string]any{"villages": villages}
+
+	response := model.NewResponse("success", fmt.Sprintf("successfully get %d nearest villages", len(villages)), villagesResponse)
+	return c.JSON(http.StatusOK, response)
+}
+
+// GetGeometry   godoc
+// @Summary      Get Village Geometry
+// @Description  Get the boundary of a village as a GeoJSON Feature, whose geometry is null when the boundary isn't known
+// @Tags         villages
+// @Accept       json
+// @Produce      application/geo+json
+// @Param        id         path      int     true   "Village ID"
+// @Param        tolerance  query     number  false  "simplify the geometry, dropping the positions closer than this many degrees to the simplified lines"
+// @Success      200        {object}  geojson.Feature
+// @Failure      400        {object}  echo.HTTPError
+// @Failure      404        {object}  echo.HTTPError
+// @Failure      500        {object}  echo.HTTPError
+// @Router       /villages/{id}/geometry [get]
+func (v *villagesController) getGeometry(c echo.Context) error {
+	tolerance, err := bindTolerance(c)
+	if err != nil {
+		return err
+	}
+
+	feature, err := v.service.GetGeometry(c.Request().Context(), c.Param("id"), tolerance)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	return geoJSON(c, feature)
+}
+
+// BatchGet      godoc
+// @Summary      Batch Get Villages
+// @Description  Get the villages with the given IDs in one request, listing the IDs that don't belong to any village and the malformed ones
+// @Tags         villages
+// @Accept       json
+// @Produce      json
+// @Param        body  body      batchGetRequest  true  "Village IDs, at most MAX_BATCH_SIZE (default 100) of them"
+// @Success      200   {object}  villagesBatchResponse
+// @Failure      400   {object}  echo.HTTPError
+// @Failure      500   {object}  echo.HTTPError
+// @Router       /villages:batchGet [post]
+func (v *villagesController) batchGet(c echo.Context) error {
+	ids, err := bindBatchIDs(c, v.maxBatchSize)
+	if err != nil {
+		return err
+	}
+
+	villages, missing, invalid, err := v.service.GetByIDs(c.Request().Context(), ids)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	villagesResponse := map[string]any{"villages": villages, "missing": missing, "invalid": invalid}
+
+	response := model.NewResponse("success", fmt.Sprintf("successfully get %d of %d villages", len(villages), len(villages)+len(missing)+len(invalid)), villagesResponse)
+	return c.JSON(http.StatusOK, response)
+}
+
 // villagesResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
 type villagesResponse struct {
-	Status  string       `json:"status"`
-	Message string       `json:"message"`
-	Data    villagesData `json:"data"`
+	Status     string           `json:"status"`
+	Message    string           `json:"message"`
+	Data       villagesData     `json:"data"`
+	Pagination model.Pagination `json:"pagination"`
 }
 
 type villagesData struct {
 	Villages []model.Village `json:"villages"`
 }
 
+// villagesNearestResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
+type villagesNearestResponse struct {
+	Status  string       `json:"status"`
+	Message string       `json:"message"`
+	Data    villagesData `json:"data"`
+}
+
 // villageResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
 type villageResponse struct {
 	Status  string        `json:"status"`
 	Message string        `json:"message"`
 	Data    model.Village `json:"data"`
 }
+
+// villagesBatchResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
+type villagesBatchResponse struct {
+	Status  string            `json:"status"`
+	Message string            `json:"message"`
+	Data    villagesBatchData `json:"data"`
+}
+
+type villagesBatchData struct {
+	Villages []model.Village `json:"villages"`
+	Missing  []string        `json:"missing"`
+	Invalid  []string        `json:"invalid"`
+}
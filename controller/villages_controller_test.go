@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
+	"github.com/erikrios/ponorogo-regency-api/geojson"
 	"github.com/erikrios/ponorogo-regency-api/model"
 	"github.com/erikrios/ponorogo-regency-api/service"
 	"github.com/erikrios/ponorogo-regency-api/service/mocks"
@@ -19,13 +21,13 @@ import (
 func TestVillagesController(t *testing.T) {
 	t.Run("TestNewVillagesController", func(t *testing.T) {
 		mockService := &mocks.VillageService{}
-		controller := NewVillagesController(mockService)
+		controller := NewVillagesController(mockService, 100)
 		assert.NotNil(t, controller)
 	})
 
 	t.Run("TestRoute", func(t *testing.T) {
 		mockService := &mocks.VillageService{}
-		controller := NewVillagesController(mockService)
+		controller := NewVillagesController(mockService, 100)
 		g := echo.New().Group("/api/v1")
 		controller.Route(g)
 		assert.NotNil(t, controller)
@@ -54,17 +56,20 @@ func TestVillagesController(t *testing.T) {
 		}
 
 		t.Run("success scenario", func(t *testing.T) {
-			mockService.On("GetAll", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string")).Return(
-				func(ctx context.Context, keyword string) []model.Village {
+			mockService.On("GetAll", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string"), mock.AnythingOfType("model.ListQuery")).Return(
+				func(ctx context.Context, keyword string, query model.ListQuery) []model.Village {
 					return dummyVillages
 				},
-				func(ctx context.Context, keyword string) error {
+				func(ctx context.Context, keyword string, query model.ListQuery) int {
+					return len(dummyVillages)
+				},
+				func(ctx context.Context, keyword string, query model.ListQuery) error {
 					return nil
 				},
 			).Once()
 
 			t.Run("it should return 200 status code with valid response, when there is no error", func(t *testing.T) {
-				controller := NewVillagesController(mockService)
+				controller := NewVillagesController(mockService, 100)
 
 				e := echo.New()
 				req := httptest.NewRequest(http.MethodGet, "/api/v1/villages?keyword=Pager", nil)
@@ -111,17 +116,20 @@ func TestVillagesController(t *testing.T) {
 		})
 
 		t.Run("failed scenario", func(t *testing.T) {
-			mockService.On("GetAll", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string")).Return(
-				func(ctx context.Context, keyword string) []model.Village {
+			mockService.On("GetAll", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string"), mock.AnythingOfType("model.ListQuery")).Return(
+				func(ctx context.Context, keyword string, query model.ListQuery) []model.Village {
 					return []model.Village{}
 				},
-				func(ctx context.Context, keyword string) error {
+				func(ctx context.Context, keyword string, query model.ListQuery) int {
+					return 0
+				},
+				func(ctx context.Context, keyword string, query model.ListQuery) error {
 					return service.ErrRepository
 				},
 			).Once()
 
 			t.Run("it should return 500 status code with valid response, when error happened", func(t *testing.T) {
-				controller := NewVillagesController(mockService)
+				controller := NewVillagesController(mockService, 100)
 
 				e := echo.New()
 				req := httptest.NewRequest(http.MethodGet, "/api/v1/villages?keyword=Bungkal", nil)
@@ -137,6 +145,200 @@ func TestVillagesController(t *testing.T) {
 				}
 			})
 		})
+		t.Run("sparse fields scenario", func(t *testing.T) {
+			mockService.On("GetAll", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string"), mock.MatchedBy(func(query model.ListQuery) bool {
+				return query.Expand != nil && len(query.Expand) == 0
+			})).Return(
+				func(ctx context.Context, keyword string, query model.ListQuery) []model.Village {
+					return dummyVillages
+				},
+				func(ctx context.Context, keyword string, query model.ListQuery) int {
+					return len(dummyVillages)
+				},
+				func(ctx context.Context, keyword string, query model.ListQuery) error {
+					return nil
+				},
+			).Once()
+
+			t.Run("it should return only the given fields without parents, when given fields", func(t *testing.T) {
+				controller := NewVillagesController(mockService, 100)
+
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodGet, "/api/v1/villages?fields=id,name", nil)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+
+				if assert.NoError(t, controller.getAll(c)) {
+					assert.Equal(t, http.StatusOK, rec.Code)
+
+					response := make(map[string]any)
+					if assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response)) {
+						data := response["data"].(map[string]any)["villages"].([]any)
+
+						for i, village := range dummyVillages {
+							assert.Equal(t, map[string]any{"id": village.ID, "name": village.Name}, data[i])
+						}
+					}
+				}
+			})
+		})
+
+		t.Run("bad request scenario", func(t *testing.T) {
+			mockService.On("GetAll", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string"), mock.AnythingOfType("model.ListQuery")).Return(
+				func(ctx context.Context, keyword string, query model.ListQuery) []model.Village {
+					return []model.Village{}
+				},
+				func(ctx context.Context, keyword string, query model.ListQuery) int {
+					return 0
+				},
+				func(ctx context.Context, keyword string, query model.ListQuery) error {
+					return &service.QueryError{Reason: `unknown filter field "population"`}
+				},
+			).Once()
+
+			t.Run("it should return 400 status code with valid response, when filtering by unknown field", func(t *testing.T) {
+				controller := NewVillagesController(mockService, 100)
+
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodGet, "/api/v1/villages?population=1000", nil)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+
+				gotError := controller.getAll(c)
+				if assert.Error(t, gotError) {
+					if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+						assert.Equal(t, http.StatusBadRequest, echoHTTPError.Code)
+						assert.Equal(t, `Invalid query: unknown filter field "population".`, echoHTTPError.Message)
+					}
+				}
+			})
+		})
+	})
+
+	t.Run("TestBatchGet", func(t *testing.T) {
+		mockService := &mocks.VillageService{}
+
+		dummyVillages := []model.Village{
+			{
+				ID:   "3502010001",
+				Name: "BAOSANKIDUL",
+				District: model.District{
+					ID:   "3502010",
+					Name: "NGRAYUN",
+					Regency: model.Regency{
+						ID:       "3502",
+						Name:     "KABUPATEN PONOROGO",
+						Province: model.Province{ID: "35", Name: "JAWA TIMUR"},
+					},
+				},
+			},
+		}
+
+		mockService.On("GetByIDs", mock.Anything, []string{"3502010001", "3502010999"}).Return(
+			func(ctx context.Context, ids []string) []model.Village {
+				return dummyVillages
+			},
+			func(ctx context.Context, ids []string) []string {
+				return []string{"3502010999"}
+			},
+			func(ctx context.Context, ids []string) []string {
+				return []string{}
+			},
+			func(ctx context.Context, ids []string) error {
+				return nil
+			},
+		).Once()
+		mockService.On("GetByIDs", mock.Anything, []string{"3502010"}).Return(
+			func(ctx context.Context, ids []string) []model.Village {
+				return []model.Village{}
+			},
+			func(ctx context.Context, ids []string) []string {
+				return []string{}
+			},
+			func(ctx context.Context, ids []string) []string {
+				return []string{"3502010"}
+			},
+			func(ctx context.Context, ids []string) error {
+				return nil
+			},
+		).Once()
+
+		e := echo.New()
+		NewVillagesController(mockService, 2).Route(e.Group("/api/v1"))
+
+		t.Run("it should return 200 status code with the found villages and the missing IDs, when there is no error", func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/villages:batchGet", strings.NewReader(`{"ids": ["3502010001", "3502010999"]}`))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, http.StatusOK, rec.Code)
+
+			var response model.Response[villagesBatchData]
+			if assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response)) {
+				assert.Equal(t, "successfully get 1 of 2 villages", response.Message)
+				assert.Equal(t, dummyVillages, response.Data.Villages)
+				assert.Equal(t, []string{"3502010999"}, response.Data.Missing)
+				assert.Empty(t, response.Data.Invalid)
+			}
+		})
+
+		t.Run("it should return 200 status code with the malformed IDs, when some IDs are malformed", func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/villages:batchGet", strings.NewReader(`{"ids": ["3502010"]}`))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, http.StatusOK, rec.Code)
+
+			var response model.Response[villagesBatchData]
+			if assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response)) {
+				assert.Equal(t, "successfully get 0 of 1 villages", response.Message)
+				assert.Empty(t, response.Data.Villages)
+				assert.Equal(t, []string{"3502010"}, response.Data.Invalid)
+			}
+		})
+
+		testCases := []struct {
+			name            string
+			body            string
+			expectedMessage string
+		}{
+			{
+				name:            "it should return 400 status code, when the body isn't JSON",
+				body:            `ids=3502010001`,
+				expectedMessage: "Request body must be a JSON object with an ids array.",
+			},
+			{
+				name:            "it should return 400 status code, when the body lists no ID",
+				body:            `{"ids": []}`,
+				expectedMessage: "Request body must list at least one ID.",
+			},
+			{
+				name:            "it should return 400 status code, when the body lists more IDs than the maximum batch size",
+				body:            `{"ids": ["3502010001", "3502010001", "3502010001"]}`,
+				expectedMessage: "At most 2 IDs can be read in one batch.",
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				req := httptest.NewRequest(http.MethodPost, "/api/v1/villages:batchGet", strings.NewReader(testCase.body))
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+				rec := httptest.NewRecorder()
+
+				e.ServeHTTP(rec, req)
+
+				assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+				response := make(map[string]any)
+				if assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response)) {
+					assert.Equal(t, testCase.expectedMessage, response["message"])
+				}
+			})
+		}
 	})
 
 	t.Run("TestGetByID", func(t *testing.T) {
@@ -170,7 +372,7 @@ func TestVillagesController(t *testing.T) {
 			).Once()
 
 			t.Run("it should return 200 status code with valid response, when there is no error", func(t *testing.T) {
-				controller := NewVillagesController(mockService)
+				controller := NewVillagesController(mockService, 100)
 
 				e := echo.New()
 				req := httptest.NewRequest(http.MethodGet, "/api/v1/villages", nil)
@@ -241,7 +443,7 @@ func TestVillagesController(t *testing.T) {
 
 			for _, testCase := range testCases {
 				t.Run(testCase.name, func(t *testing.T) {
-					controller := NewVillagesController(mockService)
+					controller := NewVillagesController(mockService, 100)
 
 					e := echo.New()
 					req := httptest.NewRequest(http.MethodGet, "/api/v1/villages", nil)
@@ -262,4 +464,208 @@ func TestVillagesController(t *testing.T) {
 			}
 		})
 	})
+
+	t.Run("TestGetNearest", func(t *testing.T) {
+		mockService := &mocks.VillageService{}
+
+		distance := 1190.0
+		dummyVillages := []model.Village{
+			{
+				ID:       "3502170001",
+				Name:     "PAJU",
+				Type:     "kelurahan",
+				Distance: &distance,
+				District: model.District{
+					ID:   "3502170",
+					Name: "PONOROGO",
+					Regency: model.Regency{
+						ID:   "3502",
+						Name: "KABUPATEN PONOROGO",
+						Province: model.Province{
+							ID:   "35",
+							Name: "JAWA TIMUR",
+						},
+					},
+				},
+			},
+		}
+
+		t.Run("success scenario", func(t *testing.T) {
+			mockService.On("GetNearest", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), -7.8651, 111.4696, defaultNearestLimit).Return(
+				func(ctx context.Context, lat, lng float64, limit int) []model.Village {
+					return dummyVillages
+				},
+				func(ctx context.Context, lat, lng float64, limit int) error {
+					return nil
+				},
+			).Once()
+
+			t.Run("it should return 200 status code with valid response, when there is no error", func(t *testing.T) {
+				controller := NewVillagesController(mockService, 100)
+
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodGet, "/api/v1/villages/nearest?lat=-7.8651&lng=111.4696", nil)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+
+				if assert.NoError(t, controller.getNearest(c)) {
+					assert.Equal(t, http.StatusOK, rec.Code)
+
+					response := make(map[string]any)
+					if assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response)) {
+						data := response["data"].(map[string]any)
+						villages := data["villages"].([]any)
+
+						assert.Equal(t, "success", response["status"])
+						assert.Equal(t, "successfully get 1 nearest villages", response["message"])
+						if assert.Len(t, villages, 1) {
+							village := villages[0].(map[string]any)
+							assert.Equal(t, dummyVillages[0].ID, village["id"])
+							assert.Equal(t, distance, village["distance"])
+						}
+					}
+				}
+			})
+		})
+
+		t.Run("failed scenario", func(t *testing.T) {
+			mockService.On("GetNearest", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), 97.8651, 111.4696, 5).Return(
+				func(ctx context.Context, lat, lng float64, limit int) []model.Village {
+					return nil
+				},
+				func(ctx context.Context, lat, lng float64, limit int) error {
+					return &service.QueryError{Reason: "lat must be between -90 and 90"}
+				},
+			).Once()
+			mockService.On("GetNearest", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), -7.8651, 111.4696, 5).Return(
+				func(ctx context.Context, lat, lng float64, limit int) []model.Village {
+					return nil
+				},
+				func(ctx context.Context, lat, lng float64, limit int) error {
+					return service.ErrRepository
+				},
+			).Once()
+
+			testCases := []struct {
+				name               string
+				target             string
+				expectedStatusCode int
+				expectedMessage    string
+			}{
+				{
+					name:               "it should return 400 status code with valid response, when lat is missing",
+					target:             "/api/v1/villages/nearest?lng=111.4696",
+					expectedStatusCode: http.StatusBadRequest,
+					expectedMessage:    "Query params lat and lng must be numbers.",
+				},
+				{
+					name:               "it should return 400 status code with valid response, when lng isn't a number",
+					target:             "/api/v1/villages/nearest?lat=-7.8651&lng=east",
+					expectedStatusCode: http.StatusBadRequest,
+					expectedMessage:    "Query params lat and lng must be numbers.",
+				},
+				{
+					name:               "it should return 400 status code with valid response, when limit is out of range",
+					target:             "/api/v1/villages/nearest?lat=-7.8651&lng=111.4696&limit=101",
+					expectedStatusCode: http.StatusBadRequest,
+					expectedMessage:    "Query param limit must be an integer between 1 and 100.",
+				},
+				{
+					name:               "it should return 400 status code with valid response, when the point is invalid",
+					target:             "/api/v1/villages/nearest?lat=97.8651&lng=111.4696&limit=5",
+					expectedStatusCode: http.StatusBadRequest,
+					expectedMessage:    "Invalid query: lat must be between -90 and 90.",
+				},
+				{
+					name:               "it should return 500 status code with valid response, when error happened",
+					target:             "/api/v1/villages/nearest?lat=-7.8651&lng=111.4696&limit=5",
+					expectedStatusCode: http.StatusInternalServerError,
+					expectedMessage:    "Something went wrong.",
+				},
+			}
+
+			for _, testCase := range testCases {
+				t.Run(testCase.name, func(t *testing.T) {
+					controller := NewVillagesController(mockService, 100)
+
+					e := echo.New()
+					req := httptest.NewRequest(http.MethodGet, testCase.target, nil)
+					rec := httptest.NewRecorder()
+					c := e.NewContext(req, rec)
+
+					gotError := controller.getNearest(c)
+					if assert.Error(t, gotError) {
+						if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+							assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+							assert.Equal(t, testCase.expectedMessage, echoHTTPError.Message)
+						}
+					}
+				})
+			}
+		})
+	})
+
+	t.Run("TestGetGeometry", func(t *testing.T) {
+		mockService := &mocks.VillageService{}
+
+		t.Run("success scenario", func(t *testing.T) {
+			mockService.On("GetGeometry", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), "3502010001", 0.0).Return(
+				func(ctx context.Context, id string, tolerance float64) geojson.Feature {
+					return geojson.NewFeature(id, nil, map[string]any{"id": id, "name": "BAOSANKIDUL", "display_name": "Baosankidul"})
+				},
+				func(ctx context.Context, id string, tolerance float64) error {
+					return nil
+				},
+			).Once()
+
+			t.Run("it should return 200 status code with the bare feature, when there is no error", func(t *testing.T) {
+				controller := NewVillagesController(mockService, 100)
+
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodGet, "/api/v1/villages/3502010001/geometry", nil)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+				c.SetPath("/:id/geometry")
+				c.SetParamNames("id")
+				c.SetParamValues("3502010001")
+
+				if assert.NoError(t, controller.getGeometry(c)) {
+					assert.Equal(t, http.StatusOK, rec.Code)
+					assert.Equal(t, mimeGeoJSON, rec.Header().Get(echo.HeaderContentType))
+					assert.JSONEq(t, `{"type": "Feature", "id": "3502010001", "geometry": null, "properties": {"id": "3502010001", "name": "BAOSANKIDUL", "display_name": "Baosankidul"}}`, rec.Body.String())
+				}
+			})
+		})
+
+		t.Run("failed scenario", func(t *testing.T) {
+			mockService.On("GetGeometry", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), "3502010999", 0.0).Return(
+				func(ctx context.Context, id string, tolerance float64) geojson.Feature {
+					return geojson.Feature{}
+				},
+				func(ctx context.Context, id string, tolerance float64) error {
+					return service.ErrDataNotFound
+				},
+			).Once()
+
+			t.Run("it should return 404 status code with valid response, when given ID not found", func(t *testing.T) {
+				controller := NewVillagesController(mockService, 100)
+
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodGet, "/api/v1/villages/3502010999/geometry", nil)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+				c.SetPath("/:id/geometry")
+				c.SetParamNames("id")
+				c.SetParamValues("3502010999")
+
+				gotError := controller.getGeometry(c)
+				if assert.Error(t, gotError) {
+					if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+						assert.Equal(t, http.StatusNotFound, echoHTTPError.Code)
+						assert.Equal(t, "Resource with given ID not found.", echoHTTPError.Message)
+					}
+				}
+			})
+		})
+	})
 }
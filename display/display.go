@@ -0,0 +1,80 @@
+// Package display formats the official names of the administrative units, stored in upper case,
+// into the names shown to people.
+package display
+
+import (
+	"strings"
+	"unicode"
+)
+
+// abbreviations are the abbreviated prefixes of the official names along with the words they stand
+// for.
+var abbreviations = map[string]string{
+	"PROV.": "Provinsi",
+	"KAB.":  "Kabupaten",
+	"KOT.":  "Kota",
+	"KEC.":  "Kecamatan",
+	"KEL.":  "Kelurahan",
+	"DS.":   "Desa",
+	"KEP.":  "Kepulauan",
+}
+
+// acronyms are kept in upper case, such as the DKI of DKI Jakarta. DI is only an acronym when it
+// starts a name, as in DI Yogyakarta, and a particle otherwise.
+var acronyms = map[string]bool{
+	"DKI": true,
+}
+
+// particles are kept in lower case, unless they start a name.
+var particles = map[string]bool{
+	"dan":  true,
+	"di":   true,
+	"ke":   true,
+	"dari": true,
+}
+
+// romanNumerals tell apart the villages split from a former one, such as Sumbersari II.
+var romanNumerals = map[string]bool{
+	"I": true, "II": true, "III": true, "IV": true, "V": true,
+	"VI": true, "VII": true, "VIII": true, "IX": true, "X": true,
+}
+
+// Name returns the official name in title case, expanding its abbreviated prefixes and keeping its
+// acronyms, particles and roman numerals as they are written in Indonesian, e.g. "KAB. PONOROGO"
+// becomes "Kabupaten Ponorogo".
+func Name(official string) string {
+	words := strings.Fields(official)
+
+	for i, word := range words {
+		upper := strings.ToUpper(word)
+
+		switch {
+		case abbreviations[upper] != "":
+			words[i] = abbreviations[upper]
+		case acronyms[upper], romanNumerals[upper], i == 0 && upper == "DI":
+			words[i] = upper
+		case i > 0 && particles[strings.ToLower(word)]:
+			words[i] = strings.ToLower(word)
+		default:
+			words[i] = title(word)
+		}
+	}
+
+	return strings.Join(words, " ")
+}
+
+// title capitalizes the first letter of word and of each of its parts, such as the two parts of
+// Tanjung-Anom, and lowercases the other ones.
+func title(word string) string {
+	runes := []rune(word)
+
+	for i, r := range runes {
+		if i == 0 || strings.ContainsRune("-(/.", runes[i-1]) {
+			runes[i] = unicode.ToUpper(r)
+		} else {
+			runes[i] = unicode.ToLower(r)
+		}
+	}
+
+	return string(runes)
+}
@@ -0,0 +1,35 @@
+package display
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisplay(t *testing.T) {
+	t.Run("TestName", func(t *testing.T) {
+		testCases := []struct {
+			name     string
+			official string
+			expected string
+		}{
+			{name: "it should title case every word, when given upper case name", official: "KABUPATEN PONOROGO", expected: "Kabupaten Ponorogo"},
+			{name: "it should expand the prefix, when given abbreviated regency", official: "KAB. PONOROGO", expected: "Kabupaten Ponorogo"},
+			{name: "it should expand the prefix, when given abbreviated district", official: "KEC. NGRAYUN", expected: "Kecamatan Ngrayun"},
+			{name: "it should keep the acronym, when given DKI", official: "DKI JAKARTA", expected: "DKI Jakarta"},
+			{name: "it should keep the acronym, when given a name starting with DI", official: "DI YOGYAKARTA", expected: "DI Yogyakarta"},
+			{name: "it should lowercase the particles, when they don't start the name", official: "SIDOREJO DAN SEKITARNYA", expected: "Sidorejo dan Sekitarnya"},
+			{name: "it should keep the roman numeral, when given split village", official: "SUMBERSARI II", expected: "Sumbersari II"},
+			{name: "it should capitalize each part, when given hyphenated name", official: "TANJUNG-ANOM", expected: "Tanjung-Anom"},
+			{name: "it should not capitalize after an apostrophe, when given elided name", official: "MA'RUF", expected: "Ma'ruf"},
+			{name: "it should collapse the spaces, when given padded name", official: " BAOSAN  KIDUL ", expected: "Baosan Kidul"},
+			{name: "it should return empty name, when given empty name", official: "", expected: ""},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				assert.Equal(t, testCase.expected, Name(testCase.official))
+			})
+		}
+	})
+}
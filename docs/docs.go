@@ -25,6 +25,50 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/codes/{code}": {
+            "get": {
+                "description": "Break down an administrative code of any level into its province, regency, district and village parts, flagging the unknown ones",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "codes"
+                ],
+                "summary": "Decode Code",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Administrative code of 2, 4, 7 or 10 digits",
+                        "name": "code",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.codeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
         "/districts": {
             "get": {
                 "description": "Get districts",
@@ -44,6 +88,76 @@ const docTemplate = `{
                         "description": "district name search by keyword",
                         "name": "keyword",
                         "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "contains",
+                            "fuzzy"
+                        ],
+                        "type": "string",
+                        "description": "how keyword is matched, fuzzy ranks typo-tolerant matches by their score, from 0 to 1",
+                        "name": "match",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page number, starting from 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "maximum number of items per page, from 1 to 100 (default 50)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only return items after this ID, can't be combined with page",
+                        "name": "after",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "id",
+                            "-id",
+                            "name",
+                            "-name"
+                        ],
+                        "type": "string",
+                        "description": "sort by id or name, descending when prefixed with -",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only return items whose name contains this value",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only return items of this regency",
+                        "name": "regency_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only return items of this province",
+                        "name": "province_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma-separated fields of each item to return, such as id,name",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma-separated parents to embed in each item, all of them when omitted",
+                        "name": "expand",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -53,6 +167,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/controller.districtsResponse"
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -81,6 +201,82 @@ const docTemplate = `{
                         "description": "district name search by keyword",
                         "name": "keyword",
                         "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page number, starting from 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "maximum number of items per page, from 1 to 100 (default 50)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only return items after this ID, can't be combined with page",
+                        "name": "after",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "id",
+                            "-id",
+                            "name",
+                            "-name"
+                        ],
+                        "type": "string",
+                        "description": "sort by id or name, descending when prefixed with -",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only return items whose name contains this value",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only return villages of this district",
+                        "name": "district_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only return items of this regency",
+                        "name": "regency_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only return items of this province",
+                        "name": "province_id",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "desa",
+                            "kelurahan"
+                        ],
+                        "type": "string",
+                        "description": "only return villages of this type",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma-separated fields of each item to return, such as id,name",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma-separated parents to embed in each item, all of them when omitted",
+                        "name": "expand",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -90,6 +286,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/controller.villagesResponse"
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -128,6 +330,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/controller.districtResponse"
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -143,19 +351,19 @@ const docTemplate = `{
                 }
             }
         },
-        "/districts/{id}/villages": {
+        "/districts/{id}/geometry": {
             "get": {
-                "description": "Get villages by district ID",
+                "description": "Get the boundary of a district as a GeoJSON Feature, whose geometry is null when the boundary isn't known",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/geo+json"
                 ],
                 "tags": [
                     "districts"
                 ],
-                "summary": "Get Villages by District ID",
+                "summary": "Get District Geometry",
                 "parameters": [
                     {
                         "type": "integer",
@@ -163,13 +371,31 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "simplify the geometry, dropping the positions closer than this many degrees to the simplified lines",
+                        "name": "tolerance",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/controller.villagesResponse"
+                            "$ref": "#/definitions/geojson.Feature"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
                     "500": {
@@ -181,9 +407,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/provinces": {
+        "/districts/{id}/villages": {
             "get": {
-                "description": "Get provinces",
+                "description": "Get villages by district ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -191,14 +417,91 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "provinces"
+                    "districts"
                 ],
-                "summary": "Get Provinces",
+                "summary": "Get Villages by District ID",
                 "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "District ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page number, starting from 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "maximum number of items per page, from 1 to 100 (default 50)",
+                        "name": "limit",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "description": "province name search by keyword",
-                        "name": "keyword",
+                        "description": "only return items after this ID, can't be combined with page",
+                        "name": "after",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "id",
+                            "-id",
+                            "name",
+                            "-name"
+                        ],
+                        "type": "string",
+                        "description": "sort by id or name, descending when prefixed with -",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only return items whose name contains this value",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only return villages of this district",
+                        "name": "district_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only return items of this regency",
+                        "name": "regency_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only return items of this province",
+                        "name": "province_id",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "desa",
+                            "kelurahan"
+                        ],
+                        "type": "string",
+                        "description": "only return villages of this type",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma-separated fields of each item to return, such as id,name",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma-separated parents to embed in each item, all of them when omitted",
+                        "name": "expand",
                         "in": "query"
                     }
                 ],
@@ -206,7 +509,19 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/controller.provincesResponse"
+                            "$ref": "#/definitions/controller.villagesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
                     "500": {
@@ -218,33 +533,45 @@ const docTemplate = `{
                 }
             }
         },
-        "/provinces/{id}": {
+        "/districts/{id}/villages/geometry": {
             "get": {
-                "description": "get provinces by ID",
+                "description": "Get the boundaries of the villages of a district as a GeoJSON FeatureCollection, for whole-district exports",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/geo+json"
                 ],
                 "tags": [
-                    "provinces"
+                    "districts"
                 ],
-                "summary": "Get Province by ID",
+                "summary": "Get Villages Geometry by District ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Province ID",
+                        "description": "District ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "simplify the geometries, dropping the positions closer than this many degrees to the simplified lines",
+                        "name": "tolerance",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/controller.provinceResponse"
+                            "$ref": "#/definitions/geojson.FeatureCollection"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
                     "404": {
@@ -262,9 +589,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/regencies": {
-            "get": {
-                "description": "Get regencies",
+        "/districts:batchGet": {
+            "post": {
+                "description": "Get the districts with the given IDs in one request, listing the IDs that don't belong to any district and the malformed ones",
                 "consumes": [
                     "application/json"
                 ],
@@ -272,22 +599,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "regencies"
+                    "districts"
                 ],
-                "summary": "Get Regencies",
+                "summary": "Batch Get Districts",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "regency name search by keyword",
-                        "name": "keyword",
-                        "in": "query"
+                        "description": "District IDs, at most MAX_BATCH_SIZE (default 100) of them",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controller.batchGetRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/controller.regenciesResponse"
+                            "$ref": "#/definitions/controller.districtsBatchResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
                     "500": {
@@ -299,9 +635,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/regencies/{id}": {
+        "/locate": {
             "get": {
-                "description": "get regencies by ID",
+                "description": "Get the village, district, regency and province whose boundaries contain a point, without the village when only the district boundary contains it",
                 "consumes": [
                     "application/json"
                 ],
@@ -309,14 +645,71 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "regencies"
+                    "locate"
                 ],
-                "summary": "Get Regency by ID",
+                "summary": "Locate",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Regency ID",
-                        "name": "id",
+                        "type": "number",
+                        "description": "latitude of the point, from -90 to 90",
+                        "name": "lat",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "longitude of the point, from -180 to 180",
+                        "name": "lng",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.locateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/postal-codes/{code}": {
+            "get": {
+                "description": "Get every village served by a postal code",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "postal-codes"
+                ],
+                "summary": "Get Villages by Postal Code",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Postal code of 5 digits",
+                        "name": "code",
                         "in": "path",
                         "required": true
                     }
@@ -325,7 +718,13 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/controller.regencyResponse"
+                            "$ref": "#/definitions/controller.postalCodeVillagesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
                     "404": {
@@ -343,9 +742,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/villages": {
+        "/provinces": {
             "get": {
-                "description": "Get villages",
+                "description": "Get provinces",
                 "consumes": [
                     "application/json"
                 ],
@@ -353,22 +752,86 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "villages"
+                    "provinces"
                 ],
-                "summary": "Get Villages",
+                "summary": "Get Provinces",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "village name search by keyword",
+                        "description": "province name search by keyword",
                         "name": "keyword",
                         "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "contains",
+                            "fuzzy"
+                        ],
+                        "type": "string",
+                        "description": "how keyword is matched, fuzzy ranks typo-tolerant matches by their score, from 0 to 1",
+                        "name": "match",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page number, starting from 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "maximum number of items per page, from 1 to 100 (default 50)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only return items after this ID, can't be combined with page",
+                        "name": "after",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "id",
+                            "-id",
+                            "name",
+                            "-name"
+                        ],
+                        "type": "string",
+                        "description": "sort by id or name, descending when prefixed with -",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only return items whose name contains this value",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma-separated fields of each item to return, such as id,name",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma-separated parents to embed in each item, all of them when omitted",
+                        "name": "expand",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/controller.villagesResponse"
+                            "$ref": "#/definitions/controller.provincesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
                     "500": {
@@ -380,9 +843,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/villages/{id}": {
+        "/provinces/{id}": {
             "get": {
-                "description": "get villages by ID",
+                "description": "get provinces by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -390,13 +853,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "villages"
+                    "provinces"
                 ],
-                "summary": "Get Village by ID",
+                "summary": "Get Province by ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Village ID",
+                        "description": "Province ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -406,7 +869,167 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/controller.villageResponse"
+                            "$ref": "#/definitions/controller.provinceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/provinces/{id}/regencies": {
+            "get": {
+                "description": "Get regencies by province ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "provinces"
+                ],
+                "summary": "Get Regencies by Province ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Province ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page number, starting from 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "maximum number of items per page, from 1 to 100 (default 50)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only return items after this ID, can't be combined with page",
+                        "name": "after",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "id",
+                            "-id",
+                            "name",
+                            "-name"
+                        ],
+                        "type": "string",
+                        "description": "sort by id or name, descending when prefixed with -",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only return items whose name contains this value",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma-separated fields of each item to return, such as id,name",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma-separated parents to embed in each item, all of them when omitted",
+                        "name": "expand",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.regenciesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/provinces/{id}/tree": {
+            "get": {
+                "description": "Get a province with its regencies, districts and villages nested",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "provinces"
+                ],
+                "summary": "Get Province Tree",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Province ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "number of levels below the province, from 0 to 3 (default 3)",
+                        "name": "depth",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.provinceTreeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
                     "404": {
@@ -423,39 +1046,1058 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "controller.districtResponse": {
+        },
+        "/regencies": {
+            "get": {
+                "description": "Get regencies",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "regencies"
+                ],
+                "summary": "Get Regencies",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "regency name search by keyword",
+                        "name": "keyword",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "contains",
+                            "fuzzy"
+                        ],
+                        "type": "string",
+                        "description": "how keyword is matched, fuzzy ranks typo-tolerant matches by their score, from 0 to 1",
+                        "name": "match",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page number, starting from 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "maximum number of items per page, from 1 to 100 (default 50)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only return items after this ID, can't be combined with page",
+                        "name": "after",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "id",
+                            "-id",
+                            "name",
+                            "-name"
+                        ],
+                        "type": "string",
+                        "description": "sort by id or name, descending when prefixed with -",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only return items whose name contains this value",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only return items of this province",
+                        "name": "province_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma-separated fields of each item to return, such as id,name",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma-separated parents to embed in each item, all of them when omitted",
+                        "name": "expand",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.regenciesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/regencies/{id}": {
+            "get": {
+                "description": "get regencies by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "regencies"
+                ],
+                "summary": "Get Regency by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Regency ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.regencyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/regencies/{id}/districts": {
+            "get": {
+                "description": "Get districts by regency ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "regencies"
+                ],
+                "summary": "Get Districts by Regency ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Regency ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page number, starting from 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "maximum number of items per page, from 1 to 100 (default 50)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only return items after this ID, can't be combined with page",
+                        "name": "after",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "id",
+                            "-id",
+                            "name",
+                            "-name"
+                        ],
+                        "type": "string",
+                        "description": "sort by id or name, descending when prefixed with -",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only return items whose name contains this value",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only return items of this province",
+                        "name": "province_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma-separated fields of each item to return, such as id,name",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma-separated parents to embed in each item, all of them when omitted",
+                        "name": "expand",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.districtsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/regencies/{id}/tree": {
+            "get": {
+                "description": "Get a regency with its province, districts and villages nested",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "regencies"
+                ],
+                "summary": "Get Regency Tree",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Regency ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "number of levels below the regency, from 0 to 2 (default 2)",
+                        "name": "depth",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.regencyTreeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/search": {
+            "get": {
+                "description": "Search the provinces, regencies, districts and villages whose name contains the keyword at once, most relevant first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "search"
+                ],
+                "summary": "Search",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "keyword the names must contain",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma-separated levels to search, all of them when omitted, such as district,village",
+                        "name": "level",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "maximum number of hits, from 1 to 100 (default 50)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.searchResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/suggest": {
+            "get": {
+                "description": "Suggest the provinces, regencies, districts and villages having a word starting with the prefix, for typeahead",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "suggest"
+                ],
+                "summary": "Suggest",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "prefix of a word of the names",
+                        "name": "prefix",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "maximum number of suggestions, from 1 to 100 (default 10)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.suggestResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/villages": {
+            "get": {
+                "description": "Get villages",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "villages"
+                ],
+                "summary": "Get Villages",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "village name search by keyword",
+                        "name": "keyword",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "contains",
+                            "fuzzy"
+                        ],
+                        "type": "string",
+                        "description": "how keyword is matched, fuzzy ranks typo-tolerant matches by their score, from 0 to 1",
+                        "name": "match",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page number, starting from 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "maximum number of items per page, from 1 to 100 (default 50)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only return items after this ID, can't be combined with page",
+                        "name": "after",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "id",
+                            "-id",
+                            "name",
+                            "-name"
+                        ],
+                        "type": "string",
+                        "description": "sort by id or name, descending when prefixed with -",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only return items whose name contains this value",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only return villages of this district",
+                        "name": "district_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only return items of this regency",
+                        "name": "regency_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only return items of this province",
+                        "name": "province_id",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "desa",
+                            "kelurahan"
+                        ],
+                        "type": "string",
+                        "description": "only return villages of this type",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma-separated fields of each item to return, such as id,name",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma-separated parents to embed in each item, all of them when omitted",
+                        "name": "expand",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.villagesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/villages/nearest": {
+            "get": {
+                "description": "Get the villages whose centroid is the closest to a point, nearest first, with their distance in meters",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "villages"
+                ],
+                "summary": "Get Nearest Villages",
+                "parameters": [
+                    {
+                        "type": "number",
+                        "description": "latitude of the point, from -90 to 90",
+                        "name": "lat",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "longitude of the point, from -180 to 180",
+                        "name": "lng",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "maximum number of villages, from 1 to 100 (default 10)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.villagesNearestResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/villages/{id}": {
+            "get": {
+                "description": "get villages by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "villages"
+                ],
+                "summary": "Get Village by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Village ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.villageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/villages/{id}/geometry": {
+            "get": {
+                "description": "Get the boundary of a village as a GeoJSON Feature, whose geometry is null when the boundary isn't known",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/geo+json"
+                ],
+                "tags": [
+                    "villages"
+                ],
+                "summary": "Get Village Geometry",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Village ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "simplify the geometry, dropping the positions closer than this many degrees to the simplified lines",
+                        "name": "tolerance",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/geojson.Feature"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/villages:batchGet": {
+            "post": {
+                "description": "Get the villages with the given IDs in one request, listing the IDs that don't belong to any village and the malformed ones",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "villages"
+                ],
+                "summary": "Batch Get Villages",
+                "parameters": [
+                    {
+                        "description": "Village IDs, at most MAX_BATCH_SIZE (default 100) of them",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controller.batchGetRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.villagesBatchResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "controller.batchGetRequest": {
+            "type": "object",
+            "properties": {
+                "ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "controller.codeResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/model.Code"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "controller.districtResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/model.District"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "controller.districtsBatchData": {
+            "type": "object",
+            "properties": {
+                "districts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.District"
+                    }
+                },
+                "invalid": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "missing": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "controller.districtsBatchResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/controller.districtsBatchData"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "controller.districtsData": {
+            "type": "object",
+            "properties": {
+                "districts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.District"
+                    }
+                }
+            }
+        },
+        "controller.districtsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/controller.districtsData"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "pagination": {
+                    "$ref": "#/definitions/model.Pagination"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "controller.locateResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/model.Location"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "controller.postalCodeVillagesResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/controller.villagesData"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "controller.provinceResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/model.Province"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "controller.provinceTreeResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/model.ProvinceTree"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "controller.provincesData": {
+            "type": "object",
+            "properties": {
+                "provices": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Province"
+                    }
+                }
+            }
+        },
+        "controller.provincesResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/controller.provincesData"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "pagination": {
+                    "$ref": "#/definitions/model.Pagination"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "controller.regenciesData": {
+            "type": "object",
+            "properties": {
+                "regencies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Regency"
+                    }
+                }
+            }
+        },
+        "controller.regenciesResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/controller.regenciesData"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "pagination": {
+                    "$ref": "#/definitions/model.Pagination"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "controller.regencyResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/model.Regency"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "controller.regencyTreeResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/model.RegencyTree"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "controller.searchData": {
+            "type": "object",
+            "properties": {
+                "hits": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.SearchHit"
+                    }
+                }
+            }
+        },
+        "controller.searchResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/model.District"
+                    "$ref": "#/definitions/controller.searchData"
                 },
                 "message": {
                     "type": "string"
                 },
+                "pagination": {
+                    "$ref": "#/definitions/model.Pagination"
+                },
                 "status": {
                     "type": "string"
                 }
             }
         },
-        "controller.districtsData": {
+        "controller.suggestData": {
             "type": "object",
             "properties": {
-                "districts": {
+                "suggestions": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/model.District"
+                        "$ref": "#/definitions/model.Suggestion"
                     }
                 }
             }
         },
-        "controller.districtsResponse": {
+        "controller.suggestResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/controller.districtsData"
+                    "$ref": "#/definitions/controller.suggestData"
                 },
                 "message": {
                     "type": "string"
@@ -465,11 +2107,11 @@ const docTemplate = `{
                 }
             }
         },
-        "controller.provinceResponse": {
+        "controller.villageResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/model.Province"
+                    "$ref": "#/definitions/model.Village"
                 },
                 "message": {
                     "type": "string"
@@ -479,22 +2121,34 @@ const docTemplate = `{
                 }
             }
         },
-        "controller.provincesData": {
+        "controller.villagesBatchData": {
             "type": "object",
             "properties": {
-                "provices": {
+                "invalid": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/model.Province"
+                        "type": "string"
+                    }
+                },
+                "missing": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "villages": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Village"
                     }
                 }
             }
         },
-        "controller.provincesResponse": {
+        "controller.villagesBatchResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/controller.provincesData"
+                    "$ref": "#/definitions/controller.villagesBatchData"
                 },
                 "message": {
                     "type": "string"
@@ -504,22 +2158,22 @@ const docTemplate = `{
                 }
             }
         },
-        "controller.regenciesData": {
+        "controller.villagesData": {
             "type": "object",
             "properties": {
-                "regencies": {
+                "villages": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/model.Regency"
+                        "$ref": "#/definitions/model.Village"
                     }
                 }
             }
         },
-        "controller.regenciesResponse": {
+        "controller.villagesNearestResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/controller.regenciesData"
+                    "$ref": "#/definitions/controller.villagesData"
                 },
                 "message": {
                     "type": "string"
@@ -529,93 +2183,254 @@ const docTemplate = `{
                 }
             }
         },
-        "controller.regencyResponse": {
+        "controller.villagesResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/model.Regency"
+                    "$ref": "#/definitions/controller.villagesData"
                 },
                 "message": {
                     "type": "string"
                 },
+                "pagination": {
+                    "$ref": "#/definitions/model.Pagination"
+                },
                 "status": {
                     "type": "string"
                 }
             }
         },
-        "controller.villageResponse": {
+        "echo.HTTPError": {
             "type": "object",
             "properties": {
-                "data": {
-                    "$ref": "#/definitions/model.Village"
+                "message": {}
+            }
+        },
+        "geojson.Feature": {
+            "type": "object",
+            "properties": {
+                "geometry": {
+                    "type": "object"
                 },
-                "message": {
+                "id": {
                     "type": "string"
                 },
-                "status": {
+                "properties": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "any"
+                    }
+                },
+                "type": {
                     "type": "string"
                 }
             }
         },
-        "controller.villagesData": {
+        "geojson.FeatureCollection": {
             "type": "object",
             "properties": {
-                "villages": {
+                "features": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/model.Village"
+                        "$ref": "#/definitions/geojson.Feature"
                     }
+                },
+                "type": {
+                    "type": "string"
                 }
             }
         },
-        "controller.villagesResponse": {
+        "model.Code": {
             "type": "object",
             "properties": {
-                "data": {
-                    "$ref": "#/definitions/controller.villagesData"
-                },
-                "message": {
+                "code": {
                     "type": "string"
                 },
-                "status": {
+                "known": {
+                    "description": "Known tells whether the code itself exists, which implies that every part of it does.",
+                    "type": "boolean"
+                },
+                "level": {
                     "type": "string"
+                },
+                "parts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.CodePart"
+                    }
                 }
             }
         },
-        "echo.HTTPError": {
+        "model.CodePart": {
             "type": "object",
             "properties": {
-                "message": {}
+                "code": {
+                    "type": "string"
+                },
+                "known": {
+                    "type": "boolean"
+                },
+                "level": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
             }
         },
         "model.District": {
             "type": "object",
             "properties": {
+                "display_name": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "latitude": {
+                    "description": "Latitude and Longitude locate the centroid of the district, omitted when it isn't known.",
+                    "type": "number"
+                },
+                "longitude": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "regency": {
+                    "$ref": "#/definitions/model.Regency"
+                },
+                "score": {
+                    "type": "number"
+                }
+            }
+        },
+        "model.DistrictTree": {
+            "type": "object",
+            "properties": {
+                "display_name": {
+                    "type": "string"
+                },
                 "id": {
                     "type": "string"
                 },
                 "name": {
                     "type": "string"
                 },
+                "villages": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.VillageTree"
+                    }
+                }
+            }
+        },
+        "model.Location": {
+            "type": "object",
+            "properties": {
+                "district": {
+                    "$ref": "#/definitions/model.District"
+                },
+                "province": {
+                    "$ref": "#/definitions/model.Province"
+                },
                 "regency": {
                     "$ref": "#/definitions/model.Regency"
+                },
+                "village": {
+                    "$ref": "#/definitions/model.Village"
+                }
+            }
+        },
+        "model.Pagination": {
+            "type": "object",
+            "properties": {
+                "limit": {
+                    "type": "integer"
+                },
+                "next": {
+                    "type": "string"
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "prev": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "integer"
                 }
             }
         },
         "model.Province": {
             "type": "object",
             "properties": {
+                "display_name": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "score": {
+                    "type": "number"
+                }
+            }
+        },
+        "model.ProvinceTree": {
+            "type": "object",
+            "properties": {
+                "display_name": {
+                    "type": "string"
+                },
                 "id": {
                     "type": "string"
                 },
                 "name": {
                     "type": "string"
+                },
+                "regencies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.RegencyTree"
+                    }
                 }
             }
         },
         "model.Regency": {
             "type": "object",
             "properties": {
+                "display_name": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "province": {
+                    "$ref": "#/definitions/model.Province"
+                },
+                "score": {
+                    "type": "number"
+                }
+            }
+        },
+        "model.RegencyTree": {
+            "type": "object",
+            "properties": {
+                "display_name": {
+                    "type": "string"
+                },
+                "districts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.DistrictTree"
+                    }
+                },
                 "id": {
                     "type": "string"
                 },
@@ -627,15 +2442,103 @@ const docTemplate = `{
                 }
             }
         },
+        "model.SearchHit": {
+            "type": "object",
+            "properties": {
+                "display_name": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "level": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "path": {
+                    "description": "Path is the name of the unit followed by the names of its ancestors, separated by commas.",
+                    "type": "string"
+                },
+                "score": {
+                    "description": "Score is the similarity of the name to the search, from 0 to 1, by which the hits are ranked.",
+                    "type": "number"
+                }
+            }
+        },
+        "model.Suggestion": {
+            "type": "object",
+            "properties": {
+                "display_name": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "level": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "path": {
+                    "description": "Path is the name of the unit followed by the names of its ancestors, separated by commas.",
+                    "type": "string"
+                }
+            }
+        },
         "model.Village": {
             "type": "object",
             "properties": {
+                "display_name": {
+                    "type": "string"
+                },
+                "distance": {
+                    "description": "Distance is how far the centroid is from the point of a nearest search, in meters.",
+                    "type": "number"
+                },
                 "district": {
                     "$ref": "#/definitions/model.District"
                 },
                 "id": {
                     "type": "string"
                 },
+                "latitude": {
+                    "description": "Latitude and Longitude locate the centroid of the village, omitted when it isn't known.",
+                    "type": "number"
+                },
+                "longitude": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "postal_codes": {
+                    "description": "PostalCodes are the kode pos serving the village, in ascending order, omitted when none is known.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "score": {
+                    "type": "number"
+                },
+                "type": {
+                    "description": "Type is desa for a rural village, or kelurahan for an urban one.",
+                    "type": "string"
+                }
+            }
+        },
+        "model.VillageTree": {
+            "type": "object",
+            "properties": {
+                "display_name": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
                 "name": {
                     "type": "string"
                 }
@@ -0,0 +1,7 @@
+package entity
+
+type Boundary struct {
+	ID       string
+	Name     string
+	Geometry *string
+}
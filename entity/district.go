@@ -1,7 +1,9 @@
 package entity
 
 type District struct {
-	ID      string
-	Name    string
-	Regency Regency
+	ID        string
+	Name      string
+	Latitude  *float64
+	Longitude *float64
+	Regency   Regency
 }
@@ -1,7 +1,11 @@
 package entity
 
 type Village struct {
-	ID       string
-	Name     string
-	District District
+	ID          string
+	Name        string
+	Type        string
+	Latitude    *float64
+	Longitude   *float64
+	PostalCodes []string
+	District    District
 }
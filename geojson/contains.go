@@ -0,0 +1,72 @@
+package geojson
+
+import "math"
+
+// BBox is the smallest rectangle holding a geometry, from its south-west corner to its north-east one.
+type BBox struct {
+	Min Position
+	Max Position
+}
+
+// Contains reports whether p lies inside b or on its edges.
+func (b BBox) Contains(p Position) bool {
+	return p[0] >= b.Min[0] && p[0] <= b.Max[0] && p[1] >= b.Min[1] && p[1] <= b.Max[1]
+}
+
+// Bounds returns the bounding box of the exterior rings of g.
+func (g Geometry) Bounds() BBox {
+	bounds := BBox{
+		Min: Position{math.Inf(1), math.Inf(1)},
+		Max: Position{math.Inf(-1), math.Inf(-1)},
+	}
+
+	for _, polygon := range g.Polygons {
+		for _, position := range polygon[0] {
+			bounds.Min[0] = math.Min(bounds.Min[0], position[0])
+			bounds.Min[1] = math.Min(bounds.Min[1], position[1])
+			bounds.Max[0] = math.Max(bounds.Max[0], position[0])
+			bounds.Max[1] = math.Max(bounds.Max[1], position[1])
+		}
+	}
+
+	return bounds
+}
+
+// Contains reports whether p lies inside one of the polygons of g, outside of their holes. A point
+// exactly on an edge may be reported on either side.
+func (g Geometry) Contains(p Position) bool {
+	for _, polygon := range g.Polygons {
+		if !polygon[0].contains(p) {
+			continue
+		}
+
+		inHole := false
+		for _, hole := range polygon[1:] {
+			if hole.contains(p) {
+				inHole = true
+				break
+			}
+		}
+
+		if !inHole {
+			return true
+		}
+	}
+
+	return false
+}
+
+// contains casts a ray from p towards the east and counts the edges of r it crosses, p being inside
+// when the count is odd.
+func (r Ring) contains(p Position) bool {
+	inside := false
+
+	for i, j := 0, len(r)-1; i < len(r); j, i = i, i+1 {
+		a, b := r[i], r[j]
+		if (a[1] > p[1]) != (b[1] > p[1]) && p[0] < (b[0]-a[0])*(p[1]-a[1])/(b[1]-a[1])+a[0] {
+			inside = !inside
+		}
+	}
+
+	return inside
+}
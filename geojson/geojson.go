@@ -0,0 +1,122 @@
+// Package geojson reads and writes the RFC 7946 objects describing the boundaries of the
+// administrative units.
+package geojson
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// The types of the GeoJSON objects read and written by this package.
+const (
+	TypePolygon           = "Polygon"
+	TypeMultiPolygon      = "MultiPolygon"
+	TypeFeature           = "Feature"
+	TypeFeatureCollection = "FeatureCollection"
+)
+
+// Position is a longitude followed by a latitude, in degrees. An altitude, if any, is dropped.
+type Position [2]float64
+
+// Ring is a closed line, its last position being the same as its first.
+type Ring []Position
+
+// Polygon is an exterior ring followed by the rings of its holes.
+type Polygon []Ring
+
+// Geometry is a Polygon or a MultiPolygon, the only geometries bounding an area.
+type Geometry struct {
+	Type string
+	// Polygons holds the single polygon of a Polygon, or every polygon of a MultiPolygon.
+	Polygons []Polygon
+}
+
+type rawGeometry struct {
+	Type        string          `json:"type"`
+	Coordinates json.RawMessage `json:"coordinates"`
+}
+
+// Parse reads a GeoJSON geometry, rejecting the ones that don't bound an area.
+func Parse(data []byte) (geometry Geometry, err error) {
+	err = json.Unmarshal(data, &geometry)
+	return
+}
+
+func (g Geometry) MarshalJSON() ([]byte, error) {
+	raw := struct {
+		Type        string `json:"type"`
+		Coordinates any    `json:"coordinates"`
+	}{Type: g.Type}
+
+	switch g.Type {
+	case TypePolygon:
+		if len(g.Polygons) != 1 {
+			return nil, fmt.Errorf("geojson: a polygon has %d polygons", len(g.Polygons))
+		}
+		raw.Coordinates = g.Polygons[0]
+	case TypeMultiPolygon:
+		raw.Coordinates = g.Polygons
+	default:
+		return nil, fmt.Errorf("geojson: unsupported geometry type %q", g.Type)
+	}
+
+	return json.Marshal(raw)
+}
+
+func (g *Geometry) UnmarshalJSON(data []byte) (err error) {
+	var raw rawGeometry
+	if err = json.Unmarshal(data, &raw); err != nil {
+		return
+	}
+
+	switch raw.Type {
+	case TypePolygon:
+		var polygon Polygon
+		if err = json.Unmarshal(raw.Coordinates, &polygon); err != nil {
+			return
+		}
+		g.Polygons = []Polygon{polygon}
+	case TypeMultiPolygon:
+		if err = json.Unmarshal(raw.Coordinates, &g.Polygons); err != nil {
+			return
+		}
+	default:
+		return fmt.Errorf("geojson: unsupported geometry type %q", raw.Type)
+	}
+
+	for _, polygon := range g.Polygons {
+		if len(polygon) == 0 {
+			return fmt.Errorf("geojson: a polygon has no exterior ring")
+		}
+		for _, ring := range polygon {
+			if len(ring) < 4 || ring[0] != ring[len(ring)-1] {
+				return fmt.Errorf("geojson: a ring must be closed and have at least 4 positions")
+			}
+		}
+	}
+
+	g.Type = raw.Type
+	return
+}
+
+// Feature is a geometry along with the properties of what it locates. Its geometry is nil when the
+// location is unknown.
+type Feature struct {
+	Type       string         `json:"type"`
+	ID         string         `json:"id"`
+	Geometry   *Geometry      `json:"geometry" swaggertype:"object"`
+	Properties map[string]any `json:"properties"`
+}
+
+func NewFeature(id string, geometry *Geometry, properties map[string]any) Feature {
+	return Feature{Type: TypeFeature, ID: id, Geometry: geometry, Properties: properties}
+}
+
+type FeatureCollection struct {
+	Type     string    `json:"type"`
+	Features []Feature `json:"features"`
+}
+
+func NewFeatureCollection(features []Feature) FeatureCollection {
+	return FeatureCollection{Type: TypeFeatureCollection, Features: features}
+}
@@ -0,0 +1,149 @@
+package geojson
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGeoJSON(t *testing.T) {
+	square := `[[111.4,-7.9],[111.5,-7.9],[111.5,-7.8],[111.4,-7.8],[111.4,-7.9]]`
+
+	t.Run("TestParse", func(t *testing.T) {
+		t.Run("it should read a single polygon, when given a Polygon", func(t *testing.T) {
+			got, err := Parse([]byte(`{"type":"Polygon","coordinates":[` + square + `]}`))
+			if assert.NoError(t, err) {
+				assert.Equal(t, TypePolygon, got.Type)
+				if assert.Len(t, got.Polygons, 1) && assert.Len(t, got.Polygons[0], 1) {
+					assert.Equal(t, Position{111.5, -7.8}, got.Polygons[0][0][2])
+				}
+			}
+		})
+
+		t.Run("it should read every polygon, when given a MultiPolygon", func(t *testing.T) {
+			got, err := Parse([]byte(`{"type":"MultiPolygon","coordinates":[[` + square + `],[` + square + `]]}`))
+			if assert.NoError(t, err) {
+				assert.Equal(t, TypeMultiPolygon, got.Type)
+				assert.Len(t, got.Polygons, 2)
+			}
+		})
+
+		t.Run("it should drop the altitude, when given positions with three elements", func(t *testing.T) {
+			got, err := Parse([]byte(`{"type":"Polygon","coordinates":[[[111.4,-7.9,90],[111.5,-7.9,90],[111.5,-7.8,90],[111.4,-7.9,90]]]}`))
+			if assert.NoError(t, err) {
+				assert.Equal(t, Position{111.4, -7.9}, got.Polygons[0][0][0])
+			}
+		})
+
+		testCases := []struct {
+			name  string
+			given string
+		}{
+			{name: "it should return error, when given a geometry not bounding an area", given: `{"type":"Point","coordinates":[111.4,-7.9]}`},
+			{name: "it should return error, when given a ring that isn't closed", given: `{"type":"Polygon","coordinates":[[[111.4,-7.9],[111.5,-7.9],[111.5,-7.8],[111.4,-7.8]]]}`},
+			{name: "it should return error, when given a ring with less than 4 positions", given: `{"type":"Polygon","coordinates":[[[111.4,-7.9],[111.5,-7.9],[111.4,-7.9]]]}`},
+			{name: "it should return error, when given a polygon without ring", given: `{"type":"MultiPolygon","coordinates":[[]]}`},
+			{name: "it should return error, when given malformed JSON", given: `{"type":"Polygon"`},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				_, err := Parse([]byte(testCase.given))
+				assert.Error(t, err)
+			})
+		}
+	})
+
+	t.Run("TestMarshalJSON", func(t *testing.T) {
+		t.Run("it should write the coordinates back, when given a parsed geometry", func(t *testing.T) {
+			for _, given := range []string{
+				`{"type":"Polygon","coordinates":[` + square + `]}`,
+				`{"type":"MultiPolygon","coordinates":[[` + square + `]]}`,
+			} {
+				geometry, err := Parse([]byte(given))
+				if assert.NoError(t, err) {
+					got, err := json.Marshal(geometry)
+					assert.NoError(t, err)
+					assert.JSONEq(t, given, string(got))
+				}
+			}
+		})
+
+		t.Run("it should write a null geometry, when given a feature without geometry", func(t *testing.T) {
+			got, err := json.Marshal(NewFeature("3502010", nil, map[string]any{"name": "NGRAYUN"}))
+			assert.NoError(t, err)
+			assert.JSONEq(t, `{"type":"Feature","id":"3502010","geometry":null,"properties":{"name":"NGRAYUN"}}`, string(got))
+		})
+
+		t.Run("it should return error, when given an unsupported geometry", func(t *testing.T) {
+			_, err := json.Marshal(Geometry{Type: "Point"})
+			assert.Error(t, err)
+		})
+	})
+
+	t.Run("TestSimplify", func(t *testing.T) {
+		// A square whose sides wobble by 0.001 degrees.
+		geometry, err := Parse([]byte(`{"type":"Polygon","coordinates":[[[111.4,-7.9],[111.45,-7.901],[111.5,-7.9],[111.501,-7.85],[111.5,-7.8],[111.45,-7.799],[111.4,-7.8],[111.399,-7.85],[111.4,-7.9]]]}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		t.Run("it should drop the positions within tolerance, when given a tolerance wider than the wobbles", func(t *testing.T) {
+			got := geometry.Simplify(0.01)
+			assert.Equal(t, Ring{{111.4, -7.9}, {111.5, -7.9}, {111.5, -7.8}, {111.4, -7.8}, {111.4, -7.9}}, got.Polygons[0][0])
+			assert.Len(t, geometry.Polygons[0][0], 9)
+		})
+
+		t.Run("it should keep every position, when given a tolerance narrower than the wobbles", func(t *testing.T) {
+			got := geometry.Simplify(0.0001)
+			assert.Equal(t, geometry.Polygons[0][0], got.Polygons[0][0])
+		})
+
+		t.Run("it should keep the ring as is, when simplifying would leave less than 4 positions", func(t *testing.T) {
+			got := geometry.Simplify(1)
+			assert.Equal(t, geometry.Polygons[0][0], got.Polygons[0][0])
+		})
+
+		t.Run("it should keep every position, when given zero tolerance", func(t *testing.T) {
+			got := geometry.Simplify(0)
+			assert.Equal(t, geometry, got)
+		})
+	})
+
+	t.Run("TestContains", func(t *testing.T) {
+		// A square with a square hole in its middle, next to a triangle.
+		geometry, err := Parse([]byte(`{"type":"MultiPolygon","coordinates":[
+			[[[0,0],[4,0],[4,4],[0,4],[0,0]],[[1,1],[3,1],[3,3],[1,3],[1,1]]],
+			[[[5,0],[7,0],[6,2],[5,0]]]
+		]}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		testCases := []struct {
+			name     string
+			given    Position
+			expected bool
+		}{
+			{name: "it should return true, when the point is inside the exterior ring", given: Position{0.5, 2}, expected: true},
+			{name: "it should return false, when the point is inside a hole", given: Position{2, 2}, expected: false},
+			{name: "it should return true, when the point is inside another polygon", given: Position{6, 1}, expected: true},
+			{name: "it should return false, when the point is inside the bounds but outside the polygons", given: Position{6.9, 1.9}, expected: false},
+			{name: "it should return false, when the point is outside the bounds", given: Position{-1, 2}, expected: false},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				assert.Equal(t, testCase.expected, geometry.Contains(testCase.given))
+			})
+		}
+
+		t.Run("it should return the box of the exterior rings, when given a multipolygon", func(t *testing.T) {
+			bounds := geometry.Bounds()
+			assert.Equal(t, BBox{Min: Position{0, 0}, Max: Position{7, 4}}, bounds)
+			assert.True(t, bounds.Contains(Position{7, 4}))
+			assert.False(t, bounds.Contains(Position{7.1, 4}))
+		})
+	})
+}
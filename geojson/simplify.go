@@ -0,0 +1,69 @@
+package geojson
+
+import "math"
+
+// Simplify returns a copy of g whose rings only keep the positions farther than tolerance, in
+// degrees, from the line simplifying them, following the Ramer–Douglas–Peucker algorithm. A ring
+// that would end up with less than 4 positions is kept as is.
+func (g Geometry) Simplify(tolerance float64) Geometry {
+	simplified := Geometry{Type: g.Type, Polygons: make([]Polygon, len(g.Polygons))}
+
+	for i, polygon := range g.Polygons {
+		simplified.Polygons[i] = make(Polygon, len(polygon))
+		for j, ring := range polygon {
+			simplified.Polygons[i][j] = ring.simplify(tolerance)
+		}
+	}
+
+	return simplified
+}
+
+func (r Ring) simplify(tolerance float64) Ring {
+	if tolerance <= 0 || len(r) <= 4 {
+		return append(Ring(nil), r...)
+	}
+
+	keep := make([]bool, len(r))
+	keep[0], keep[len(r)-1] = true, true
+
+	var walk func(first, last int)
+	walk = func(first, last int) {
+		farthest, index := 0.0, 0
+		for i := first + 1; i < last; i++ {
+			if distance := segmentDistance(r[i], r[first], r[last]); distance > farthest {
+				farthest, index = distance, i
+			}
+		}
+
+		if farthest > tolerance {
+			keep[index] = true
+			walk(first, index)
+			walk(index, last)
+		}
+	}
+	walk(0, len(r)-1)
+
+	simplified := make(Ring, 0, len(r))
+	for i, position := range r {
+		if keep[i] {
+			simplified = append(simplified, position)
+		}
+	}
+
+	if len(simplified) < 4 {
+		return append(Ring(nil), r...)
+	}
+	return simplified
+}
+
+// segmentDistance returns the planar distance from p to the segment from a to b.
+func segmentDistance(p, a, b Position) float64 {
+	dx, dy := b[0]-a[0], b[1]-a[1]
+
+	t := 0.0
+	if length := dx*dx + dy*dy; length > 0 {
+		t = math.Max(0, math.Min(1, ((p[0]-a[0])*dx+(p[1]-a[1])*dy)/length))
+	}
+
+	return math.Hypot(p[0]-(a[0]+t*dx), p[1]-(a[1]+t*dy))
+}
@@ -0,0 +1,42 @@
+package interceptor
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+func UnaryLogger() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		start := time.Now()
+		res, err := handler(ctx, req)
+		logCall(info.FullMethod, err, time.Since(start))
+		return res, err
+	}
+}
+
+func StreamLogger() grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		start := time.Now()
+		err := handler(srv, stream)
+		logCall(info.FullMethod, err, time.Since(start))
+		return err
+	}
+}
+
+func logCall(method string, err error, latency time.Duration) {
+	log.Printf("method=%s, code=%s, latency_human=%s\n", method, status.Code(err), latency)
+}
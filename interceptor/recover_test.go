@@ -0,0 +1,43 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRecover(t *testing.T) {
+	t.Run("TestUnaryRecover", func(t *testing.T) {
+		info := &grpc.UnaryServerInfo{FullMethod: "/erikrios.ponorogoregencyapi.ProvinceService/GetProvince"}
+
+		t.Run("it should return the handler response, when the handler does not panic", func(t *testing.T) {
+			res, err := UnaryRecover()(context.Background(), "request", info, func(ctx context.Context, req any) (any, error) {
+				return "response", nil
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, "response", res)
+		})
+
+		t.Run("it should return Internal status code, when the handler panics", func(t *testing.T) {
+			_, err := UnaryRecover()(context.Background(), "request", info, func(ctx context.Context, req any) (any, error) {
+				panic("boom")
+			})
+			assert.Equal(t, codes.Internal, status.Code(err))
+		})
+	})
+
+	t.Run("TestStreamRecover", func(t *testing.T) {
+		info := &grpc.StreamServerInfo{FullMethod: "/erikrios.ponorogoregencyapi.VillageService/StreamVillages"}
+
+		t.Run("it should return Internal status code, when the handler panics", func(t *testing.T) {
+			err := StreamRecover()(nil, nil, info, func(srv any, stream grpc.ServerStream) error {
+				panic("boom")
+			})
+			assert.Equal(t, codes.Internal, status.Code(err))
+		})
+	})
+}
@@ -0,0 +1,49 @@
+package interceptor
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// UnaryTimeout bounds every unary call by the given timeout, unless the client already asked for a
+// shorter deadline.
+func UnaryTimeout(timeout time.Duration) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamTimeout bounds every streaming call by the given timeout, unless the client already asked
+// for a shorter deadline.
+func StreamTimeout(timeout time.Duration) grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		ctx, cancel := context.WithTimeout(stream.Context(), timeout)
+		defer cancel()
+
+		return handler(srv, &contextServerStream{ServerStream: stream, ctx: ctx})
+	}
+}
+
+type contextServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (c *contextServerStream) Context() context.Context {
+	return c.ctx
+}
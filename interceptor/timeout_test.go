@@ -0,0 +1,63 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (f *fakeServerStream) Context() context.Context {
+	return f.ctx
+}
+
+func TestTimeout(t *testing.T) {
+	t.Run("TestUnaryTimeout", func(t *testing.T) {
+		info := &grpc.UnaryServerInfo{FullMethod: "/erikrios.ponorogoregencyapi.ProvinceService/GetProvince"}
+
+		t.Run("it should set a deadline, when the client did not ask for one", func(t *testing.T) {
+			_, err := UnaryTimeout(time.Second)(context.Background(), "request", info, func(ctx context.Context, req any) (any, error) {
+				deadline, ok := ctx.Deadline()
+				assert.True(t, ok)
+				assert.WithinDuration(t, time.Now().Add(time.Second), deadline, 100*time.Millisecond)
+				return nil, nil
+			})
+			assert.NoError(t, err)
+		})
+
+		t.Run("it should keep the client deadline, when it is shorter", func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+			defer cancel()
+			expected, _ := ctx.Deadline()
+
+			_, err := UnaryTimeout(time.Minute)(ctx, "request", info, func(ctx context.Context, req any) (any, error) {
+				deadline, _ := ctx.Deadline()
+				assert.Equal(t, expected, deadline)
+				return nil, nil
+			})
+			assert.NoError(t, err)
+		})
+	})
+
+	t.Run("TestStreamTimeout", func(t *testing.T) {
+		info := &grpc.StreamServerInfo{FullMethod: "/erikrios.ponorogoregencyapi.VillageService/StreamVillages"}
+
+		t.Run("it should expose a deadline through the stream context", func(t *testing.T) {
+			stream := &fakeServerStream{ctx: context.Background()}
+
+			err := StreamTimeout(time.Second)(nil, stream, info, func(srv any, stream grpc.ServerStream) error {
+				_, ok := stream.Context().Deadline()
+				assert.True(t, ok)
+				return nil
+			})
+			assert.NoError(t, err)
+		})
+	})
+}
@@ -1,19 +1,31 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"net"
+	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/erikrios/ponorogo-regency-api/config"
 	"github.com/erikrios/ponorogo-regency-api/controller"
 	_ "github.com/erikrios/ponorogo-regency-api/docs"
+	"github.com/erikrios/ponorogo-regency-api/interceptor"
 	"github.com/erikrios/ponorogo-regency-api/middleware"
 	"github.com/erikrios/ponorogo-regency-api/repository"
+	"github.com/erikrios/ponorogo-regency-api/rpc"
 	"github.com/erikrios/ponorogo-regency-api/service"
 	"github.com/joho/godotenv"
 	"github.com/labstack/echo/v4"
 	echoSwagger "github.com/swaggo/echo-swagger"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
 // @title           Ponorogo Regency API
@@ -43,22 +55,55 @@ func main() {
 		log.Printf("Successfully connected to database with instance address: %p", db)
 	}
 
+	maxBatchSize, err := config.NewMaxBatchSize()
+	if err != nil {
+		log.Fatalln(err.Error())
+	}
+
+	suggestRefreshInterval, err := config.NewSuggestRefreshInterval()
+	if err != nil {
+		log.Fatalln(err.Error())
+	}
+
+	locateRefreshInterval, err := config.NewLocateRefreshInterval()
+	if err != nil {
+		log.Fatalln(err.Error())
+	}
+
 	port := fmt.Sprintf(":%s", os.Getenv("PORT"))
+	grpcPort := fmt.Sprintf(":%s", os.Getenv("GRPC_PORT"))
 
 	provinceRepository := repository.NewProvinceRepositoryImpl(db)
 	regencyRepository := repository.NewRegencyRepositoryImpl(db)
 	districtRepository := repository.NewDistrictRepositoryImpl(db)
 	villageRepository := repository.NewVillageRepositoryImpl(db)
 
-	provinceService := service.NewProvinceServiceImpl(provinceRepository)
-	regencyService := service.NewRegencyServiceImpl(regencyRepository)
+	provinceService := service.NewProvinceServiceImpl(provinceRepository, regencyRepository)
+	regencyService := service.NewRegencyServiceImpl(regencyRepository, districtRepository)
 	districtService := service.NewDistrictServiceImpl(districtRepository, villageRepository)
 	villageService := service.NewVillageServiceImpl(villageRepository)
+	codeService := service.NewCodeServiceImpl(provinceRepository, regencyRepository, districtRepository, villageRepository)
+	searchService := service.NewSearchServiceImpl(provinceRepository, regencyRepository, districtRepository, villageRepository)
+	suggestService := service.NewSuggestServiceImpl(provinceRepository, regencyRepository, districtRepository, villageRepository)
+	locateService := service.NewLocateServiceImpl(villageRepository, districtRepository)
+
+	if err := suggestService.Refresh(context.Background()); err != nil {
+		log.Fatalln(err.Error())
+	}
+
+	if err := locateService.Refresh(context.Background()); err != nil {
+		log.Fatalln(err.Error())
+	}
 
 	provincesController := controller.NewProvincesController(provinceService)
 	regenciesController := controller.NewRegenciesController(regencyService)
-	districtsController := controller.NewDistrictsController(districtService)
-	villagesController := controller.NewVillagesController(villageService)
+	districtsController := controller.NewDistrictsController(districtService, maxBatchSize)
+	villagesController := controller.NewVillagesController(villageService, maxBatchSize)
+	codesController := controller.NewCodesController(codeService)
+	postalCodesController := controller.NewPostalCodesController(villageService)
+	searchController := controller.NewSearchController(searchService)
+	suggestController := controller.NewSuggestController(suggestService)
+	locateController := controller.NewLocateController(locateService)
 
 	e := echo.New()
 
@@ -81,6 +126,106 @@ func main() {
 	regenciesController.Route(g)
 	districtsController.Route(g)
 	villagesController.Route(g)
+	codesController.Route(g)
+	postalCodesController.Route(g)
+	searchController.Route(g)
+	suggestController.Route(g)
+	locateController.Route(g)
+
+	gatewayConn, err := grpc.Dial(
+		fmt.Sprintf("localhost%s", grpcPort),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		log.Fatalln(err.Error())
+	}
+	defer gatewayConn.Close()
+
+	gateway, err := rpc.NewGateway(context.Background(), gatewayConn)
+	if err != nil {
+		log.Fatalln(err.Error())
+	}
+
+	e.Group("/api/v2").Any("/*", echo.WrapHandler(gateway))
+
+	var unaryInterceptors []grpc.UnaryServerInterceptor
+	var streamInterceptors []grpc.StreamServerInterceptor
+
+	if os.Getenv("ENV") == "production" {
+		unaryInterceptors = []grpc.UnaryServerInterceptor{
+			interceptor.UnaryRecover(),
+			interceptor.UnaryTimeout(10 * time.Second),
+		}
+		streamInterceptors = []grpc.StreamServerInterceptor{
+			interceptor.StreamRecover(),
+			interceptor.StreamTimeout(5 * time.Minute),
+		}
+	} else {
+		unaryInterceptors = []grpc.UnaryServerInterceptor{
+			interceptor.UnaryLogger(),
+			interceptor.UnaryRecover(),
+			interceptor.UnaryTimeout(10 * time.Second),
+		}
+		streamInterceptors = []grpc.StreamServerInterceptor{
+			interceptor.StreamLogger(),
+			interceptor.StreamRecover(),
+			interceptor.StreamTimeout(5 * time.Minute),
+		}
+	}
+
+	grpcServer := rpc.NewServer(
+		provinceService,
+		regencyService,
+		districtService,
+		villageService,
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+	)
 
-	e.Logger.Fatal(e.Start(port))
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+
+	listener, err := net.Listen("tcp", grpcPort)
+	if err != nil {
+		log.Fatalln(err.Error())
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	go rpc.WatchHealth(ctx, healthServer, db, 10*time.Second)
+	go service.Watch(ctx, "suggestion", suggestService, suggestRefreshInterval)
+	go service.Watch(ctx, "location", locateService, locateRefreshInterval)
+
+	go func() {
+		if err := e.Start(port); err != nil && err != http.ErrServerClosed {
+			log.Println(err.Error())
+		}
+		stop()
+	}()
+
+	go func() {
+		log.Printf("gRPC server started on %s", listener.Addr())
+		if err := grpcServer.Serve(listener); err != nil {
+			log.Println(err.Error())
+		}
+		stop()
+	}()
+
+	<-ctx.Done()
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	healthServer.Shutdown()
+
+	go func() {
+		<-shutdownCtx.Done()
+		grpcServer.Stop()
+	}()
+	grpcServer.GracefulStop()
+
+	if err := e.Shutdown(shutdownCtx); err != nil {
+		e.Logger.Fatal(err)
+	}
 }
@@ -0,0 +1,2 @@
+ALTER TABLE villages
+    DROP COLUMN IF EXISTS type;
@@ -0,0 +1,16 @@
+ALTER TABLE villages
+    ADD COLUMN type varchar(9) not null default 'desa',
+    ADD constraint villages_type_check
+        check (type IN ('desa', 'kelurahan'));
+
+-- The codes of this dataset don't tell the kelurahan apart, so they are listed one by one: every
+-- village of the PONOROGO district, plus the urban villages of BABADAN, JENANGAN and SIMAN.
+UPDATE villages
+SET type = 'kelurahan'
+WHERE district_id = '3502170'
+   OR id IN ('3502090017', -- RONOWIJAYAN
+             '3502090018', -- MANGUNSUMAN
+             '3502180001', -- KERTOSARI
+             '3502180003', -- PATIHAN WETAN
+             '3502180004', -- KADIPATEN
+             '3502190003'); -- SETONO
@@ -0,0 +1,7 @@
+ALTER TABLE villages
+    DROP COLUMN IF EXISTS latitude,
+    DROP COLUMN IF EXISTS longitude;
+
+ALTER TABLE districts
+    DROP COLUMN IF EXISTS latitude,
+    DROP COLUMN IF EXISTS longitude;
@@ -0,0 +1,42 @@
+ALTER TABLE districts
+    ADD COLUMN latitude  double precision,
+    ADD COLUMN longitude double precision;
+
+ALTER TABLE villages
+    ADD COLUMN latitude  double precision,
+    ADD COLUMN longitude double precision;
+
+-- The centroids are approximated from the seat of each district, as no surveyed boundaries are
+-- available yet. Every village starts at the centroid of its district until its own is loaded.
+UPDATE districts d
+SET latitude  = c.latitude,
+    longitude = c.longitude
+FROM (VALUES ('3502010', -8.1000, 111.4000), -- NGRAYUN
+             ('3502020', -8.0400, 111.4200), -- SLAHUNG
+             ('3502030', -8.0000, 111.4700), -- BUNGKAL
+             ('3502040', -7.9900, 111.5800), -- SAMBIT
+             ('3502050', -7.9800, 111.6500), -- SAWOO
+             ('3502060', -7.8700, 111.7000), -- SOOKO
+             ('3502061', -7.8800, 111.7500), -- PUDAK
+             ('3502070', -7.8300, 111.6400), -- PULUNG
+             ('3502080', -7.9300, 111.5300), -- MLARAK
+             ('3502090', -7.9000, 111.5000), -- SIMAN
+             ('3502100', -7.9200, 111.4500), -- JETIS
+             ('3502110', -7.9800, 111.4000), -- BALONG
+             ('3502120', -7.8900, 111.3800), -- KAUMAN
+             ('3502130', -7.8500, 111.3300), -- JAMBON
+             ('3502140', -7.8900, 111.3100), -- BADEGAN
+             ('3502150', -7.8300, 111.2900), -- SAMPUNG
+             ('3502160', -7.8500, 111.4000), -- SUKOREJO
+             ('3502170', -7.8651, 111.4696), -- PONOROGO
+             ('3502180', -7.8300, 111.4800), -- BABADAN
+             ('3502190', -7.8300, 111.5300), -- JENANGAN
+             ('3502200', -7.8000, 111.6200)) -- NGEBEL
+         AS c (id, latitude, longitude)
+WHERE d.id = c.id;
+
+UPDATE villages v
+SET latitude  = d.latitude,
+    longitude = d.longitude
+FROM districts d
+WHERE d.id = v.district_id;
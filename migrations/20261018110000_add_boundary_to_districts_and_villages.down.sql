@@ -0,0 +1,5 @@
+ALTER TABLE villages
+    DROP COLUMN IF EXISTS boundary;
+
+ALTER TABLE districts
+    DROP COLUMN IF EXISTS boundary;
@@ -0,0 +1,13 @@
+-- The boundaries are GeoJSON Polygon or MultiPolygon geometries, in WGS 84 longitude and latitude.
+-- No boundary is seeded, as the repository has no surveyed source for them: they stay empty until
+-- loaded from the official administrative boundaries, the features of the API having a null
+-- geometry meanwhile.
+ALTER TABLE districts
+    ADD COLUMN boundary jsonb,
+    ADD constraint districts_boundary_check
+        check (boundary IS NULL OR boundary ->> 'type' IN ('Polygon', 'MultiPolygon'));
+
+ALTER TABLE villages
+    ADD COLUMN boundary jsonb,
+    ADD constraint villages_boundary_check
+        check (boundary IS NULL OR boundary ->> 'type' IN ('Polygon', 'MultiPolygon'));
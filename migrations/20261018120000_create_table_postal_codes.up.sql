@@ -0,0 +1,294 @@
+-- A village is served by one postal code at most of the time, but a few large ones are split between
+-- several codes, and a code often serves several villages.
+CREATE TABLE IF NOT EXISTS postal_codes
+(
+    code       char(5)  not null,
+    village_id char(10) not null,
+    primary key (code, village_id),
+    constraint postal_codes_code_check
+        check (code ~ '^[1-9][0-9]{4}$'),
+    constraint postal_codes_village_id_foreign
+        foreign key (village_id)
+            references villages (id) on delete cascade
+);
+
+CREATE INDEX IF NOT EXISTS postal_codes_village_id_index ON postal_codes (village_id);
+
+INSERT INTO postal_codes (code, village_id)
+VALUES ('63464', '3502010001'),
+       ('63464', '3502010002'),
+       ('63464', '3502010003'),
+       ('63464', '3502010004'),
+       ('63464', '3502010005'),
+       ('63464', '3502010006'),
+       ('63464', '3502010007'),
+       ('63464', '3502010008'),
+       ('63464', '3502010010'),
+       ('63464', '3502010011'),
+       ('63463', '3502020001'),
+       ('63463', '3502020002'),
+       ('63463', '3502020003'),
+       ('63463', '3502020004'),
+       ('63463', '3502020005'),
+       ('63463', '3502020006'),
+       ('63463', '3502020007'),
+       ('63463', '3502020008'),
+       ('63463', '3502020009'),
+       ('63463', '3502020010'),
+       ('63463', '3502020011'),
+       ('63463', '3502020012'),
+       ('63463', '3502020014'),
+       ('63463', '3502020016'),
+       ('63463', '3502020017'),
+       ('63463', '3502020018'),
+       ('63463', '3502020019'),
+       ('63463', '3502020020'),
+       ('63463', '3502020021'),
+       ('63463', '3502020022'),
+       ('63462', '3502030002'),
+       ('63462', '3502030003'),
+       ('63462', '3502030004'),
+       ('63462', '3502030005'),
+       ('63462', '3502030007'),
+       ('63462', '3502030008'),
+       ('63462', '3502030009'),
+       ('63462', '3502030010'),
+       ('63462', '3502030011'),
+       ('63462', '3502030012'),
+       ('63462', '3502030013'),
+       ('63462', '3502030016'),
+       ('63462', '3502030017'),
+       ('63462', '3502030018'),
+       ('63462', '3502030019'),
+       ('63474', '3502040001'),
+       ('63474', '3502040002'),
+       ('63474', '3502040003'),
+       ('63474', '3502040004'),
+       ('63474', '3502040005'),
+       ('63474', '3502040006'),
+       ('63474', '3502040007'),
+       ('63474', '3502040008'),
+       ('63474', '3502040009'),
+       ('63474', '3502040011'),
+       ('63474', '3502040012'),
+       ('63474', '3502040013'),
+       ('63474', '3502040014'),
+       ('63474', '3502040015'),
+       ('63474', '3502040016'),
+       ('63475', '3502050002'),
+       ('63475', '3502050003'),
+       ('63475', '3502050004'),
+       ('63475', '3502050005'),
+       ('63475', '3502050006'),
+       ('63475', '3502050007'),
+       ('63475', '3502050008'),
+       ('63475', '3502050009'),
+       ('63475', '3502050010'),
+       ('63475', '3502050011'),
+       ('63475', '3502050012'),
+       ('63475', '3502050013'),
+       ('63475', '3502050014'),
+       ('63482', '3502060001'),
+       ('63482', '3502060004'),
+       ('63482', '3502060005'),
+       ('63418', '3502061001'),
+       ('63418', '3502061002'),
+       ('63418', '3502061003'),
+       ('63418', '3502061004'),
+       ('63418', '3502061005'),
+       ('63418', '3502061006'),
+       ('63481', '3502070001'),
+       ('63481', '3502070002'),
+       ('63481', '3502070004'),
+       ('63481', '3502070005'),
+       ('63481', '3502070006'),
+       ('63481', '3502070008'),
+       ('63481', '3502070009'),
+       ('63481', '3502070010'),
+       ('63481', '3502070011'),
+       ('63481', '3502070012'),
+       ('63481', '3502070013'),
+       ('63481', '3502070014'),
+       ('63481', '3502070015'),
+       ('63481', '3502070017'),
+       ('63481', '3502070018'),
+       ('63472', '3502080002'),
+       ('63472', '3502080003'),
+       ('63472', '3502080005'),
+       ('63472', '3502080006'),
+       ('63472', '3502080007'),
+       ('63472', '3502080008'),
+       ('63472', '3502080009'),
+       ('63472', '3502080010'),
+       ('63472', '3502080011'),
+       ('63472', '3502080012'),
+       ('63472', '3502080013'),
+       ('63472', '3502080015'),
+       ('63471', '3502090001'),
+       ('63471', '3502090002'),
+       ('63471', '3502090003'),
+       ('63471', '3502090004'),
+       ('63471', '3502090005'),
+       ('63471', '3502090006'),
+       ('63471', '3502090008'),
+       ('63471', '3502090009'),
+       ('63471', '3502090010'),
+       ('63471', '3502090011'),
+       ('63471', '3502090012'),
+       ('63471', '3502090013'),
+       ('63471', '3502090014'),
+       ('63471', '3502090015'),
+       ('63471', '3502090016'),
+       ('63471', '3502090017'),
+       ('63471', '3502090018'),
+       ('63473', '3502100001'),
+       ('63473', '3502100002'),
+       ('63473', '3502100003'),
+       ('63473', '3502100004'),
+       ('63473', '3502100005'),
+       ('63473', '3502100006'),
+       ('63473', '3502100007'),
+       ('63473', '3502100008'),
+       ('63473', '3502100009'),
+       ('63473', '3502100010'),
+       ('63473', '3502100011'),
+       ('63473', '3502100012'),
+       ('63473', '3502100013'),
+       ('63473', '3502100014'),
+       ('63461', '3502110001'),
+       ('63461', '3502110002'),
+       ('63461', '3502110003'),
+       ('63461', '3502110005'),
+       ('63461', '3502110006'),
+       ('63461', '3502110008'),
+       ('63461', '3502110009'),
+       ('63461', '3502110010'),
+       ('63461', '3502110011'),
+       ('63461', '3502110012'),
+       ('63461', '3502110013'),
+       ('63461', '3502110014'),
+       ('63461', '3502110015'),
+       ('63461', '3502110016'),
+       ('63461', '3502110017'),
+       ('63461', '3502110018'),
+       ('63461', '3502110020'),
+       ('63451', '3502120001'),
+       ('63451', '3502120002'),
+       ('63451', '3502120003'),
+       ('63451', '3502120004'),
+       ('63451', '3502120005'),
+       ('63451', '3502120006'),
+       ('63451', '3502120007'),
+       ('63451', '3502120008'),
+       ('63451', '3502120009'),
+       ('63451', '3502120010'),
+       ('63451', '3502120011'),
+       ('63451', '3502120012'),
+       ('63451', '3502120013'),
+       ('63451', '3502120014'),
+       ('63451', '3502120015'),
+       ('63451', '3502120016'),
+       ('63456', '3502130002'),
+       ('63456', '3502130003'),
+       ('63456', '3502130004'),
+       ('63456', '3502130005'),
+       ('63456', '3502130006'),
+       ('63456', '3502130007'),
+       ('63456', '3502130008'),
+       ('63456', '3502130010'),
+       ('63456', '3502130011'),
+       ('63456', '3502130012'),
+       ('63456', '3502130013'),
+       ('63455', '3502140001'),
+       ('63455', '3502140002'),
+       ('63455', '3502140003'),
+       ('63455', '3502140004'),
+       ('63455', '3502140005'),
+       ('63455', '3502140006'),
+       ('63455', '3502140007'),
+       ('63455', '3502140008'),
+       ('63455', '3502140009'),
+       ('63455', '3502140010'),
+       ('63454', '3502150001'),
+       ('63454', '3502150002'),
+       ('63454', '3502150003'),
+       ('63454', '3502150004'),
+       ('63454', '3502150006'),
+       ('63454', '3502150007'),
+       ('63454', '3502150008'),
+       ('63454', '3502150009'),
+       ('63454', '3502150011'),
+       ('63454', '3502150012'),
+       ('63453', '3502160001'),
+       ('63453', '3502160002'),
+       ('63453', '3502160003'),
+       ('63453', '3502160004'),
+       ('63453', '3502160005'),
+       ('63453', '3502160006'),
+       ('63453', '3502160007'),
+       ('63453', '3502160008'),
+       ('63453', '3502160009'),
+       ('63453', '3502160010'),
+       ('63453', '3502160011'),
+       ('63453', '3502160012'),
+       ('63453', '3502160013'),
+       ('63453', '3502160014'),
+       ('63453', '3502160015'),
+       ('63453', '3502160016'),
+       ('63453', '3502160017'),
+       ('63453', '3502160018'),
+       ('63414', '3502170001'),
+       ('63419', '3502170002'),
+       ('63416', '3502170003'),
+       ('63416', '3502170004'),
+       ('63419', '3502170005'),
+       ('63411', '3502170006'),
+       ('63418', '3502170007'),
+       ('63419', '3502170008'),
+       ('63412', '3502170009'),
+       ('63411', '3502170010'),
+       ('63419', '3502170011'),
+       ('63411', '3502170012'),
+       ('63413', '3502170013'),
+       ('63413', '3502170014'),
+       ('63411', '3502170015'),
+       ('63411', '3502170016'),
+       ('63412', '3502170017'),
+       ('63412', '3502170018'),
+       ('63415', '3502170019'),
+       ('63491', '3502180001'),
+       ('63491', '3502180002'),
+       ('63491', '3502180003'),
+       ('63491', '3502180004'),
+       ('63491', '3502180005'),
+       ('63491', '3502180006'),
+       ('63491', '3502180007'),
+       ('63491', '3502180008'),
+       ('63491', '3502180010'),
+       ('63491', '3502180011'),
+       ('63491', '3502180012'),
+       ('63491', '3502180013'),
+       ('63491', '3502180014'),
+       ('63491', '3502180015'),
+       ('63492', '3502190001'),
+       ('63492', '3502190002'),
+       ('63492', '3502190003'),
+       ('63492', '3502190004'),
+       ('63492', '3502190005'),
+       ('63492', '3502190006'),
+       ('63492', '3502190007'),
+       ('63492', '3502190008'),
+       ('63492', '3502190009'),
+       ('63492', '3502190010'),
+       ('63492', '3502190011'),
+       ('63492', '3502190012'),
+       ('63492', '3502190013'),
+       ('63492', '3502190014'),
+       ('63492', '3502190015'),
+       ('63492', '3502190016'),
+       ('63492', '3502190017'),
+       ('63493', '3502200001'),
+       ('63493', '3502200002'),
+       ('63493', '3502200003'),
+       ('63493', '3502200004'),
+       ('63493', '3502200005');
@@ -130,3 +130,76 @@ FROM villages v
          INNER JOIN regencies r on d.regency_id = r.id
          INNER JOIN provinces p on r.province_id = p.id
 WHERE d.name ILIKE '%uNg%';
+
+-- Get villages by name, ignoring case, spaces, punctuation and legacy spellings (Baosan Kidoel)
+SELECT v.id, v.name
+FROM villages v
+WHERE regexp_replace(replace(replace(replace(lower(v.name), 'oe', 'u'), 'dj', 'j'), 'tj', 'c'), '[^[:alnum:]]', '', 'g')
+          LIKE '%' || 'baosankidul' || '%';
+
+-- Get the urban villages
+SELECT v.id, v.name, v.type
+FROM villages v
+WHERE v.type = 'kelurahan';
+
+-- Get the villages having a centroid, to be ranked by distance
+SELECT v.id, v.name, v.latitude, v.longitude
+FROM villages v
+WHERE v.latitude IS NOT NULL
+  AND v.longitude IS NOT NULL
+ORDER BY v.id;
+
+-- Get the boundaries of the villages of a district, as GeoJSON text
+SELECT v.id, v.name, v.boundary::text
+FROM villages v
+WHERE v.district_id = '3502010'
+ORDER BY v.id;
+
+-- Get the known boundaries of every village, to be indexed for locating points
+SELECT v.id, v.name, v.boundary::text
+FROM villages v
+WHERE v.boundary IS NOT NULL
+ORDER BY v.id;
+
+-- Get the villages served by a postal code, along with every code of each of them
+SELECT v.id, v.name, (SELECT array_agg(pc.code ORDER BY pc.code) FROM postal_codes pc WHERE pc.village_id = v.id) AS postal_codes
+FROM villages v
+WHERE v.id IN (SELECT pc.village_id FROM postal_codes pc WHERE pc.code = '63472')
+ORDER BY v.id;
+
+-- Paginated lists --
+
+-- Count villages, for the total of a paginated list
+SELECT COUNT(*)
+FROM villages v;
+
+-- Get the second page of villages, 20 per page
+SELECT v.id, v.name
+FROM villages v
+ORDER BY v.id
+LIMIT 20 OFFSET 20;
+
+-- Get the villages following a given village
+SELECT v.id, v.name
+FROM villages v
+WHERE v.id > '3502010001'
+ORDER BY v.id
+LIMIT 20;
+
+-- Trees --
+
+-- Get a regency with its province, districts and villages, one row per village
+SELECT p.id,
+       p.name,
+       r.id,
+       r.name,
+       COALESCE(d.id, ''),
+       COALESCE(d.name, ''),
+       COALESCE(v.id, ''),
+       COALESCE(v.name, '')
+FROM regencies r
+         INNER JOIN provinces p on r.province_id = p.id
+         LEFT JOIN districts d on d.regency_id = r.id
+         LEFT JOIN villages v on v.district_id = d.id
+WHERE r.id = '3502'
+ORDER BY d.id, v.id;
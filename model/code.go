@@ -0,0 +1,17 @@
+package model
+
+// Code is an administrative code broken down into the codes of the levels it embeds, widest first.
+type Code struct {
+	Code  string `json:"code"`
+	Level string `json:"level"`
+	// Known tells whether the code itself exists, which implies that every part of it does.
+	Known bool       `json:"known"`
+	Parts []CodePart `json:"parts"`
+}
+
+type CodePart struct {
+	Level string `json:"level"`
+	Code  string `json:"code"`
+	Name  string `json:"name,omitempty"`
+	Known bool   `json:"known"`
+}
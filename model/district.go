@@ -1,7 +1,12 @@
 package model
 
 type District struct {
-	ID      string  `json:"id"`
-	Name    string  `json:"name"`
-	Regency Regency `json:"regency"`
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	DisplayName string `json:"display_name"`
+	// Latitude and Longitude locate the centroid of the district, omitted when it isn't known.
+	Latitude  *float64 `json:"latitude,omitempty"`
+	Longitude *float64 `json:"longitude,omitempty"`
+	Regency   Regency  `json:"regency"`
+	Score     float64  `json:"score,omitempty"`
 }
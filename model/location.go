@@ -0,0 +1,10 @@
+package model
+
+// Location is the administrative units containing a point, from the village up to the province. The
+// village is omitted when the point is only known to be inside the district.
+type Location struct {
+	Village  *Village `json:"village,omitempty"`
+	District District `json:"district"`
+	Regency  Regency  `json:"regency"`
+	Province Province `json:"province"`
+}
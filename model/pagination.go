@@ -0,0 +1,9 @@
+package model
+
+type Pagination struct {
+	Page  int    `json:"page,omitempty"`
+	Limit int    `json:"limit"`
+	Total int    `json:"total"`
+	Next  string `json:"next,omitempty"`
+	Prev  string `json:"prev,omitempty"`
+}
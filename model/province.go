@@ -1,6 +1,8 @@
 package model
 
 type Province struct {
-	ID   string `json:"id"`
-	Name string `json:"name"`
+	ID          string  `json:"id"`
+	Name        string  `json:"name"`
+	DisplayName string  `json:"display_name"`
+	Score       float64 `json:"score,omitempty"`
 }
@@ -0,0 +1,17 @@
+package model
+
+type ListQuery struct {
+	Page    int
+	Limit   int
+	After   string
+	Sort    string
+	Filters map[string]string
+	// Expand lists the embedded parents: nil embeds all of them, empty none.
+	Expand []string
+	Match  string
+}
+
+const (
+	MatchContains = "contains"
+	MatchFuzzy    = "fuzzy"
+)
@@ -1,7 +1,9 @@
 package model
 
 type Regency struct {
-	ID       string   `json:"id"`
-	Name     string   `json:"name"`
-	Province Province `json:"province"`
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	DisplayName string   `json:"display_name"`
+	Province    Province `json:"province"`
+	Score       float64  `json:"score,omitempty"`
 }
@@ -1,9 +1,10 @@
 package model
 
 type Response[T any] struct {
-	Status  string `json:"status"`
-	Message string `json:"message"`
-	Data    T      `json:"data"`
+	Status     string      `json:"status"`
+	Message    string      `json:"message"`
+	Data       T           `json:"data"`
+	Pagination *Pagination `json:"pagination,omitempty"`
 }
 
 func NewResponse[T any](status string, message string, data T) *Response[T] {
@@ -13,3 +14,8 @@ func NewResponse[T any](status string, message string, data T) *Response[T] {
 		Data:    data,
 	}
 }
+
+func (r *Response[T]) WithPagination(pagination *Pagination) *Response[T] {
+	r.Pagination = pagination
+	return r
+}
@@ -0,0 +1,13 @@
+package model
+
+// SearchHit is an administrative unit of any level whose name matches a search.
+type SearchHit struct {
+	Level       string `json:"level"`
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	DisplayName string `json:"display_name"`
+	// Path is the name of the unit followed by the names of its ancestors, separated by commas.
+	Path string `json:"path"`
+	// Score is the similarity of the name to the search, from 0 to 1, by which the hits are ranked.
+	Score float64 `json:"score"`
+}
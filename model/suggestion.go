@@ -0,0 +1,12 @@
+package model
+
+// Suggestion is an administrative unit of any level whose name, or one of its words, starts with
+// the typed prefix.
+type Suggestion struct {
+	Level       string `json:"level"`
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	DisplayName string `json:"display_name"`
+	// Path is the name of the unit followed by the names of its ancestors, separated by commas.
+	Path string `json:"path"`
+}
@@ -0,0 +1,29 @@
+package model
+
+type ProvinceTree struct {
+	ID          string        `json:"id"`
+	Name        string        `json:"name"`
+	DisplayName string        `json:"display_name"`
+	Regencies   []RegencyTree `json:"regencies,omitempty"`
+}
+
+type RegencyTree struct {
+	ID          string         `json:"id"`
+	Name        string         `json:"name"`
+	DisplayName string         `json:"display_name"`
+	Province    *Province      `json:"province,omitempty"`
+	Districts   []DistrictTree `json:"districts,omitempty"`
+}
+
+type DistrictTree struct {
+	ID          string        `json:"id"`
+	Name        string        `json:"name"`
+	DisplayName string        `json:"display_name"`
+	Villages    []VillageTree `json:"villages,omitempty"`
+}
+
+type VillageTree struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	DisplayName string `json:"display_name"`
+}
@@ -1,7 +1,25 @@
 package model
 
 type Village struct {
-	ID       string   `json:"id"`
-	Name     string   `json:"name"`
-	District District `json:"district"`
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	DisplayName string `json:"display_name"`
+	// Type is desa for a rural village, or kelurahan for an urban one.
+	Type string `json:"type"`
+	// Latitude and Longitude locate the centroid of the village, omitted when it isn't known.
+	Latitude  *float64 `json:"latitude,omitempty"`
+	Longitude *float64 `json:"longitude,omitempty"`
+	// PostalCodes are the kode pos serving the village, in ascending order, omitted when none is known.
+	PostalCodes []string `json:"postal_codes,omitempty"`
+	District    District `json:"district"`
+	Score       float64  `json:"score,omitempty"`
+	// Distance is how far the centroid is from the point of a nearest search, in meters.
+	Distance *float64 `json:"distance,omitempty"`
 }
+
+const (
+	// VillageTypeDesa is the type of the rural villages, led by an elected kepala desa.
+	VillageTypeDesa = "desa"
+	// VillageTypeKelurahan is the type of the urban villages, led by a lurah appointed by the regency.
+	VillageTypeKelurahan = "kelurahan"
+)
@@ -0,0 +1,37 @@
+// Package normalize folds the different ways a place name can be written into a single one, so that
+// the names typed by users match the official ones.
+package normalize
+
+import (
+	"strings"
+	"unicode"
+)
+
+// legacySpellings are the digraphs of the Van Ophuijsen spelling, still found in old documents and
+// signs, along with the letters replacing them since. They are replaced one after the other, the
+// way the SQL counterpart of Name does.
+var legacySpellings = [][2]string{
+	{"oe", "u"},
+	{"dj", "j"},
+	{"tj", "c"},
+}
+
+// Fold lowercases s and replaces its legacy spellings, keeping its spaces and punctuation.
+func Fold(s string) string {
+	s = strings.ToLower(s)
+	for _, spelling := range legacySpellings {
+		s = strings.ReplaceAll(s, spelling[0], spelling[1])
+	}
+	return s
+}
+
+// Name folds s and drops everything but its letters and digits, so that "Baosan Kidul" and
+// "BAOSANKIDUL", or "Soekoredjo" and "SUKOREJO", are normalized to the same name.
+func Name(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return -1
+	}, Fold(s))
+}
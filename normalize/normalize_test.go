@@ -0,0 +1,40 @@
+package normalize
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalize(t *testing.T) {
+	t.Run("TestFold", func(t *testing.T) {
+		t.Run("it should lowercase and replace legacy spellings while keeping spaces, when given a name", func(t *testing.T) {
+			assert.Equal(t, "baosan kidul", Fold("BAOSAN KIDUL"))
+			assert.Equal(t, "sukorejo", Fold("Soekoredjo"))
+			assert.Equal(t, "cermin", Fold("Tjermin"))
+		})
+	})
+
+	t.Run("TestName", func(t *testing.T) {
+		testCases := []struct {
+			name     string
+			given    string
+			expected string
+		}{
+			{name: "it should ignore case, when given official name", given: "BAOSANKIDUL", expected: "baosankidul"},
+			{name: "it should ignore spaces, when given split words", given: "Baosan Kidul", expected: "baosankidul"},
+			{name: "it should ignore punctuation, when given abbreviation", given: "KAB. PONOROGO", expected: "kabponorogo"},
+			{name: "it should replace oe with u, when given legacy spelling", given: "Poeloeng", expected: "pulung"},
+			{name: "it should replace dj with j, when given legacy spelling", given: "Djenangan", expected: "jenangan"},
+			{name: "it should replace tj with c, when given legacy spelling", given: "Tjampoerdarat", expected: "campurdarat"},
+			{name: "it should replace the digraphs in order, when a replacement forms another one", given: "tdj", expected: "c"},
+			{name: "it should return empty name, when given only spaces", given: "  ", expected: ""},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				assert.Equal(t, testCase.expected, Name(testCase.given))
+			})
+		}
+	})
+}
@@ -0,0 +1,168 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.12.4
+// source: district_message.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type District struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name    string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Regency *Regency `protobuf:"bytes,3,opt,name=regency,proto3" json:"regency,omitempty"`
+}
+
+func (x *District) Reset() {
+	*x = District{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_district_message_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *District) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*District) ProtoMessage() {}
+
+func (x *District) ProtoReflect() protoreflect.Message {
+	mi := &file_district_message_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use District.ProtoReflect.Descriptor instead.
+func (*District) Descriptor() ([]byte, []int) {
+	return file_district_message_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *District) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *District) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *District) GetRegency() *Regency {
+	if x != nil {
+		return x.Regency
+	}
+	return nil
+}
+
+var File_district_message_proto protoreflect.FileDescriptor
+
+var file_district_message_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1b, 0x65, 0x72, 0x69, 0x6b, 0x72, 0x69,
+	0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x6e, 0x6f, 0x72, 0x6f, 0x67, 0x6f, 0x72, 0x65, 0x67, 0x65, 0x6e,
+	0x63, 0x79, 0x61, 0x70, 0x69, 0x1a, 0x15, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x6e, 0x0a, 0x08,
+	0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3e, 0x0a, 0x07,
+	0x72, 0x65, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x65, 0x72, 0x69, 0x6b, 0x72, 0x69, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x6e, 0x6f, 0x72, 0x6f, 0x67,
+	0x6f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x67, 0x65,
+	0x6e, 0x63, 0x79, 0x52, 0x07, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x06, 0x5a, 0x04,
+	0x2e, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_district_message_proto_rawDescOnce sync.Once
+	file_district_message_proto_rawDescData = file_district_message_proto_rawDesc
+)
+
+func file_district_message_proto_rawDescGZIP() []byte {
+	file_district_message_proto_rawDescOnce.Do(func() {
+		file_district_message_proto_rawDescData = protoimpl.X.CompressGZIP(file_district_message_proto_rawDescData)
+	})
+	return file_district_message_proto_rawDescData
+}
+
+var file_district_message_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_district_message_proto_goTypes = []interface{}{
+	(*District)(nil), // 0: erikrios.ponorogoregencyapi.District
+	(*Regency)(nil),  // 1: erikrios.ponorogoregencyapi.Regency
+}
+var file_district_message_proto_depIdxs = []int32{
+	1, // 0: erikrios.ponorogoregencyapi.District.regency:type_name -> erikrios.ponorogoregencyapi.Regency
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_district_message_proto_init() }
+func file_district_message_proto_init() {
+	if File_district_message_proto != nil {
+		return
+	}
+	file_regency_message_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_district_message_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*District); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_district_message_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_district_message_proto_goTypes,
+		DependencyIndexes: file_district_message_proto_depIdxs,
+		MessageInfos:      file_district_message_proto_msgTypes,
+	}.Build()
+	File_district_message_proto = out.File
+	file_district_message_proto_rawDesc = nil
+	file_district_message_proto_goTypes = nil
+	file_district_message_proto_depIdxs = nil
+}
@@ -0,0 +1,673 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.12.4
+// source: district_service.proto
+
+package pb
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetDistrictsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter *Filter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *GetDistrictsRequest) Reset() {
+	*x = GetDistrictsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_district_service_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDistrictsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDistrictsRequest) ProtoMessage() {}
+
+func (x *GetDistrictsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_district_service_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDistrictsRequest.ProtoReflect.Descriptor instead.
+func (*GetDistrictsRequest) Descriptor() ([]byte, []int) {
+	return file_district_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetDistrictsRequest) GetFilter() *Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type GetDistrictsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Districts []*District `protobuf:"bytes,1,rep,name=districts,proto3" json:"districts,omitempty"`
+}
+
+func (x *GetDistrictsResponse) Reset() {
+	*x = GetDistrictsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_district_service_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDistrictsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDistrictsResponse) ProtoMessage() {}
+
+func (x *GetDistrictsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_district_service_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDistrictsResponse.ProtoReflect.Descriptor instead.
+func (*GetDistrictsResponse) Descriptor() ([]byte, []int) {
+	return file_district_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetDistrictsResponse) GetDistricts() []*District {
+	if x != nil {
+		return x.Districts
+	}
+	return nil
+}
+
+type GetDistrictRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetDistrictRequest) Reset() {
+	*x = GetDistrictRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_district_service_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDistrictRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDistrictRequest) ProtoMessage() {}
+
+func (x *GetDistrictRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_district_service_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDistrictRequest.ProtoReflect.Descriptor instead.
+func (*GetDistrictRequest) Descriptor() ([]byte, []int) {
+	return file_district_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetDistrictRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetDistrictResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	District *District `protobuf:"bytes,1,opt,name=district,proto3" json:"district,omitempty"`
+}
+
+func (x *GetDistrictResponse) Reset() {
+	*x = GetDistrictResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_district_service_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDistrictResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDistrictResponse) ProtoMessage() {}
+
+func (x *GetDistrictResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_district_service_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDistrictResponse.ProtoReflect.Descriptor instead.
+func (*GetDistrictResponse) Descriptor() ([]byte, []int) {
+	return file_district_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetDistrictResponse) GetDistrict() *District {
+	if x != nil {
+		return x.District
+	}
+	return nil
+}
+
+type GetVillagesByDistrictIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetVillagesByDistrictIDRequest) Reset() {
+	*x = GetVillagesByDistrictIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_district_service_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVillagesByDistrictIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVillagesByDistrictIDRequest) ProtoMessage() {}
+
+func (x *GetVillagesByDistrictIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_district_service_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVillagesByDistrictIDRequest.ProtoReflect.Descriptor instead.
+func (*GetVillagesByDistrictIDRequest) Descriptor() ([]byte, []int) {
+	return file_district_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetVillagesByDistrictIDRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetVillagesByDistrictIDResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Villages []*Village `protobuf:"bytes,1,rep,name=villages,proto3" json:"villages,omitempty"`
+}
+
+func (x *GetVillagesByDistrictIDResponse) Reset() {
+	*x = GetVillagesByDistrictIDResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_district_service_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVillagesByDistrictIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVillagesByDistrictIDResponse) ProtoMessage() {}
+
+func (x *GetVillagesByDistrictIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_district_service_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVillagesByDistrictIDResponse.ProtoReflect.Descriptor instead.
+func (*GetVillagesByDistrictIDResponse) Descriptor() ([]byte, []int) {
+	return file_district_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetVillagesByDistrictIDResponse) GetVillages() []*Village {
+	if x != nil {
+		return x.Villages
+	}
+	return nil
+}
+
+type GetVillagesByDistrictNameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter *Filter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *GetVillagesByDistrictNameRequest) Reset() {
+	*x = GetVillagesByDistrictNameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_district_service_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVillagesByDistrictNameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVillagesByDistrictNameRequest) ProtoMessage() {}
+
+func (x *GetVillagesByDistrictNameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_district_service_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVillagesByDistrictNameRequest.ProtoReflect.Descriptor instead.
+func (*GetVillagesByDistrictNameRequest) Descriptor() ([]byte, []int) {
+	return file_district_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetVillagesByDistrictNameRequest) GetFilter() *Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type GetVillagesByDistrictNameResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Villages []*Village `protobuf:"bytes,1,rep,name=villages,proto3" json:"villages,omitempty"`
+}
+
+func (x *GetVillagesByDistrictNameResponse) Reset() {
+	*x = GetVillagesByDistrictNameResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_district_service_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVillagesByDistrictNameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVillagesByDistrictNameResponse) ProtoMessage() {}
+
+func (x *GetVillagesByDistrictNameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_district_service_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVillagesByDistrictNameResponse.ProtoReflect.Descriptor instead.
+func (*GetVillagesByDistrictNameResponse) Descriptor() ([]byte, []int) {
+	return file_district_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetVillagesByDistrictNameResponse) GetVillages() []*Village {
+	if x != nil {
+		return x.Villages
+	}
+	return nil
+}
+
+var File_district_service_proto protoreflect.FileDescriptor
+
+var file_district_service_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1b, 0x65, 0x72, 0x69, 0x6b, 0x72, 0x69,
+	0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x6e, 0x6f, 0x72, 0x6f, 0x67, 0x6f, 0x72, 0x65, 0x67, 0x65, 0x6e,
+	0x63, 0x79, 0x61, 0x70, 0x69, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x5f, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x76, 0x69, 0x6c,
+	0x6c, 0x61, 0x67, 0x65, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x14, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x52, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x44,
+	0x69, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x3b, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x65, 0x72, 0x69, 0x6b, 0x72, 0x69, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x6e, 0x6f, 0x72,
+	0x6f, 0x67, 0x6f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x5b, 0x0a, 0x14,
+	0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x65, 0x72, 0x69, 0x6b, 0x72, 0x69,
+	0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x6e, 0x6f, 0x72, 0x6f, 0x67, 0x6f, 0x72, 0x65, 0x67, 0x65, 0x6e,
+	0x63, 0x79, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x52, 0x09,
+	0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x73, 0x22, 0x24, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x58, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69,
+	0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x65, 0x72, 0x69, 0x6b, 0x72,
+	0x69, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x6e, 0x6f, 0x72, 0x6f, 0x67, 0x6f, 0x72, 0x65, 0x67, 0x65,
+	0x6e, 0x63, 0x79, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x52,
+	0x08, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x22, 0x30, 0x0a, 0x1e, 0x47, 0x65, 0x74,
+	0x56, 0x69, 0x6c, 0x6c, 0x61, 0x67, 0x65, 0x73, 0x42, 0x79, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69,
+	0x63, 0x74, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x63, 0x0a, 0x1f, 0x47,
+	0x65, 0x74, 0x56, 0x69, 0x6c, 0x6c, 0x61, 0x67, 0x65, 0x73, 0x42, 0x79, 0x44, 0x69, 0x73, 0x74,
+	0x72, 0x69, 0x63, 0x74, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40,
+	0x0a, 0x08, 0x76, 0x69, 0x6c, 0x6c, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x65, 0x72, 0x69, 0x6b, 0x72, 0x69, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x6e, 0x6f,
+	0x72, 0x6f, 0x67, 0x6f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x61, 0x70, 0x69, 0x2e, 0x56,
+	0x69, 0x6c, 0x6c, 0x61, 0x67, 0x65, 0x52, 0x08, 0x76, 0x69, 0x6c, 0x6c, 0x61, 0x67, 0x65, 0x73,
+	0x22, 0x5f, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x56, 0x69, 0x6c, 0x6c, 0x61, 0x67, 0x65, 0x73, 0x42,
+	0x79, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x65, 0x72, 0x69, 0x6b, 0x72, 0x69, 0x6f, 0x73, 0x2e,
+	0x70, 0x6f, 0x6e, 0x6f, 0x72, 0x6f, 0x67, 0x6f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x61,
+	0x70, 0x69, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x22, 0x65, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x56, 0x69, 0x6c, 0x6c, 0x61, 0x67, 0x65, 0x73,
+	0x42, 0x79, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x08, 0x76, 0x69, 0x6c, 0x6c, 0x61, 0x67,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x65, 0x72, 0x69, 0x6b, 0x72,
+	0x69, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x6e, 0x6f, 0x72, 0x6f, 0x67, 0x6f, 0x72, 0x65, 0x67, 0x65,
+	0x6e, 0x63, 0x79, 0x61, 0x70, 0x69, 0x2e, 0x56, 0x69, 0x6c, 0x6c, 0x61, 0x67, 0x65, 0x52, 0x08,
+	0x76, 0x69, 0x6c, 0x6c, 0x61, 0x67, 0x65, 0x73, 0x32, 0xb6, 0x05, 0x0a, 0x0f, 0x44, 0x69, 0x73,
+	0x74, 0x72, 0x69, 0x63, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x8e, 0x01, 0x0a,
+	0x0c, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x73, 0x12, 0x30, 0x2e,
+	0x65, 0x72, 0x69, 0x6b, 0x72, 0x69, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x6e, 0x6f, 0x72, 0x6f, 0x67,
+	0x6f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x44,
+	0x69, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x31, 0x2e, 0x65, 0x72, 0x69, 0x6b, 0x72, 0x69, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x6e, 0x6f, 0x72,
+	0x6f, 0x67, 0x6f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65,
+	0x74, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x32, 0x2f, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x73, 0x12, 0x90, 0x01,
+	0x0a, 0x0b, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x12, 0x2f, 0x2e,
+	0x65, 0x72, 0x69, 0x6b, 0x72, 0x69, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x6e, 0x6f, 0x72, 0x6f, 0x67,
+	0x6f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x44,
+	0x69, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30,
+	0x2e, 0x65, 0x72, 0x69, 0x6b, 0x72, 0x69, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x6e, 0x6f, 0x72, 0x6f,
+	0x67, 0x6f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74,
+	0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x32, 0x2f, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d,
+	0x12, 0xbd, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x56, 0x69, 0x6c, 0x6c, 0x61, 0x67, 0x65, 0x73,
+	0x42, 0x79, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x49, 0x44, 0x12, 0x3b, 0x2e, 0x65,
+	0x72, 0x69, 0x6b, 0x72, 0x69, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x6e, 0x6f, 0x72, 0x6f, 0x67, 0x6f,
+	0x72, 0x65, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x69,
+	0x6c, 0x6c, 0x61, 0x67, 0x65, 0x73, 0x42, 0x79, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74,
+	0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3c, 0x2e, 0x65, 0x72, 0x69, 0x6b,
+	0x72, 0x69, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x6e, 0x6f, 0x72, 0x6f, 0x67, 0x6f, 0x72, 0x65, 0x67,
+	0x65, 0x6e, 0x63, 0x79, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x69, 0x6c, 0x6c, 0x61,
+	0x67, 0x65, 0x73, 0x42, 0x79, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x49, 0x44, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12,
+	0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x32, 0x2f, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x63,
+	0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x76, 0x69, 0x6c, 0x6c, 0x61, 0x67, 0x65, 0x73,
+	0x12, 0xbe, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x56, 0x69, 0x6c, 0x6c, 0x61, 0x67, 0x65, 0x73,
+	0x42, 0x79, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3d,
+	0x2e, 0x65, 0x72, 0x69, 0x6b, 0x72, 0x69, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x6e, 0x6f, 0x72, 0x6f,
+	0x67, 0x6f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74,
+	0x56, 0x69, 0x6c, 0x6c, 0x61, 0x67, 0x65, 0x73, 0x42, 0x79, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69,
+	0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3e, 0x2e,
+	0x65, 0x72, 0x69, 0x6b, 0x72, 0x69, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x6e, 0x6f, 0x72, 0x6f, 0x67,
+	0x6f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x56,
+	0x69, 0x6c, 0x6c, 0x61, 0x67, 0x65, 0x73, 0x42, 0x79, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x63,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x32, 0x2f, 0x64,
+	0x69, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x73, 0x2f, 0x76, 0x69, 0x6c, 0x6c, 0x61, 0x67, 0x65,
+	0x73, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_district_service_proto_rawDescOnce sync.Once
+	file_district_service_proto_rawDescData = file_district_service_proto_rawDesc
+)
+
+func file_district_service_proto_rawDescGZIP() []byte {
+	file_district_service_proto_rawDescOnce.Do(func() {
+		file_district_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_district_service_proto_rawDescData)
+	})
+	return file_district_service_proto_rawDescData
+}
+
+var file_district_service_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_district_service_proto_goTypes = []interface{}{
+	(*GetDistrictsRequest)(nil),               // 0: erikrios.ponorogoregencyapi.GetDistrictsRequest
+	(*GetDistrictsResponse)(nil),              // 1: erikrios.ponorogoregencyapi.GetDistrictsResponse
+	(*GetDistrictRequest)(nil),                // 2: erikrios.ponorogoregencyapi.GetDistrictRequest
+	(*GetDistrictResponse)(nil),               // 3: erikrios.ponorogoregencyapi.GetDistrictResponse
+	(*GetVillagesByDistrictIDRequest)(nil),    // 4: erikrios.ponorogoregencyapi.GetVillagesByDistrictIDRequest
+	(*GetVillagesByDistrictIDResponse)(nil),   // 5: erikrios.ponorogoregencyapi.GetVillagesByDistrictIDResponse
+	(*GetVillagesByDistrictNameRequest)(nil),  // 6: erikrios.ponorogoregencyapi.GetVillagesByDistrictNameRequest
+	(*GetVillagesByDistrictNameResponse)(nil), // 7: erikrios.ponorogoregencyapi.GetVillagesByDistrictNameResponse
+	(*Filter)(nil),                            // 8: erikrios.ponorogoregencyapi.Filter
+	(*District)(nil),                          // 9: erikrios.ponorogoregencyapi.District
+	(*Village)(nil),                           // 10: erikrios.ponorogoregencyapi.Village
+}
+var file_district_service_proto_depIdxs = []int32{
+	8,  // 0: erikrios.ponorogoregencyapi.GetDistrictsRequest.filter:type_name -> erikrios.ponorogoregencyapi.Filter
+	9,  // 1: erikrios.ponorogoregencyapi.GetDistrictsResponse.districts:type_name -> erikrios.ponorogoregencyapi.District
+	9,  // 2: erikrios.ponorogoregencyapi.GetDistrictResponse.district:type_name -> erikrios.ponorogoregencyapi.District
+	10, // 3: erikrios.ponorogoregencyapi.GetVillagesByDistrictIDResponse.villages:type_name -> erikrios.ponorogoregencyapi.Village
+	8,  // 4: erikrios.ponorogoregencyapi.GetVillagesByDistrictNameRequest.filter:type_name -> erikrios.ponorogoregencyapi.Filter
+	10, // 5: erikrios.ponorogoregencyapi.GetVillagesByDistrictNameResponse.villages:type_name -> erikrios.ponorogoregencyapi.Village
+	0,  // 6: erikrios.ponorogoregencyapi.DistrictService.GetDistricts:input_type -> erikrios.ponorogoregencyapi.GetDistrictsRequest
+	2,  // 7: erikrios.ponorogoregencyapi.DistrictService.GetDistrict:input_type -> erikrios.ponorogoregencyapi.GetDistrictRequest
+	4,  // 8: erikrios.ponorogoregencyapi.DistrictService.GetVillagesByDistrictID:input_type -> erikrios.ponorogoregencyapi.GetVillagesByDistrictIDRequest
+	6,  // 9: erikrios.ponorogoregencyapi.DistrictService.GetVillagesByDistrictName:input_type -> erikrios.ponorogoregencyapi.GetVillagesByDistrictNameRequest
+	1,  // 10: erikrios.ponorogoregencyapi.DistrictService.GetDistricts:output_type -> erikrios.ponorogoregencyapi.GetDistrictsResponse
+	3,  // 11: erikrios.ponorogoregencyapi.DistrictService.GetDistrict:output_type -> erikrios.ponorogoregencyapi.GetDistrictResponse
+	5,  // 12: erikrios.ponorogoregencyapi.DistrictService.GetVillagesByDistrictID:output_type -> erikrios.ponorogoregencyapi.GetVillagesByDistrictIDResponse
+	7,  // 13: erikrios.ponorogoregencyapi.DistrictService.GetVillagesByDistrictName:output_type -> erikrios.ponorogoregencyapi.GetVillagesByDistrictNameResponse
+	10, // [10:14] is the sub-list for method output_type
+	6,  // [6:10] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_district_service_proto_init() }
+func file_district_service_proto_init() {
+	if File_district_service_proto != nil {
+		return
+	}
+	file_district_message_proto_init()
+	file_village_message_proto_init()
+	file_filter_message_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_district_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDistrictsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_district_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDistrictsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_district_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDistrictRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_district_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDistrictResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_district_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVillagesByDistrictIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_district_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVillagesByDistrictIDResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_district_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVillagesByDistrictNameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_district_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVillagesByDistrictNameResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_district_service_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_district_service_proto_goTypes,
+		DependencyIndexes: file_district_service_proto_depIdxs,
+		MessageInfos:      file_district_service_proto_msgTypes,
+	}.Build()
+	File_district_service_proto = out.File
+	file_district_service_proto_rawDesc = nil
+	file_district_service_proto_goTypes = nil
+	file_district_service_proto_depIdxs = nil
+}
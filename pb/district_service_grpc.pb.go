@@ -0,0 +1,213 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.12.4
+// source: district_service.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// DistrictServiceClient is the client API for DistrictService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DistrictServiceClient interface {
+	GetDistricts(ctx context.Context, in *GetDistrictsRequest, opts ...grpc.CallOption) (*GetDistrictsResponse, error)
+	GetDistrict(ctx context.Context, in *GetDistrictRequest, opts ...grpc.CallOption) (*GetDistrictResponse, error)
+	GetVillagesByDistrictID(ctx context.Context, in *GetVillagesByDistrictIDRequest, opts ...grpc.CallOption) (*GetVillagesByDistrictIDResponse, error)
+	GetVillagesByDistrictName(ctx context.Context, in *GetVillagesByDistrictNameRequest, opts ...grpc.CallOption) (*GetVillagesByDistrictNameResponse, error)
+}
+
+type districtServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDistrictServiceClient(cc grpc.ClientConnInterface) DistrictServiceClient {
+	return &districtServiceClient{cc}
+}
+
+func (c *districtServiceClient) GetDistricts(ctx context.Context, in *GetDistrictsRequest, opts ...grpc.CallOption) (*GetDistrictsResponse, error) {
+	out := new(GetDistrictsResponse)
+	err := c.cc.Invoke(ctx, "/erikrios.ponorogoregencyapi.DistrictService/GetDistricts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *districtServiceClient) GetDistrict(ctx context.Context, in *GetDistrictRequest, opts ...grpc.CallOption) (*GetDistrictResponse, error) {
+	out := new(GetDistrictResponse)
+	err := c.cc.Invoke(ctx, "/erikrios.ponorogoregencyapi.DistrictService/GetDistrict", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *districtServiceClient) GetVillagesByDistrictID(ctx context.Context, in *GetVillagesByDistrictIDRequest, opts ...grpc.CallOption) (*GetVillagesByDistrictIDResponse, error) {
+	out := new(GetVillagesByDistrictIDResponse)
+	err := c.cc.Invoke(ctx, "/erikrios.ponorogoregencyapi.DistrictService/GetVillagesByDistrictID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *districtServiceClient) GetVillagesByDistrictName(ctx context.Context, in *GetVillagesByDistrictNameRequest, opts ...grpc.CallOption) (*GetVillagesByDistrictNameResponse, error) {
+	out := new(GetVillagesByDistrictNameResponse)
+	err := c.cc.Invoke(ctx, "/erikrios.ponorogoregencyapi.DistrictService/GetVillagesByDistrictName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DistrictServiceServer is the server API for DistrictService service.
+// All implementations must embed UnimplementedDistrictServiceServer
+// for forward compatibility
+type DistrictServiceServer interface {
+	GetDistricts(context.Context, *GetDistrictsRequest) (*GetDistrictsResponse, error)
+	GetDistrict(context.Context, *GetDistrictRequest) (*GetDistrictResponse, error)
+	GetVillagesByDistrictID(context.Context, *GetVillagesByDistrictIDRequest) (*GetVillagesByDistrictIDResponse, error)
+	GetVillagesByDistrictName(context.Context, *GetVillagesByDistrictNameRequest) (*GetVillagesByDistrictNameResponse, error)
+	mustEmbedUnimplementedDistrictServiceServer()
+}
+
+// UnimplementedDistrictServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedDistrictServiceServer struct {
+}
+
+func (UnimplementedDistrictServiceServer) GetDistricts(context.Context, *GetDistrictsRequest) (*GetDistrictsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDistricts not implemented")
+}
+func (UnimplementedDistrictServiceServer) GetDistrict(context.Context, *GetDistrictRequest) (*GetDistrictResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDistrict not implemented")
+}
+func (UnimplementedDistrictServiceServer) GetVillagesByDistrictID(context.Context, *GetVillagesByDistrictIDRequest) (*GetVillagesByDistrictIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVillagesByDistrictID not implemented")
+}
+func (UnimplementedDistrictServiceServer) GetVillagesByDistrictName(context.Context, *GetVillagesByDistrictNameRequest) (*GetVillagesByDistrictNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVillagesByDistrictName not implemented")
+}
+func (UnimplementedDistrictServiceServer) mustEmbedUnimplementedDistrictServiceServer() {}
+
+// UnsafeDistrictServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DistrictServiceServer will
+// result in compilation errors.
+type UnsafeDistrictServiceServer interface {
+	mustEmbedUnimplementedDistrictServiceServer()
+}
+
+func RegisterDistrictServiceServer(s grpc.ServiceRegistrar, srv DistrictServiceServer) {
+	s.RegisterService(&DistrictService_ServiceDesc, srv)
+}
+
+func _DistrictService_GetDistricts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDistrictsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DistrictServiceServer).GetDistricts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/erikrios.ponorogoregencyapi.DistrictService/GetDistricts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DistrictServiceServer).GetDistricts(ctx, req.(*GetDistrictsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DistrictService_GetDistrict_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDistrictRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DistrictServiceServer).GetDistrict(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/erikrios.ponorogoregencyapi.DistrictService/GetDistrict",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DistrictServiceServer).GetDistrict(ctx, req.(*GetDistrictRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DistrictService_GetVillagesByDistrictID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVillagesByDistrictIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DistrictServiceServer).GetVillagesByDistrictID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/erikrios.ponorogoregencyapi.DistrictService/GetVillagesByDistrictID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DistrictServiceServer).GetVillagesByDistrictID(ctx, req.(*GetVillagesByDistrictIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DistrictService_GetVillagesByDistrictName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVillagesByDistrictNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DistrictServiceServer).GetVillagesByDistrictName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/erikrios.ponorogoregencyapi.DistrictService/GetVillagesByDistrictName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DistrictServiceServer).GetVillagesByDistrictName(ctx, req.(*GetVillagesByDistrictNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DistrictService_ServiceDesc is the grpc.ServiceDesc for DistrictService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DistrictService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "erikrios.ponorogoregencyapi.DistrictService",
+	HandlerType: (*DistrictServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetDistricts",
+			Handler:    _DistrictService_GetDistricts_Handler,
+		},
+		{
+			MethodName: "GetDistrict",
+			Handler:    _DistrictService_GetDistrict_Handler,
+		},
+		{
+			MethodName: "GetVillagesByDistrictID",
+			Handler:    _DistrictService_GetVillagesByDistrictID_Handler,
+		},
+		{
+			MethodName: "GetVillagesByDistrictName",
+			Handler:    _DistrictService_GetVillagesByDistrictName_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "district_service.proto",
+}
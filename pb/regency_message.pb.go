@@ -0,0 +1,168 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.12.4
+// source: regency_message.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Regency struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name     string    `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Province *Province `protobuf:"bytes,3,opt,name=province,proto3" json:"province,omitempty"`
+}
+
+func (x *Regency) Reset() {
+	*x = Regency{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regency_message_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Regency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Regency) ProtoMessage() {}
+
+func (x *Regency) ProtoReflect() protoreflect.Message {
+	mi := &file_regency_message_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Regency.ProtoReflect.Descriptor instead.
+func (*Regency) Descriptor() ([]byte, []int) {
+	return file_regency_message_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Regency) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Regency) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Regency) GetProvince() *Province {
+	if x != nil {
+		return x.Province
+	}
+	return nil
+}
+
+var File_regency_message_proto protoreflect.FileDescriptor
+
+var file_regency_message_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1b, 0x65, 0x72, 0x69, 0x6b, 0x72, 0x69, 0x6f,
+	0x73, 0x2e, 0x70, 0x6f, 0x6e, 0x6f, 0x72, 0x6f, 0x67, 0x6f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x63,
+	0x79, 0x61, 0x70, 0x69, 0x1a, 0x16, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x70, 0x0a, 0x07,
+	0x52, 0x65, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x41, 0x0a, 0x08, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x65, 0x72, 0x69, 0x6b, 0x72, 0x69, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x6e, 0x6f, 0x72, 0x6f, 0x67,
+	0x6f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x63, 0x65, 0x42, 0x06,
+	0x5a, 0x04, 0x2e, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_regency_message_proto_rawDescOnce sync.Once
+	file_regency_message_proto_rawDescData = file_regency_message_proto_rawDesc
+)
+
+func file_regency_message_proto_rawDescGZIP() []byte {
+	file_regency_message_proto_rawDescOnce.Do(func() {
+		file_regency_message_proto_rawDescData = protoimpl.X.CompressGZIP(file_regency_message_proto_rawDescData)
+	})
+	return file_regency_message_proto_rawDescData
+}
+
+var file_regency_message_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_regency_message_proto_goTypes = []interface{}{
+	(*Regency)(nil),  // 0: erikrios.ponorogoregencyapi.Regency
+	(*Province)(nil), // 1: erikrios.ponorogoregencyapi.Province
+}
+var file_regency_message_proto_depIdxs = []int32{
+	1, // 0: erikrios.ponorogoregencyapi.Regency.province:type_name -> erikrios.ponorogoregencyapi.Province
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_regency_message_proto_init() }
+func file_regency_message_proto_init() {
+	if File_regency_message_proto != nil {
+		return
+	}
+	file_province_message_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_regency_message_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Regency); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_regency_message_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_regency_message_proto_goTypes,
+		DependencyIndexes: file_regency_message_proto_depIdxs,
+		MessageInfos:      file_regency_message_proto_msgTypes,
+	}.Build()
+	File_regency_message_proto = out.File
+	file_regency_message_proto_rawDesc = nil
+	file_regency_message_proto_goTypes = nil
+	file_regency_message_proto_depIdxs = nil
+}
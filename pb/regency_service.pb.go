@@ -0,0 +1,375 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.12.4
+// source: regency_service.proto
+
+package pb
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetRegenciesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter *Filter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *GetRegenciesRequest) Reset() {
+	*x = GetRegenciesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regency_service_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRegenciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRegenciesRequest) ProtoMessage() {}
+
+func (x *GetRegenciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_regency_service_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRegenciesRequest.ProtoReflect.Descriptor instead.
+func (*GetRegenciesRequest) Descriptor() ([]byte, []int) {
+	return file_regency_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetRegenciesRequest) GetFilter() *Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type GetRegenciesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Regencies []*Regency `protobuf:"bytes,1,rep,name=regencies,proto3" json:"regencies,omitempty"`
+}
+
+func (x *GetRegenciesResponse) Reset() {
+	*x = GetRegenciesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regency_service_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRegenciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRegenciesResponse) ProtoMessage() {}
+
+func (x *GetRegenciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_regency_service_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRegenciesResponse.ProtoReflect.Descriptor instead.
+func (*GetRegenciesResponse) Descriptor() ([]byte, []int) {
+	return file_regency_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetRegenciesResponse) GetRegencies() []*Regency {
+	if x != nil {
+		return x.Regencies
+	}
+	return nil
+}
+
+type GetRegencyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetRegencyRequest) Reset() {
+	*x = GetRegencyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regency_service_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRegencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRegencyRequest) ProtoMessage() {}
+
+func (x *GetRegencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_regency_service_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRegencyRequest.ProtoReflect.Descriptor instead.
+func (*GetRegencyRequest) Descriptor() ([]byte, []int) {
+	return file_regency_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetRegencyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetRegencyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Regency *Regency `protobuf:"bytes,1,opt,name=regency,proto3" json:"regency,omitempty"`
+}
+
+func (x *GetRegencyResponse) Reset() {
+	*x = GetRegencyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regency_service_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRegencyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRegencyResponse) ProtoMessage() {}
+
+func (x *GetRegencyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_regency_service_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRegencyResponse.ProtoReflect.Descriptor instead.
+func (*GetRegencyResponse) Descriptor() ([]byte, []int) {
+	return file_regency_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetRegencyResponse) GetRegency() *Regency {
+	if x != nil {
+		return x.Regency
+	}
+	return nil
+}
+
+var File_regency_service_proto protoreflect.FileDescriptor
+
+var file_regency_service_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1b, 0x65, 0x72, 0x69, 0x6b, 0x72, 0x69, 0x6f,
+	0x73, 0x2e, 0x70, 0x6f, 0x6e, 0x6f, 0x72, 0x6f, 0x67, 0x6f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x63,
+	0x79, 0x61, 0x70, 0x69, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x15, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x52, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x65, 0x72, 0x69, 0x6b, 0x72, 0x69, 0x6f,
+	0x73, 0x2e, 0x70, 0x6f, 0x6e, 0x6f, 0x72, 0x6f, 0x67, 0x6f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x63,
+	0x79, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x22, 0x5a, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x63,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x09, 0x72,
+	0x65, 0x67, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x65, 0x72, 0x69, 0x6b, 0x72, 0x69, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x6e, 0x6f, 0x72, 0x6f,
+	0x67, 0x6f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x67,
+	0x65, 0x6e, 0x63, 0x79, 0x52, 0x09, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x22,
+	0x23, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x54, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x65, 0x67, 0x65, 0x6e,
+	0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x07, 0x72, 0x65,
+	0x67, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x65, 0x72,
+	0x69, 0x6b, 0x72, 0x69, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x6e, 0x6f, 0x72, 0x6f, 0x67, 0x6f, 0x72,
+	0x65, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x63,
+	0x79, 0x52, 0x07, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x32, 0xb1, 0x02, 0x0a, 0x0e, 0x52,
+	0x65, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x8e, 0x01,
+	0x0a, 0x0c, 0x47, 0x65, 0x74, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x30,
+	0x2e, 0x65, 0x72, 0x69, 0x6b, 0x72, 0x69, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x6e, 0x6f, 0x72, 0x6f,
+	0x67, 0x6f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x67, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x31, 0x2e, 0x65, 0x72, 0x69, 0x6b, 0x72, 0x69, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x6e, 0x6f,
+	0x72, 0x6f, 0x67, 0x6f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x61, 0x70, 0x69, 0x2e, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x32, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x8d,
+	0x01, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x2e, 0x2e,
+	0x65, 0x72, 0x69, 0x6b, 0x72, 0x69, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x6e, 0x6f, 0x72, 0x6f, 0x67,
+	0x6f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e,
+	0x65, 0x72, 0x69, 0x6b, 0x72, 0x69, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x6e, 0x6f, 0x72, 0x6f, 0x67,
+	0x6f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x32, 0x2f,
+	0x72, 0x65, 0x67, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x42, 0x06,
+	0x5a, 0x04, 0x2e, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_regency_service_proto_rawDescOnce sync.Once
+	file_regency_service_proto_rawDescData = file_regency_service_proto_rawDesc
+)
+
+func file_regency_service_proto_rawDescGZIP() []byte {
+	file_regency_service_proto_rawDescOnce.Do(func() {
+		file_regency_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_regency_service_proto_rawDescData)
+	})
+	return file_regency_service_proto_rawDescData
+}
+
+var file_regency_service_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_regency_service_proto_goTypes = []interface{}{
+	(*GetRegenciesRequest)(nil),  // 0: erikrios.ponorogoregencyapi.GetRegenciesRequest
+	(*GetRegenciesResponse)(nil), // 1: erikrios.ponorogoregencyapi.GetRegenciesResponse
+	(*GetRegencyRequest)(nil),    // 2: erikrios.ponorogoregencyapi.GetRegencyRequest
+	(*GetRegencyResponse)(nil),   // 3: erikrios.ponorogoregencyapi.GetRegencyResponse
+	(*Filter)(nil),               // 4: erikrios.ponorogoregencyapi.Filter
+	(*Regency)(nil),              // 5: erikrios.ponorogoregencyapi.Regency
+}
+var file_regency_service_proto_depIdxs = []int32{
+	4, // 0: erikrios.ponorogoregencyapi.GetRegenciesRequest.filter:type_name -> erikrios.ponorogoregencyapi.Filter
+	5, // 1: erikrios.ponorogoregencyapi.GetRegenciesResponse.regencies:type_name -> erikrios.ponorogoregencyapi.Regency
+	5, // 2: erikrios.ponorogoregencyapi.GetRegencyResponse.regency:type_name -> erikrios.ponorogoregencyapi.Regency
+	0, // 3: erikrios.ponorogoregencyapi.RegencyService.GetRegencies:input_type -> erikrios.ponorogoregencyapi.GetRegenciesRequest
+	2, // 4: erikrios.ponorogoregencyapi.RegencyService.GetRegency:input_type -> erikrios.ponorogoregencyapi.GetRegencyRequest
+	1, // 5: erikrios.ponorogoregencyapi.RegencyService.GetRegencies:output_type -> erikrios.ponorogoregencyapi.GetRegenciesResponse
+	3, // 6: erikrios.ponorogoregencyapi.RegencyService.GetRegency:output_type -> erikrios.ponorogoregencyapi.GetRegencyResponse
+	5, // [5:7] is the sub-list for method output_type
+	3, // [3:5] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_regency_service_proto_init() }
+func file_regency_service_proto_init() {
+	if File_regency_service_proto != nil {
+		return
+	}
+	file_regency_message_proto_init()
+	file_filter_message_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_regency_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRegenciesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regency_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRegenciesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regency_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRegencyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regency_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRegencyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_regency_service_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_regency_service_proto_goTypes,
+		DependencyIndexes: file_regency_service_proto_depIdxs,
+		MessageInfos:      file_regency_service_proto_msgTypes,
+	}.Build()
+	File_regency_service_proto = out.File
+	file_regency_service_proto_rawDesc = nil
+	file_regency_service_proto_goTypes = nil
+	file_regency_service_proto_depIdxs = nil
+}
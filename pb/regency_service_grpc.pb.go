@@ -0,0 +1,141 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.12.4
+// source: regency_service.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// RegencyServiceClient is the client API for RegencyService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RegencyServiceClient interface {
+	GetRegencies(ctx context.Context, in *GetRegenciesRequest, opts ...grpc.CallOption) (*GetRegenciesResponse, error)
+	GetRegency(ctx context.Context, in *GetRegencyRequest, opts ...grpc.CallOption) (*GetRegencyResponse, error)
+}
+
+type regencyServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRegencyServiceClient(cc grpc.ClientConnInterface) RegencyServiceClient {
+	return &regencyServiceClient{cc}
+}
+
+func (c *regencyServiceClient) GetRegencies(ctx context.Context, in *GetRegenciesRequest, opts ...grpc.CallOption) (*GetRegenciesResponse, error) {
+	out := new(GetRegenciesResponse)
+	err := c.cc.Invoke(ctx, "/erikrios.ponorogoregencyapi.RegencyService/GetRegencies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *regencyServiceClient) GetRegency(ctx context.Context, in *GetRegencyRequest, opts ...grpc.CallOption) (*GetRegencyResponse, error) {
+	out := new(GetRegencyResponse)
+	err := c.cc.Invoke(ctx, "/erikrios.ponorogoregencyapi.RegencyService/GetRegency", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RegencyServiceServer is the server API for RegencyService service.
+// All implementations must embed UnimplementedRegencyServiceServer
+// for forward compatibility
+type RegencyServiceServer interface {
+	GetRegencies(context.Context, *GetRegenciesRequest) (*GetRegenciesResponse, error)
+	GetRegency(context.Context, *GetRegencyRequest) (*GetRegencyResponse, error)
+	mustEmbedUnimplementedRegencyServiceServer()
+}
+
+// UnimplementedRegencyServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedRegencyServiceServer struct {
+}
+
+func (UnimplementedRegencyServiceServer) GetRegencies(context.Context, *GetRegenciesRequest) (*GetRegenciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRegencies not implemented")
+}
+func (UnimplementedRegencyServiceServer) GetRegency(context.Context, *GetRegencyRequest) (*GetRegencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRegency not implemented")
+}
+func (UnimplementedRegencyServiceServer) mustEmbedUnimplementedRegencyServiceServer() {}
+
+// UnsafeRegencyServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RegencyServiceServer will
+// result in compilation errors.
+type UnsafeRegencyServiceServer interface {
+	mustEmbedUnimplementedRegencyServiceServer()
+}
+
+func RegisterRegencyServiceServer(s grpc.ServiceRegistrar, srv RegencyServiceServer) {
+	s.RegisterService(&RegencyService_ServiceDesc, srv)
+}
+
+func _RegencyService_GetRegencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRegenciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegencyServiceServer).GetRegencies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/erikrios.ponorogoregencyapi.RegencyService/GetRegencies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegencyServiceServer).GetRegencies(ctx, req.(*GetRegenciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegencyService_GetRegency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRegencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegencyServiceServer).GetRegency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/erikrios.ponorogoregencyapi.RegencyService/GetRegency",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegencyServiceServer).GetRegency(ctx, req.(*GetRegencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RegencyService_ServiceDesc is the grpc.ServiceDesc for RegencyService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RegencyService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "erikrios.ponorogoregencyapi.RegencyService",
+	HandlerType: (*RegencyServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetRegencies",
+			Handler:    _RegencyService_GetRegencies_Handler,
+		},
+		{
+			MethodName: "GetRegency",
+			Handler:    _RegencyService_GetRegency_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "regency_service.proto",
+}
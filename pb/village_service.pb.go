@@ -0,0 +1,460 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.12.4
+// source: village_service.proto
+
+package pb
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetVillagesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter *Filter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *GetVillagesRequest) Reset() {
+	*x = GetVillagesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_village_service_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVillagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVillagesRequest) ProtoMessage() {}
+
+func (x *GetVillagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_village_service_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVillagesRequest.ProtoReflect.Descriptor instead.
+func (*GetVillagesRequest) Descriptor() ([]byte, []int) {
+	return file_village_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetVillagesRequest) GetFilter() *Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type GetVillagesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Villages []*Village `protobuf:"bytes,1,rep,name=villages,proto3" json:"villages,omitempty"`
+}
+
+func (x *GetVillagesResponse) Reset() {
+	*x = GetVillagesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_village_service_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVillagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVillagesResponse) ProtoMessage() {}
+
+func (x *GetVillagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_village_service_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVillagesResponse.ProtoReflect.Descriptor instead.
+func (*GetVillagesResponse) Descriptor() ([]byte, []int) {
+	return file_village_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetVillagesResponse) GetVillages() []*Village {
+	if x != nil {
+		return x.Villages
+	}
+	return nil
+}
+
+type GetVillageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetVillageRequest) Reset() {
+	*x = GetVillageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_village_service_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVillageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVillageRequest) ProtoMessage() {}
+
+func (x *GetVillageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_village_service_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVillageRequest.ProtoReflect.Descriptor instead.
+func (*GetVillageRequest) Descriptor() ([]byte, []int) {
+	return file_village_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetVillageRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetVillageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Village *Village `protobuf:"bytes,1,opt,name=village,proto3" json:"village,omitempty"`
+}
+
+func (x *GetVillageResponse) Reset() {
+	*x = GetVillageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_village_service_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVillageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVillageResponse) ProtoMessage() {}
+
+func (x *GetVillageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_village_service_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVillageResponse.ProtoReflect.Descriptor instead.
+func (*GetVillageResponse) Descriptor() ([]byte, []int) {
+	return file_village_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetVillageResponse) GetVillage() *Village {
+	if x != nil {
+		return x.Village
+	}
+	return nil
+}
+
+type StreamVillagesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DistrictId   string `protobuf:"bytes,1,opt,name=district_id,json=districtId,proto3" json:"district_id,omitempty"`
+	DistrictName string `protobuf:"bytes,2,opt,name=district_name,json=districtName,proto3" json:"district_name,omitempty"`
+}
+
+func (x *StreamVillagesRequest) Reset() {
+	*x = StreamVillagesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_village_service_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamVillagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamVillagesRequest) ProtoMessage() {}
+
+func (x *StreamVillagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_village_service_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamVillagesRequest.ProtoReflect.Descriptor instead.
+func (*StreamVillagesRequest) Descriptor() ([]byte, []int) {
+	return file_village_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StreamVillagesRequest) GetDistrictId() string {
+	if x != nil {
+		return x.DistrictId
+	}
+	return ""
+}
+
+func (x *StreamVillagesRequest) GetDistrictName() string {
+	if x != nil {
+		return x.DistrictName
+	}
+	return ""
+}
+
+var File_village_service_proto protoreflect.FileDescriptor
+
+var file_village_service_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x76, 0x69, 0x6c, 0x6c, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1b, 0x65, 0x72, 0x69, 0x6b, 0x72, 0x69, 0x6f,
+	0x73, 0x2e, 0x70, 0x6f, 0x6e, 0x6f, 0x72, 0x6f, 0x67, 0x6f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x63,
+	0x79, 0x61, 0x70, 0x69, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x15, 0x76, 0x69, 0x6c, 0x6c, 0x61, 0x67, 0x65, 0x5f, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x51, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x56, 0x69, 0x6c, 0x6c, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x65, 0x72, 0x69, 0x6b, 0x72, 0x69, 0x6f, 0x73,
+	0x2e, 0x70, 0x6f, 0x6e, 0x6f, 0x72, 0x6f, 0x67, 0x6f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x63, 0x79,
+	0x61, 0x70, 0x69, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x22, 0x57, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x56, 0x69, 0x6c, 0x6c, 0x61, 0x67, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x08, 0x76, 0x69, 0x6c,
+	0x6c, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x65, 0x72,
+	0x69, 0x6b, 0x72, 0x69, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x6e, 0x6f, 0x72, 0x6f, 0x67, 0x6f, 0x72,
+	0x65, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x61, 0x70, 0x69, 0x2e, 0x56, 0x69, 0x6c, 0x6c, 0x61, 0x67,
+	0x65, 0x52, 0x08, 0x76, 0x69, 0x6c, 0x6c, 0x61, 0x67, 0x65, 0x73, 0x22, 0x23, 0x0a, 0x11, 0x47,
+	0x65, 0x74, 0x56, 0x69, 0x6c, 0x6c, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x54, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x56, 0x69, 0x6c, 0x6c, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x07, 0x76, 0x69, 0x6c, 0x6c, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x65, 0x72, 0x69, 0x6b, 0x72, 0x69,
+	0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x6e, 0x6f, 0x72, 0x6f, 0x67, 0x6f, 0x72, 0x65, 0x67, 0x65, 0x6e,
+	0x63, 0x79, 0x61, 0x70, 0x69, 0x2e, 0x56, 0x69, 0x6c, 0x6c, 0x61, 0x67, 0x65, 0x52, 0x07, 0x76,
+	0x69, 0x6c, 0x6c, 0x61, 0x67, 0x65, 0x22, 0x5d, 0x0a, 0x15, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x56, 0x69, 0x6c, 0x6c, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x49, 0x64,
+	0x12, 0x23, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x63,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x32, 0xbc, 0x03, 0x0a, 0x0e, 0x56, 0x69, 0x6c, 0x6c, 0x61, 0x67,
+	0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x8a, 0x01, 0x0a, 0x0b, 0x47, 0x65, 0x74,
+	0x56, 0x69, 0x6c, 0x6c, 0x61, 0x67, 0x65, 0x73, 0x12, 0x2f, 0x2e, 0x65, 0x72, 0x69, 0x6b, 0x72,
+	0x69, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x6e, 0x6f, 0x72, 0x6f, 0x67, 0x6f, 0x72, 0x65, 0x67, 0x65,
+	0x6e, 0x63, 0x79, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x69, 0x6c, 0x6c, 0x61, 0x67,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x65, 0x72, 0x69, 0x6b,
+	0x72, 0x69, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x6e, 0x6f, 0x72, 0x6f, 0x67, 0x6f, 0x72, 0x65, 0x67,
+	0x65, 0x6e, 0x63, 0x79, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x69, 0x6c, 0x6c, 0x61,
+	0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x32, 0x2f, 0x76, 0x69, 0x6c,
+	0x6c, 0x61, 0x67, 0x65, 0x73, 0x12, 0x8c, 0x01, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x56, 0x69, 0x6c,
+	0x6c, 0x61, 0x67, 0x65, 0x12, 0x2e, 0x2e, 0x65, 0x72, 0x69, 0x6b, 0x72, 0x69, 0x6f, 0x73, 0x2e,
+	0x70, 0x6f, 0x6e, 0x6f, 0x72, 0x6f, 0x67, 0x6f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x61,
+	0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x69, 0x6c, 0x6c, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x65, 0x72, 0x69, 0x6b, 0x72, 0x69, 0x6f, 0x73, 0x2e,
+	0x70, 0x6f, 0x6e, 0x6f, 0x72, 0x6f, 0x67, 0x6f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x61,
+	0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x69, 0x6c, 0x6c, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x32, 0x2f, 0x76, 0x69, 0x6c, 0x6c, 0x61, 0x67, 0x65, 0x73, 0x2f,
+	0x7b, 0x69, 0x64, 0x7d, 0x12, 0x8d, 0x01, 0x0a, 0x0e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x56,
+	0x69, 0x6c, 0x6c, 0x61, 0x67, 0x65, 0x73, 0x12, 0x32, 0x2e, 0x65, 0x72, 0x69, 0x6b, 0x72, 0x69,
+	0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x6e, 0x6f, 0x72, 0x6f, 0x67, 0x6f, 0x72, 0x65, 0x67, 0x65, 0x6e,
+	0x63, 0x79, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x56, 0x69, 0x6c, 0x6c,
+	0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x65, 0x72,
+	0x69, 0x6b, 0x72, 0x69, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x6e, 0x6f, 0x72, 0x6f, 0x67, 0x6f, 0x72,
+	0x65, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x61, 0x70, 0x69, 0x2e, 0x56, 0x69, 0x6c, 0x6c, 0x61, 0x67,
+	0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x32, 0x2f, 0x76, 0x69, 0x6c, 0x6c, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x30, 0x01, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_village_service_proto_rawDescOnce sync.Once
+	file_village_service_proto_rawDescData = file_village_service_proto_rawDesc
+)
+
+func file_village_service_proto_rawDescGZIP() []byte {
+	file_village_service_proto_rawDescOnce.Do(func() {
+		file_village_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_village_service_proto_rawDescData)
+	})
+	return file_village_service_proto_rawDescData
+}
+
+var file_village_service_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_village_service_proto_goTypes = []interface{}{
+	(*GetVillagesRequest)(nil),    // 0: erikrios.ponorogoregencyapi.GetVillagesRequest
+	(*GetVillagesResponse)(nil),   // 1: erikrios.ponorogoregencyapi.GetVillagesResponse
+	(*GetVillageRequest)(nil),     // 2: erikrios.ponorogoregencyapi.GetVillageRequest
+	(*GetVillageResponse)(nil),    // 3: erikrios.ponorogoregencyapi.GetVillageResponse
+	(*StreamVillagesRequest)(nil), // 4: erikrios.ponorogoregencyapi.StreamVillagesRequest
+	(*Filter)(nil),                // 5: erikrios.ponorogoregencyapi.Filter
+	(*Village)(nil),               // 6: erikrios.ponorogoregencyapi.Village
+}
+var file_village_service_proto_depIdxs = []int32{
+	5, // 0: erikrios.ponorogoregencyapi.GetVillagesRequest.filter:type_name -> erikrios.ponorogoregencyapi.Filter
+	6, // 1: erikrios.ponorogoregencyapi.GetVillagesResponse.villages:type_name -> erikrios.ponorogoregencyapi.Village
+	6, // 2: erikrios.ponorogoregencyapi.GetVillageResponse.village:type_name -> erikrios.ponorogoregencyapi.Village
+	0, // 3: erikrios.ponorogoregencyapi.VillageService.GetVillages:input_type -> erikrios.ponorogoregencyapi.GetVillagesRequest
+	2, // 4: erikrios.ponorogoregencyapi.VillageService.GetVillage:input_type -> erikrios.ponorogoregencyapi.GetVillageRequest
+	4, // 5: erikrios.ponorogoregencyapi.VillageService.StreamVillages:input_type -> erikrios.ponorogoregencyapi.StreamVillagesRequest
+	1, // 6: erikrios.ponorogoregencyapi.VillageService.GetVillages:output_type -> erikrios.ponorogoregencyapi.GetVillagesResponse
+	3, // 7: erikrios.ponorogoregencyapi.VillageService.GetVillage:output_type -> erikrios.ponorogoregencyapi.GetVillageResponse
+	6, // 8: erikrios.ponorogoregencyapi.VillageService.StreamVillages:output_type -> erikrios.ponorogoregencyapi.Village
+	6, // [6:9] is the sub-list for method output_type
+	3, // [3:6] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_village_service_proto_init() }
+func file_village_service_proto_init() {
+	if File_village_service_proto != nil {
+		return
+	}
+	file_village_message_proto_init()
+	file_filter_message_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_village_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVillagesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_village_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVillagesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_village_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVillageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_village_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVillageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_village_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamVillagesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_village_service_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_village_service_proto_goTypes,
+		DependencyIndexes: file_village_service_proto_depIdxs,
+		MessageInfos:      file_village_service_proto_msgTypes,
+	}.Build()
+	File_village_service_proto = out.File
+	file_village_service_proto_rawDesc = nil
+	file_village_service_proto_goTypes = nil
+	file_village_service_proto_depIdxs = nil
+}
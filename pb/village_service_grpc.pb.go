@@ -0,0 +1,205 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.12.4
+// source: village_service.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// VillageServiceClient is the client API for VillageService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type VillageServiceClient interface {
+	GetVillages(ctx context.Context, in *GetVillagesRequest, opts ...grpc.CallOption) (*GetVillagesResponse, error)
+	GetVillage(ctx context.Context, in *GetVillageRequest, opts ...grpc.CallOption) (*GetVillageResponse, error)
+	StreamVillages(ctx context.Context, in *StreamVillagesRequest, opts ...grpc.CallOption) (VillageService_StreamVillagesClient, error)
+}
+
+type villageServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewVillageServiceClient(cc grpc.ClientConnInterface) VillageServiceClient {
+	return &villageServiceClient{cc}
+}
+
+func (c *villageServiceClient) GetVillages(ctx context.Context, in *GetVillagesRequest, opts ...grpc.CallOption) (*GetVillagesResponse, error) {
+	out := new(GetVillagesResponse)
+	err := c.cc.Invoke(ctx, "/erikrios.ponorogoregencyapi.VillageService/GetVillages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *villageServiceClient) GetVillage(ctx context.Context, in *GetVillageRequest, opts ...grpc.CallOption) (*GetVillageResponse, error) {
+	out := new(GetVillageResponse)
+	err := c.cc.Invoke(ctx, "/erikrios.ponorogoregencyapi.VillageService/GetVillage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *villageServiceClient) StreamVillages(ctx context.Context, in *StreamVillagesRequest, opts ...grpc.CallOption) (VillageService_StreamVillagesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &VillageService_ServiceDesc.Streams[0], "/erikrios.ponorogoregencyapi.VillageService/StreamVillages", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &villageServiceStreamVillagesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type VillageService_StreamVillagesClient interface {
+	Recv() (*Village, error)
+	grpc.ClientStream
+}
+
+type villageServiceStreamVillagesClient struct {
+	grpc.ClientStream
+}
+
+func (x *villageServiceStreamVillagesClient) Recv() (*Village, error) {
+	m := new(Village)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// VillageServiceServer is the server API for VillageService service.
+// All implementations must embed UnimplementedVillageServiceServer
+// for forward compatibility
+type VillageServiceServer interface {
+	GetVillages(context.Context, *GetVillagesRequest) (*GetVillagesResponse, error)
+	GetVillage(context.Context, *GetVillageRequest) (*GetVillageResponse, error)
+	StreamVillages(*StreamVillagesRequest, VillageService_StreamVillagesServer) error
+	mustEmbedUnimplementedVillageServiceServer()
+}
+
+// UnimplementedVillageServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedVillageServiceServer struct {
+}
+
+func (UnimplementedVillageServiceServer) GetVillages(context.Context, *GetVillagesRequest) (*GetVillagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVillages not implemented")
+}
+func (UnimplementedVillageServiceServer) GetVillage(context.Context, *GetVillageRequest) (*GetVillageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVillage not implemented")
+}
+func (UnimplementedVillageServiceServer) StreamVillages(*StreamVillagesRequest, VillageService_StreamVillagesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamVillages not implemented")
+}
+func (UnimplementedVillageServiceServer) mustEmbedUnimplementedVillageServiceServer() {}
+
+// UnsafeVillageServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to VillageServiceServer will
+// result in compilation errors.
+type UnsafeVillageServiceServer interface {
+	mustEmbedUnimplementedVillageServiceServer()
+}
+
+func RegisterVillageServiceServer(s grpc.ServiceRegistrar, srv VillageServiceServer) {
+	s.RegisterService(&VillageService_ServiceDesc, srv)
+}
+
+func _VillageService_GetVillages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVillagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VillageServiceServer).GetVillages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/erikrios.ponorogoregencyapi.VillageService/GetVillages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VillageServiceServer).GetVillages(ctx, req.(*GetVillagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VillageService_GetVillage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVillageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VillageServiceServer).GetVillage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/erikrios.ponorogoregencyapi.VillageService/GetVillage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VillageServiceServer).GetVillage(ctx, req.(*GetVillageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VillageService_StreamVillages_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamVillagesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VillageServiceServer).StreamVillages(m, &villageServiceStreamVillagesServer{stream})
+}
+
+type VillageService_StreamVillagesServer interface {
+	Send(*Village) error
+	grpc.ServerStream
+}
+
+type villageServiceStreamVillagesServer struct {
+	grpc.ServerStream
+}
+
+func (x *villageServiceStreamVillagesServer) Send(m *Village) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// VillageService_ServiceDesc is the grpc.ServiceDesc for VillageService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var VillageService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "erikrios.ponorogoregencyapi.VillageService",
+	HandlerType: (*VillageServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetVillages",
+			Handler:    _VillageService_GetVillages_Handler,
+		},
+		{
+			MethodName: "GetVillage",
+			Handler:    _VillageService_GetVillage_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamVillages",
+			Handler:       _VillageService_StreamVillages_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "village_service.proto",
+}
@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"log"
+
+	"github.com/erikrios/ponorogo-regency-api/entity"
+)
+
+func findBoundary(ctx context.Context, db *sql.DB, table, id string) (boundary entity.Boundary, err error) {
+	statement := "SELECT id, name, boundary::text FROM " + table + " WHERE id = $1;"
+
+	switch scanErr := db.QueryRowContext(ctx, statement, id).Scan(&boundary.ID, &boundary.Name, &boundary.Geometry); scanErr {
+	case sql.ErrNoRows:
+		err = ErrQueryNotFound
+		return
+	case nil:
+		return
+	default:
+		err = ErrDatabase
+		log.Println(scanErr)
+		return
+	}
+}
+
+func queryBoundaries(ctx context.Context, db *sql.DB, statement string, args ...any) (boundaries []entity.Boundary, err error) {
+	rows, err := db.QueryContext(ctx, statement, args...)
+	if err != nil {
+		log.Println(err)
+		err = ErrDatabase
+		return
+	}
+
+	defer func(rows *sql.Rows) {
+		if closeErr := rows.Close(); closeErr != nil {
+			log.Println(closeErr.Error())
+		}
+	}(rows)
+
+	boundaries = make([]entity.Boundary, 0)
+	for rows.Next() {
+		var boundary entity.Boundary
+		if err = rows.Scan(&boundary.ID, &boundary.Name, &boundary.Geometry); err != nil {
+			log.Println(err)
+			err = ErrDatabase
+			return
+		}
+		boundaries = append(boundaries, boundary)
+	}
+
+	if rowsErr := rows.Err(); rowsErr != nil {
+		log.Println(rowsErr)
+		err = ErrDatabase
+	}
+
+	return
+}
@@ -7,7 +7,11 @@ import (
 )
 
 type DistrictRepository interface {
-	FindAll(ctx context.Context) (districts []entity.District, err error)
+	FindAll(ctx context.Context, query Query) (districts []entity.District, total int, err error)
 	FindByID(ctx context.Context, id string) (district entity.District, err error)
-	FindByName(ctx context.Context, keyword string) (districts []entity.District, err error)
+	FindByIDs(ctx context.Context, ids []string) (districts []entity.District, err error)
+	FindByName(ctx context.Context, keyword string, query Query) (districts []entity.District, total int, err error)
+	FindByRegencyID(ctx context.Context, regencyID string, query Query) (districts []entity.District, total int, err error)
+	FindBoundaryByID(ctx context.Context, id string) (boundary entity.Boundary, err error)
+	FindBoundaries(ctx context.Context) (boundaries []entity.Boundary, err error)
 }
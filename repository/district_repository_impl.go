@@ -6,20 +6,72 @@ import (
 	"log"
 
 	"github.com/erikrios/ponorogo-regency-api/entity"
+	"github.com/erikrios/ponorogo-regency-api/normalize"
+	"github.com/lib/pq"
 )
 
 type districtRepositoryImpl struct {
 	db *sql.DB
 }
 
+var districtListSpec = listSpec{
+	table:   "districts d",
+	columns: "d.id, d.name, d.regency_id, d.latitude, d.longitude",
+	id:      "d.id",
+	parents: []parent{
+		{name: "regency", join: "INNER JOIN regencies r on d.regency_id = r.id", columns: "r.name AS regency_name, r.province_id"},
+		{name: "province", join: "INNER JOIN provinces p on r.province_id = p.id", columns: "p.name AS province_name"},
+	},
+	filters: map[string]filter{
+		"name":        {nameColumn: "d.name"},
+		"regency_id":  {condition: "d.regency_id = %s"},
+		"province_id": {condition: "r.province_id = %s", joins: 1},
+	},
+	sorts: map[string]string{
+		"id":   "d.id",
+		"name": "d.name",
+	},
+}
+
 func NewDistrictRepositoryImpl(db *sql.DB) *districtRepositoryImpl {
 	return &districtRepositoryImpl{db: db}
 }
 
-func (d *districtRepositoryImpl) FindAll(ctx context.Context) (districts []entity.District, err error) {
-	statement := "SELECT d.id, d.name, d.regency_id, r.name AS regency_name, r.province_id, p.name AS province_name FROM districts d INNER JOIN regencies r on d.regency_id = r.id INNER JOIN provinces p on r.province_id = p.id;"
+func (d *districtRepositoryImpl) FindAll(ctx context.Context, query Query) (districts []entity.District, total int, err error) {
+	return d.list(ctx, "", "", 0, query)
+}
+
+func (d *districtRepositoryImpl) FindByID(ctx context.Context, id string) (district entity.District, err error) {
+	statement := "SELECT d.id, d.name, d.regency_id, d.latitude, d.longitude, r.name AS regency_name, r.province_id, p.name AS province_name FROM districts d INNER JOIN regencies r on d.regency_id = r.id INNER JOIN provinces p on r.province_id = p.id WHERE d.id = $1;"
+
+	row := d.db.QueryRowContext(ctx, statement, id)
+
+	switch scanErr := row.Scan(
+		&district.ID,
+		&district.Name,
+		&district.Regency.ID,
+		&district.Latitude,
+		&district.Longitude,
+		&district.Regency.Name,
+		&district.Regency.Province.ID,
+		&district.Regency.Province.Name,
+	); scanErr {
+	case sql.ErrNoRows:
+		err = ErrQueryNotFound
+		return
+	case nil:
+		return
+	default:
+		err = ErrDatabase
+		log.Println(scanErr)
+		return
+	}
+}
+
+func (d *districtRepositoryImpl) FindByIDs(ctx context.Context, ids []string) (districts []entity.District, err error) {
+	statement := "SELECT d.id, d.name, d.regency_id, d.latitude, d.longitude, r.name AS regency_name, r.province_id, p.name AS province_name FROM districts d INNER JOIN regencies r on d.regency_id = r.id INNER JOIN provinces p on r.province_id = p.id WHERE d.id = ANY($1) ORDER BY d.id;"
 
-	rows, err := d.db.QueryContext(ctx, statement)
+	rows, err := d.db.QueryContext(ctx, statement, pq.Array(ids))
 	if err != nil {
 		log.Println(err)
 		err = ErrDatabase
@@ -27,18 +79,20 @@ func (d *districtRepositoryImpl) FindAll(ctx context.Context) (districts []entit
 	}
 
 	defer func(rows *sql.Rows) {
-		if err = rows.Close(); err != nil {
-			log.Println(err.Error())
+		if closeErr := rows.Close(); closeErr != nil {
+			log.Println(closeErr.Error())
 		}
 	}(rows)
 
-	districts = make([]entity.District, 0)
+	districts = make([]entity.District, 0, len(ids))
 	for rows.Next() {
 		var district entity.District
 		if err = rows.Scan(
 			&district.ID,
 			&district.Name,
 			&district.Regency.ID,
+			&district.Latitude,
+			&district.Longitude,
 			&district.Regency.Name,
 			&district.Regency.Province.ID,
 			&district.Regency.Province.Name,
@@ -50,38 +104,45 @@ func (d *districtRepositoryImpl) FindAll(ctx context.Context) (districts []entit
 		districts = append(districts, district)
 	}
 
+	if rowsErr := rows.Err(); rowsErr != nil {
+		log.Println(rowsErr)
+		err = ErrDatabase
+	}
+
 	return
 }
 
-func (d *districtRepositoryImpl) FindByID(ctx context.Context, id string) (district entity.District, err error) {
-	statement := "SELECT d.id, d.name, d.regency_id, r.name AS regency_name, r.province_id, p.name AS province_name FROM districts d INNER JOIN regencies r on d.regency_id = r.id INNER JOIN provinces p on r.province_id = p.id WHERE d.id = $1;"
+func (d *districtRepositoryImpl) FindByName(ctx context.Context, keyword string, query Query) (districts []entity.District, total int, err error) {
+	return d.list(ctx, "", nameContains("d.name", "$1"), 0, query, normalize.Name(keyword))
+}
 
-	row := d.db.QueryRowContext(ctx, statement, id)
+func (d *districtRepositoryImpl) FindByRegencyID(ctx context.Context, regencyID string, query Query) (districts []entity.District, total int, err error) {
+	return d.list(ctx, "regencies", "d.regency_id = $1", 0, query, regencyID)
+}
 
-	switch scanErr := row.Scan(
-		&district.ID,
-		&district.Name,
-		&district.Regency.ID,
-		&district.Regency.Name,
-		&district.Regency.Province.ID,
-		&district.Regency.Province.Name,
-	); scanErr {
-	case sql.ErrNoRows:
-		err = ErrQueryNotFound
-		return
-	case nil:
-		return
-	default:
-		err = ErrDatabase
-		log.Println(scanErr)
+func (d *districtRepositoryImpl) FindBoundaryByID(ctx context.Context, id string) (boundary entity.Boundary, err error) {
+	return findBoundary(ctx, d.db, "districts", id)
+}
+
+func (d *districtRepositoryImpl) FindBoundaries(ctx context.Context) (boundaries []entity.Boundary, err error) {
+	return queryBoundaries(ctx, d.db, "SELECT d.id, d.name, d.boundary::text FROM districts d WHERE d.boundary IS NOT NULL ORDER BY d.id;")
+}
+
+func (d *districtRepositoryImpl) list(ctx context.Context, parent, condition string, joins int, query Query, args ...any) (districts []entity.District, total int, err error) {
+	statements, err := query.build(districtListSpec, condition, joins, args...)
+	if err != nil {
 		return
 	}
-}
 
-func (d *districtRepositoryImpl) FindByName(ctx context.Context, keyword string) (districts []entity.District, err error) {
-	statement := "SELECT d.id, d.name, d.regency_id, r.name AS regency_name, r.province_id, p.name AS province_name FROM districts d INNER JOIN regencies r on d.regency_id = r.id INNER JOIN provinces p on r.province_id = p.id WHERE d.name ILIKE '%' || $1 || '%';"
+	if parent != "" {
+		statements = statements.childrenOf(parent)
+	}
 
-	rows, err := d.db.QueryContext(ctx, statement, keyword)
+	if total, err = count(ctx, d.db, statements); err != nil {
+		return
+	}
+
+	rows, err := d.db.QueryContext(ctx, statements.query, statements.queryArgs...)
 	if err != nil {
 		log.Println(err)
 		err = ErrDatabase
@@ -97,14 +158,16 @@ func (d *districtRepositoryImpl) FindByName(ctx context.Context, keyword string)
 	districts = make([]entity.District, 0)
 	for rows.Next() {
 		var district entity.District
-		if err = rows.Scan(
-			&district.ID,
-			&district.Name,
-			&district.Regency.ID,
-			&district.Regency.Name,
-			&district.Regency.Province.ID,
-			&district.Regency.Province.Name,
-		); err != nil {
+		dests := []any{&district.ID, &district.Name, &district.Regency.ID, &district.Latitude, &district.Longitude}
+		parents := [][]any{
+			{&district.Regency.Name, &district.Regency.Province.ID},
+			{&district.Regency.Province.Name},
+		}
+		for _, parent := range parents[:statements.parents] {
+			dests = append(dests, parent...)
+		}
+
+		if err = rows.Scan(dests...); err != nil {
 			log.Println(err)
 			err = ErrDatabase
 			return
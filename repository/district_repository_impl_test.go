@@ -3,14 +3,18 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"regexp"
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/erikrios/ponorogo-regency-api/entity"
+	"github.com/erikrios/ponorogo-regency-api/normalize"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestDistrictRepositoryImpl(t *testing.T) {
+	latitude, longitude := -8.0, 111.47
 
 	t.Run("TestFindAll", func(t *testing.T) {
 		db, mock, err := sqlmock.New()
@@ -34,17 +38,18 @@ func TestDistrictRepositoryImpl(t *testing.T) {
 			},
 		}
 
-		returnedRows := sqlmock.NewRows([]string{"id", "name", "regency_id", "regency_name", "province_id", "province_name"})
+		returnedRows := sqlmock.NewRows([]string{"id", "name", "regency_id", "latitude", "longitude", "regency_name", "province_id", "province_name"})
 		for _, district := range expectedDistricts {
-			returnedRows.AddRow(district.ID, district.Name, district.Regency.ID, district.Regency.Name, district.Regency.Province.ID, district.Regency.Province.Name)
+			returnedRows.AddRow(district.ID, district.Name, district.Regency.ID, district.Latitude, district.Longitude, district.Regency.Name, district.Regency.Province.ID, district.Regency.Province.Name)
 		}
 
 		t.Run("it should return valid districts, when database successfully return the data", func(t *testing.T) {
-			mock.ExpectQuery(".*").WillReturnRows(returnedRows)
+			mock.ExpectQuery("SELECT COUNT").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(len(expectedDistricts)))
+			mock.ExpectQuery("ORDER BY").WillReturnRows(returnedRows)
 
 			var repo DistrictRepository = NewDistrictRepositoryImpl(db)
 
-			got, err := repo.FindAll(context.Background())
+			got, total, err := repo.FindAll(context.Background(), Query{})
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -54,6 +59,7 @@ func TestDistrictRepositoryImpl(t *testing.T) {
 			}
 
 			assert.ElementsMatch(t, expectedDistricts, got)
+			assert.Equal(t, len(expectedDistricts), total)
 		})
 
 		t.Run("it should return error, when database return an error", func(t *testing.T) {
@@ -61,7 +67,7 @@ func TestDistrictRepositoryImpl(t *testing.T) {
 
 			var repo DistrictRepository = NewDistrictRepositoryImpl(db)
 
-			if _, err := repo.FindAll(context.Background()); assert.Error(t, err) {
+			if _, _, err := repo.FindAll(context.Background(), Query{}); assert.Error(t, err) {
 				assert.Equal(t, ErrDatabase, err)
 			}
 
@@ -80,8 +86,10 @@ func TestDistrictRepositoryImpl(t *testing.T) {
 		defer db.Close()
 
 		expectedDistrict := entity.District{
-			ID:   "32010001",
-			Name: "Bungkal",
+			ID:        "32010001",
+			Name:      "Bungkal",
+			Latitude:  &latitude,
+			Longitude: &longitude,
 			Regency: entity.Regency{
 				ID:   "3201",
 				Name: "Kabupaten Ponorogo",
@@ -92,8 +100,8 @@ func TestDistrictRepositoryImpl(t *testing.T) {
 			},
 		}
 
-		returnedRows := sqlmock.NewRows([]string{"id", "name", "regency_id", "regency_name", "province_id", "province_name"})
-		returnedRows.AddRow(expectedDistrict.ID, expectedDistrict.Name, expectedDistrict.Regency.ID, expectedDistrict.Regency.Name, expectedDistrict.Regency.Province.ID, expectedDistrict.Regency.Province.Name)
+		returnedRows := sqlmock.NewRows([]string{"id", "name", "regency_id", "latitude", "longitude", "regency_name", "province_id", "province_name"})
+		returnedRows.AddRow(expectedDistrict.ID, expectedDistrict.Name, expectedDistrict.Regency.ID, expectedDistrict.Latitude, expectedDistrict.Longitude, expectedDistrict.Regency.Name, expectedDistrict.Regency.Province.ID, expectedDistrict.Regency.Province.Name)
 
 		t.Run("it should return valid district, when database successfully return the data", func(t *testing.T) {
 			mock.ExpectQuery(".*").WithArgs(expectedDistrict.ID).WillReturnRows(returnedRows)
@@ -141,6 +149,66 @@ func TestDistrictRepositoryImpl(t *testing.T) {
 		})
 	})
 
+	t.Run("TestFindByIDs", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer db.Close()
+
+		expectedDistricts := []entity.District{
+			{
+				ID:   "3502010",
+				Name: "NGRAYUN",
+				Regency: entity.Regency{
+					ID:   "3502",
+					Name: "KABUPATEN PONOROGO",
+					Province: entity.Province{
+						ID:   "35",
+						Name: "JAWA TIMUR",
+					},
+				},
+			},
+		}
+		ids := []string{"3502010", "3502999"}
+
+		t.Run("it should return the found districts, when database successfully return the data", func(t *testing.T) {
+			returnedRows := sqlmock.NewRows([]string{"id", "name", "regency_id", "latitude", "longitude", "regency_name", "province_id", "province_name"})
+			for _, district := range expectedDistricts {
+				returnedRows.AddRow(district.ID, district.Name, district.Regency.ID, district.Latitude, district.Longitude, district.Regency.Name, district.Regency.Province.ID, district.Regency.Province.Name)
+			}
+
+			mock.ExpectQuery(regexp.QuoteMeta("WHERE d.id = ANY($1)")).WithArgs(pq.Array(ids)).WillReturnRows(returnedRows)
+
+			var repo DistrictRepository = NewDistrictRepositoryImpl(db)
+
+			got, err := repo.FindByIDs(context.Background(), ids)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, expectedDistricts, got)
+		})
+
+		t.Run("it should return error, when database return an error", func(t *testing.T) {
+			mock.ExpectQuery(".*").WithArgs(pq.Array(ids)).WillReturnError(ErrDatabase)
+
+			var repo DistrictRepository = NewDistrictRepositoryImpl(db)
+
+			if _, err := repo.FindByIDs(context.Background(), ids); assert.Error(t, err) {
+				assert.Equal(t, ErrDatabase, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+		})
+	})
+
 	t.Run("TestFindByName", func(t *testing.T) {
 
 		db, mock, err := sqlmock.New()
@@ -164,17 +232,79 @@ func TestDistrictRepositoryImpl(t *testing.T) {
 			},
 		}
 
-		returnedRows := sqlmock.NewRows([]string{"id", "name", "regency_id", "regency_name", "province_id", "province_name"})
+		returnedRows := sqlmock.NewRows([]string{"id", "name", "regency_id", "latitude", "longitude", "regency_name", "province_id", "province_name"})
+		for _, district := range expectedDistricts {
+			returnedRows.AddRow(district.ID, district.Name, district.Regency.ID, district.Latitude, district.Longitude, district.Regency.Name, district.Regency.Province.ID, district.Regency.Province.Name)
+		}
+
+		t.Run("it should return valid districts, when database successfully return the data", func(t *testing.T) {
+			mock.ExpectQuery("SELECT COUNT").WithArgs(normalize.Name(expectedDistricts[0].Name)).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(len(expectedDistricts)))
+			mock.ExpectQuery("ORDER BY").WithArgs(normalize.Name(expectedDistricts[0].Name)).WillReturnRows(returnedRows)
+
+			var repo DistrictRepository = NewDistrictRepositoryImpl(db)
+
+			got, total, err := repo.FindByName(context.Background(), expectedDistricts[0].Name, Query{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+
+			assert.ElementsMatch(t, expectedDistricts, got)
+			assert.Equal(t, len(expectedDistricts), total)
+		})
+
+		t.Run("it should return error, when database return an error", func(t *testing.T) {
+			mock.ExpectQuery(".*").WithArgs(normalize.Name(expectedDistricts[0].Name)).WillReturnError(ErrDatabase)
+
+			var repo DistrictRepository = NewDistrictRepositoryImpl(db)
+
+			if _, _, err := repo.FindByName(context.Background(), expectedDistricts[0].Name, Query{}); assert.Error(t, err) {
+				assert.Equal(t, ErrDatabase, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+		})
+	})
+
+	t.Run("TestFindByRegencyID", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer db.Close()
+
+		expectedDistricts := []entity.District{
+			{
+				ID:   "3502010",
+				Name: "NGRAYUN",
+				Regency: entity.Regency{
+					ID:   "3502",
+					Name: "KABUPATEN PONOROGO",
+					Province: entity.Province{
+						ID:   "35",
+						Name: "JAWA TIMUR",
+					},
+				},
+			},
+		}
+
+		returnedRows := sqlmock.NewRows([]string{"id", "name", "regency_id", "latitude", "longitude", "regency_name", "province_id", "province_name"})
 		for _, district := range expectedDistricts {
-			returnedRows.AddRow(district.ID, district.Name, district.Regency.ID, district.Regency.Name, district.Regency.Province.ID, district.Regency.Province.Name)
+			returnedRows.AddRow(district.ID, district.Name, district.Regency.ID, district.Latitude, district.Longitude, district.Regency.Name, district.Regency.Province.ID, district.Regency.Province.Name)
 		}
 
 		t.Run("it should return valid districts, when database successfully return the data", func(t *testing.T) {
-			mock.ExpectQuery(".*").WithArgs(expectedDistricts[0].Name).WillReturnRows(returnedRows)
+			mock.ExpectQuery("SELECT EXISTS").WithArgs("3502").WillReturnRows(sqlmock.NewRows([]string{"exists", "count"}).AddRow(true, len(expectedDistricts)))
+			mock.ExpectQuery("ORDER BY").WithArgs("3502").WillReturnRows(returnedRows)
 
 			var repo DistrictRepository = NewDistrictRepositoryImpl(db)
 
-			got, err := repo.FindByName(context.Background(), expectedDistricts[0].Name)
+			got, total, err := repo.FindByRegencyID(context.Background(), "3502", Query{})
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -184,14 +314,148 @@ func TestDistrictRepositoryImpl(t *testing.T) {
 			}
 
 			assert.ElementsMatch(t, expectedDistricts, got)
+			assert.Equal(t, len(expectedDistricts), total)
+		})
+
+		t.Run("it should return not found error, when given regency id not found in the database", func(t *testing.T) {
+			mock.ExpectQuery("SELECT EXISTS").WithArgs("9999").WillReturnRows(sqlmock.NewRows([]string{"exists", "count"}).AddRow(false, 0))
+
+			var repo DistrictRepository = NewDistrictRepositoryImpl(db)
+
+			if _, _, err := repo.FindByRegencyID(context.Background(), "9999", Query{}); assert.Error(t, err) {
+				assert.Equal(t, ErrQueryNotFound, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+		})
+
+		t.Run("it should return error, when database return an error", func(t *testing.T) {
+			mock.ExpectQuery(".*").WithArgs("3502").WillReturnError(ErrDatabase)
+
+			var repo DistrictRepository = NewDistrictRepositoryImpl(db)
+
+			if _, _, err := repo.FindByRegencyID(context.Background(), "3502", Query{}); assert.Error(t, err) {
+				assert.Equal(t, ErrDatabase, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+		})
+	})
+
+	t.Run("TestFindBoundaryByID", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer db.Close()
+
+		geometry := `{"type": "Polygon", "coordinates": [[[111.4, -7.9], [111.5, -7.9], [111.5, -7.8], [111.4, -7.9]]]}`
+		expectedBoundary := entity.Boundary{ID: "3502010", Name: "NGRAYUN", Geometry: &geometry}
+
+		t.Run("it should return the boundary as GeoJSON text, when database successfully return the data", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT id, name, boundary::text FROM districts WHERE id = $1;")).WithArgs(expectedBoundary.ID).WillReturnRows(sqlmock.NewRows([]string{"id", "name", "boundary"}).AddRow(expectedBoundary.ID, expectedBoundary.Name, geometry))
+
+			var repo DistrictRepository = NewDistrictRepositoryImpl(db)
+
+			got, err := repo.FindBoundaryByID(context.Background(), expectedBoundary.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, expectedBoundary, got)
+		})
+
+		t.Run("it should return a nil geometry, when the boundary isn't known", func(t *testing.T) {
+			mock.ExpectQuery("FROM districts").WithArgs(expectedBoundary.ID).WillReturnRows(sqlmock.NewRows([]string{"id", "name", "boundary"}).AddRow(expectedBoundary.ID, expectedBoundary.Name, nil))
+
+			var repo DistrictRepository = NewDistrictRepositoryImpl(db)
+
+			got, err := repo.FindBoundaryByID(context.Background(), expectedBoundary.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Nil(t, got.Geometry)
+		})
+
+		t.Run("it should return not found error, when given id not found in the database", func(t *testing.T) {
+			mock.ExpectQuery("FROM districts").WithArgs("3502999").WillReturnRows(sqlmock.NewRows([]string{"id", "name", "boundary"}))
+
+			var repo DistrictRepository = NewDistrictRepositoryImpl(db)
+
+			if _, err := repo.FindBoundaryByID(context.Background(), "3502999"); assert.Error(t, err) {
+				assert.Equal(t, ErrQueryNotFound, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+		})
+
+		t.Run("it should return error, when database return an error", func(t *testing.T) {
+			mock.ExpectQuery(".*").WithArgs(expectedBoundary.ID).WillReturnError(ErrDatabase)
+
+			var repo DistrictRepository = NewDistrictRepositoryImpl(db)
+
+			if _, err := repo.FindBoundaryByID(context.Background(), expectedBoundary.ID); assert.Error(t, err) {
+				assert.Equal(t, ErrDatabase, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+		})
+	})
+
+	t.Run("TestFindBoundaries", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer db.Close()
+
+		geometry := `{"type": "Polygon", "coordinates": [[[111.4, -7.9], [111.5, -7.9], [111.5, -7.8], [111.4, -7.9]]]}`
+		expectedBoundaries := []entity.Boundary{
+			{ID: "3502010", Name: "NGRAYUN", Geometry: &geometry},
+		}
+
+		t.Run("it should return the known boundaries, when database successfully return the data", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta("WHERE d.boundary IS NOT NULL ORDER BY d.id;")).WillReturnRows(
+				sqlmock.NewRows([]string{"id", "name", "boundary"}).AddRow(expectedBoundaries[0].ID, expectedBoundaries[0].Name, geometry),
+			)
+
+			var repo DistrictRepository = NewDistrictRepositoryImpl(db)
+
+			got, err := repo.FindBoundaries(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, expectedBoundaries, got)
 		})
 
 		t.Run("it should return error, when database return an error", func(t *testing.T) {
-			mock.ExpectQuery(".*").WithArgs(expectedDistricts[0].Name).WillReturnError(ErrDatabase)
+			mock.ExpectQuery("FROM districts").WillReturnError(ErrDatabase)
 
 			var repo DistrictRepository = NewDistrictRepositoryImpl(db)
 
-			if _, err := repo.FindByName(context.Background(), expectedDistricts[0].Name); assert.Error(t, err) {
+			if _, err := repo.FindBoundaries(context.Background()); assert.Error(t, err) {
 				assert.Equal(t, ErrDatabase, err)
 			}
 
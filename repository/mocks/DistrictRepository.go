@@ -7,6 +7,8 @@ import (
 
 	entity "github.com/erikrios/ponorogo-regency-api/entity"
 	mock "github.com/stretchr/testify/mock"
+
+	repository "github.com/erikrios/ponorogo-regency-api/repository"
 )
 
 // DistrictRepository is an autogenerated mock type for the DistrictRepository type
@@ -14,19 +16,49 @@ type DistrictRepository struct {
 	mock.Mock
 }
 
-// FindAll provides a mock function with given fields: ctx
-func (_m *DistrictRepository) FindAll(ctx context.Context) ([]entity.District, error) {
-	ret := _m.Called(ctx)
+// FindAll provides a mock function with given fields: ctx, query
+func (_m *DistrictRepository) FindAll(ctx context.Context, query repository.Query) ([]entity.District, int, error) {
+	ret := _m.Called(ctx, query)
 
 	var r0 []entity.District
-	if rf, ok := ret.Get(0).(func(context.Context) []entity.District); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.Query) []entity.District); ok {
+		r0 = rf(ctx, query)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]entity.District)
 		}
 	}
 
+	var r1 int
+	if rf, ok := ret.Get(1).(func(context.Context, repository.Query) int); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, repository.Query) error); ok {
+		r2 = rf(ctx, query)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// FindBoundaries provides a mock function with given fields: ctx
+func (_m *DistrictRepository) FindBoundaries(ctx context.Context) ([]entity.Boundary, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []entity.Boundary
+	if rf, ok := ret.Get(0).(func(context.Context) []entity.Boundary); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Boundary)
+		}
+	}
+
 	var r1 error
 	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
 		r1 = rf(ctx)
@@ -37,6 +69,27 @@ func (_m *DistrictRepository) FindAll(ctx context.Context) ([]entity.District, e
 	return r0, r1
 }
 
+// FindBoundaryByID provides a mock function with given fields: ctx, id
+func (_m *DistrictRepository) FindBoundaryByID(ctx context.Context, id string) (entity.Boundary, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 entity.Boundary
+	if rf, ok := ret.Get(0).(func(context.Context, string) entity.Boundary); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(entity.Boundary)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindByID provides a mock function with given fields: ctx, id
 func (_m *DistrictRepository) FindByID(ctx context.Context, id string) (entity.District, error) {
 	ret := _m.Called(ctx, id)
@@ -58,13 +111,13 @@ func (_m *DistrictRepository) FindByID(ctx context.Context, id string) (entity.D
 	return r0, r1
 }
 
-// FindByName provides a mock function with given fields: ctx, keyword
-func (_m *DistrictRepository) FindByName(ctx context.Context, keyword string) ([]entity.District, error) {
-	ret := _m.Called(ctx, keyword)
+// FindByIDs provides a mock function with given fields: ctx, ids
+func (_m *DistrictRepository) FindByIDs(ctx context.Context, ids []string) ([]entity.District, error) {
+	ret := _m.Called(ctx, ids)
 
 	var r0 []entity.District
-	if rf, ok := ret.Get(0).(func(context.Context, string) []entity.District); ok {
-		r0 = rf(ctx, keyword)
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []entity.District); ok {
+		r0 = rf(ctx, ids)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]entity.District)
@@ -72,11 +125,71 @@ func (_m *DistrictRepository) FindByName(ctx context.Context, keyword string) ([
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, keyword)
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, ids)
 	} else {
 		r1 = ret.Error(1)
 	}
 
 	return r0, r1
 }
+
+// FindByName provides a mock function with given fields: ctx, keyword, query
+func (_m *DistrictRepository) FindByName(ctx context.Context, keyword string, query repository.Query) ([]entity.District, int, error) {
+	ret := _m.Called(ctx, keyword, query)
+
+	var r0 []entity.District
+	if rf, ok := ret.Get(0).(func(context.Context, string, repository.Query) []entity.District); ok {
+		r0 = rf(ctx, keyword, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.District)
+		}
+	}
+
+	var r1 int
+	if rf, ok := ret.Get(1).(func(context.Context, string, repository.Query) int); ok {
+		r1 = rf(ctx, keyword, query)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, repository.Query) error); ok {
+		r2 = rf(ctx, keyword, query)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// FindByRegencyID provides a mock function with given fields: ctx, regencyID, query
+func (_m *DistrictRepository) FindByRegencyID(ctx context.Context, regencyID string, query repository.Query) ([]entity.District, int, error) {
+	ret := _m.Called(ctx, regencyID, query)
+
+	var r0 []entity.District
+	if rf, ok := ret.Get(0).(func(context.Context, string, repository.Query) []entity.District); ok {
+		r0 = rf(ctx, regencyID, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.District)
+		}
+	}
+
+	var r1 int
+	if rf, ok := ret.Get(1).(func(context.Context, string, repository.Query) int); ok {
+		r1 = rf(ctx, regencyID, query)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, repository.Query) error); ok {
+		r2 = rf(ctx, regencyID, query)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
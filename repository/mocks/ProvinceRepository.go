@@ -7,6 +7,8 @@ import (
 
 	entity "github.com/erikrios/ponorogo-regency-api/entity"
 	mock "github.com/stretchr/testify/mock"
+
+	repository "github.com/erikrios/ponorogo-regency-api/repository"
 )
 
 // ProvinceRepository is an autogenerated mock type for the ProvinceRepository type
@@ -14,27 +16,34 @@ type ProvinceRepository struct {
 	mock.Mock
 }
 
-// FindAll provides a mock function with given fields: ctx
-func (_m *ProvinceRepository) FindAll(ctx context.Context) ([]entity.Province, error) {
-	ret := _m.Called(ctx)
+// FindAll provides a mock function with given fields: ctx, query
+func (_m *ProvinceRepository) FindAll(ctx context.Context, query repository.Query) ([]entity.Province, int, error) {
+	ret := _m.Called(ctx, query)
 
 	var r0 []entity.Province
-	if rf, ok := ret.Get(0).(func(context.Context) []entity.Province); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.Query) []entity.Province); ok {
+		r0 = rf(ctx, query)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]entity.Province)
 		}
 	}
 
-	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	var r1 int
+	if rf, ok := ret.Get(1).(func(context.Context, repository.Query) int); ok {
+		r1 = rf(ctx, query)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(int)
 	}
 
-	return r0, r1
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, repository.Query) error); ok {
+		r2 = rf(ctx, query)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
 // FindByID provides a mock function with given fields: ctx, id
@@ -58,22 +67,52 @@ func (_m *ProvinceRepository) FindByID(ctx context.Context, id string) (entity.P
 	return r0, r1
 }
 
-// FindByName provides a mock function with given fields: ctx, keyword
-func (_m *ProvinceRepository) FindByName(ctx context.Context, keyword string) ([]entity.Province, error) {
-	ret := _m.Called(ctx, keyword)
+// FindByName provides a mock function with given fields: ctx, keyword, query
+func (_m *ProvinceRepository) FindByName(ctx context.Context, keyword string, query repository.Query) ([]entity.Province, int, error) {
+	ret := _m.Called(ctx, keyword, query)
 
 	var r0 []entity.Province
-	if rf, ok := ret.Get(0).(func(context.Context, string) []entity.Province); ok {
-		r0 = rf(ctx, keyword)
+	if rf, ok := ret.Get(0).(func(context.Context, string, repository.Query) []entity.Province); ok {
+		r0 = rf(ctx, keyword, query)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]entity.Province)
 		}
 	}
 
+	var r1 int
+	if rf, ok := ret.Get(1).(func(context.Context, string, repository.Query) int); ok {
+		r1 = rf(ctx, keyword, query)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, repository.Query) error); ok {
+		r2 = rf(ctx, keyword, query)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// FindTree provides a mock function with given fields: ctx, id, depth
+func (_m *ProvinceRepository) FindTree(ctx context.Context, id string, depth int) ([]entity.Village, error) {
+	ret := _m.Called(ctx, id, depth)
+
+	var r0 []entity.Village
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) []entity.Village); ok {
+		r0 = rf(ctx, id, depth)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Village)
+		}
+	}
+
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, keyword)
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, id, depth)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -7,6 +7,8 @@ import (
 
 	entity "github.com/erikrios/ponorogo-regency-api/entity"
 	mock "github.com/stretchr/testify/mock"
+
+	repository "github.com/erikrios/ponorogo-regency-api/repository"
 )
 
 // RegencyRepository is an autogenerated mock type for the RegencyRepository type
@@ -14,27 +16,34 @@ type RegencyRepository struct {
 	mock.Mock
 }
 
-// FindAll provides a mock function with given fields: ctx
-func (_m *RegencyRepository) FindAll(ctx context.Context) ([]entity.Regency, error) {
-	ret := _m.Called(ctx)
+// FindAll provides a mock function with given fields: ctx, query
+func (_m *RegencyRepository) FindAll(ctx context.Context, query repository.Query) ([]entity.Regency, int, error) {
+	ret := _m.Called(ctx, query)
 
 	var r0 []entity.Regency
-	if rf, ok := ret.Get(0).(func(context.Context) []entity.Regency); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.Query) []entity.Regency); ok {
+		r0 = rf(ctx, query)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]entity.Regency)
 		}
 	}
 
-	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	var r1 int
+	if rf, ok := ret.Get(1).(func(context.Context, repository.Query) int); ok {
+		r1 = rf(ctx, query)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(int)
 	}
 
-	return r0, r1
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, repository.Query) error); ok {
+		r2 = rf(ctx, query)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
 // FindByID provides a mock function with given fields: ctx, id
@@ -58,22 +67,82 @@ func (_m *RegencyRepository) FindByID(ctx context.Context, id string) (entity.Re
 	return r0, r1
 }
 
-// FindByName provides a mock function with given fields: ctx, keyword
-func (_m *RegencyRepository) FindByName(ctx context.Context, keyword string) ([]entity.Regency, error) {
-	ret := _m.Called(ctx, keyword)
+// FindByName provides a mock function with given fields: ctx, keyword, query
+func (_m *RegencyRepository) FindByName(ctx context.Context, keyword string, query repository.Query) ([]entity.Regency, int, error) {
+	ret := _m.Called(ctx, keyword, query)
+
+	var r0 []entity.Regency
+	if rf, ok := ret.Get(0).(func(context.Context, string, repository.Query) []entity.Regency); ok {
+		r0 = rf(ctx, keyword, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Regency)
+		}
+	}
+
+	var r1 int
+	if rf, ok := ret.Get(1).(func(context.Context, string, repository.Query) int); ok {
+		r1 = rf(ctx, keyword, query)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, repository.Query) error); ok {
+		r2 = rf(ctx, keyword, query)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// FindByProvinceID provides a mock function with given fields: ctx, provinceID, query
+func (_m *RegencyRepository) FindByProvinceID(ctx context.Context, provinceID string, query repository.Query) ([]entity.Regency, int, error) {
+	ret := _m.Called(ctx, provinceID, query)
 
 	var r0 []entity.Regency
-	if rf, ok := ret.Get(0).(func(context.Context, string) []entity.Regency); ok {
-		r0 = rf(ctx, keyword)
+	if rf, ok := ret.Get(0).(func(context.Context, string, repository.Query) []entity.Regency); ok {
+		r0 = rf(ctx, provinceID, query)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]entity.Regency)
 		}
 	}
 
+	var r1 int
+	if rf, ok := ret.Get(1).(func(context.Context, string, repository.Query) int); ok {
+		r1 = rf(ctx, provinceID, query)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, repository.Query) error); ok {
+		r2 = rf(ctx, provinceID, query)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// FindTree provides a mock function with given fields: ctx, id, depth
+func (_m *RegencyRepository) FindTree(ctx context.Context, id string, depth int) ([]entity.Village, error) {
+	ret := _m.Called(ctx, id, depth)
+
+	var r0 []entity.Village
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) []entity.Village); ok {
+		r0 = rf(ctx, id, depth)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Village)
+		}
+	}
+
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, keyword)
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, id, depth)
 	} else {
 		r1 = ret.Error(1)
 	}
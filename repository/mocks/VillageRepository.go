@@ -7,6 +7,8 @@ import (
 
 	entity "github.com/erikrios/ponorogo-regency-api/entity"
 	mock "github.com/stretchr/testify/mock"
+
+	repository "github.com/erikrios/ponorogo-regency-api/repository"
 )
 
 // VillageRepository is an autogenerated mock type for the VillageRepository type
@@ -14,19 +16,49 @@ type VillageRepository struct {
 	mock.Mock
 }
 
-// FindAll provides a mock function with given fields: ctx
-func (_m *VillageRepository) FindAll(ctx context.Context) ([]entity.Village, error) {
-	ret := _m.Called(ctx)
+// FindAll provides a mock function with given fields: ctx, query
+func (_m *VillageRepository) FindAll(ctx context.Context, query repository.Query) ([]entity.Village, int, error) {
+	ret := _m.Called(ctx, query)
 
 	var r0 []entity.Village
-	if rf, ok := ret.Get(0).(func(context.Context) []entity.Village); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.Query) []entity.Village); ok {
+		r0 = rf(ctx, query)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]entity.Village)
 		}
 	}
 
+	var r1 int
+	if rf, ok := ret.Get(1).(func(context.Context, repository.Query) int); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, repository.Query) error); ok {
+		r2 = rf(ctx, query)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// FindBoundaries provides a mock function with given fields: ctx
+func (_m *VillageRepository) FindBoundaries(ctx context.Context) ([]entity.Boundary, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []entity.Boundary
+	if rf, ok := ret.Get(0).(func(context.Context) []entity.Boundary); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Boundary)
+		}
+	}
+
 	var r1 error
 	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
 		r1 = rf(ctx)
@@ -37,16 +69,16 @@ func (_m *VillageRepository) FindAll(ctx context.Context) ([]entity.Village, err
 	return r0, r1
 }
 
-// FindByDistrictID provides a mock function with given fields: ctx, districtID
-func (_m *VillageRepository) FindByDistrictID(ctx context.Context, districtID string) ([]entity.Village, error) {
+// FindBoundariesByDistrictID provides a mock function with given fields: ctx, districtID
+func (_m *VillageRepository) FindBoundariesByDistrictID(ctx context.Context, districtID string) ([]entity.Boundary, error) {
 	ret := _m.Called(ctx, districtID)
 
-	var r0 []entity.Village
-	if rf, ok := ret.Get(0).(func(context.Context, string) []entity.Village); ok {
+	var r0 []entity.Boundary
+	if rf, ok := ret.Get(0).(func(context.Context, string) []entity.Boundary); ok {
 		r0 = rf(ctx, districtID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]entity.Village)
+			r0 = ret.Get(0).([]entity.Boundary)
 		}
 	}
 
@@ -60,22 +92,20 @@ func (_m *VillageRepository) FindByDistrictID(ctx context.Context, districtID st
 	return r0, r1
 }
 
-// FindByDistrictName provides a mock function with given fields: ctx, keyword
-func (_m *VillageRepository) FindByDistrictName(ctx context.Context, keyword string) ([]entity.Village, error) {
-	ret := _m.Called(ctx, keyword)
+// FindBoundaryByID provides a mock function with given fields: ctx, id
+func (_m *VillageRepository) FindBoundaryByID(ctx context.Context, id string) (entity.Boundary, error) {
+	ret := _m.Called(ctx, id)
 
-	var r0 []entity.Village
-	if rf, ok := ret.Get(0).(func(context.Context, string) []entity.Village); ok {
-		r0 = rf(ctx, keyword)
+	var r0 entity.Boundary
+	if rf, ok := ret.Get(0).(func(context.Context, string) entity.Boundary); ok {
+		r0 = rf(ctx, id)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]entity.Village)
-		}
+		r0 = ret.Get(0).(entity.Boundary)
 	}
 
 	var r1 error
 	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, keyword)
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -83,6 +113,66 @@ func (_m *VillageRepository) FindByDistrictName(ctx context.Context, keyword str
 	return r0, r1
 }
 
+// FindByDistrictID provides a mock function with given fields: ctx, districtID, query
+func (_m *VillageRepository) FindByDistrictID(ctx context.Context, districtID string, query repository.Query) ([]entity.Village, int, error) {
+	ret := _m.Called(ctx, districtID, query)
+
+	var r0 []entity.Village
+	if rf, ok := ret.Get(0).(func(context.Context, string, repository.Query) []entity.Village); ok {
+		r0 = rf(ctx, districtID, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Village)
+		}
+	}
+
+	var r1 int
+	if rf, ok := ret.Get(1).(func(context.Context, string, repository.Query) int); ok {
+		r1 = rf(ctx, districtID, query)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, repository.Query) error); ok {
+		r2 = rf(ctx, districtID, query)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// FindByDistrictName provides a mock function with given fields: ctx, keyword, query
+func (_m *VillageRepository) FindByDistrictName(ctx context.Context, keyword string, query repository.Query) ([]entity.Village, int, error) {
+	ret := _m.Called(ctx, keyword, query)
+
+	var r0 []entity.Village
+	if rf, ok := ret.Get(0).(func(context.Context, string, repository.Query) []entity.Village); ok {
+		r0 = rf(ctx, keyword, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Village)
+		}
+	}
+
+	var r1 int
+	if rf, ok := ret.Get(1).(func(context.Context, string, repository.Query) int); ok {
+		r1 = rf(ctx, keyword, query)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, repository.Query) error); ok {
+		r2 = rf(ctx, keyword, query)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // FindByID provides a mock function with given fields: ctx, id
 func (_m *VillageRepository) FindByID(ctx context.Context, id string) (entity.Village, error) {
 	ret := _m.Called(ctx, id)
@@ -104,13 +194,66 @@ func (_m *VillageRepository) FindByID(ctx context.Context, id string) (entity.Vi
 	return r0, r1
 }
 
-// FindByName provides a mock function with given fields: ctx, keyword
-func (_m *VillageRepository) FindByName(ctx context.Context, keyword string) ([]entity.Village, error) {
-	ret := _m.Called(ctx, keyword)
+// FindByIDs provides a mock function with given fields: ctx, ids
+func (_m *VillageRepository) FindByIDs(ctx context.Context, ids []string) ([]entity.Village, error) {
+	ret := _m.Called(ctx, ids)
+
+	var r0 []entity.Village
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []entity.Village); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Village)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByName provides a mock function with given fields: ctx, keyword, query
+func (_m *VillageRepository) FindByName(ctx context.Context, keyword string, query repository.Query) ([]entity.Village, int, error) {
+	ret := _m.Called(ctx, keyword, query)
+
+	var r0 []entity.Village
+	if rf, ok := ret.Get(0).(func(context.Context, string, repository.Query) []entity.Village); ok {
+		r0 = rf(ctx, keyword, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Village)
+		}
+	}
+
+	var r1 int
+	if rf, ok := ret.Get(1).(func(context.Context, string, repository.Query) int); ok {
+		r1 = rf(ctx, keyword, query)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, repository.Query) error); ok {
+		r2 = rf(ctx, keyword, query)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// FindByPostalCode provides a mock function with given fields: ctx, code
+func (_m *VillageRepository) FindByPostalCode(ctx context.Context, code string) ([]entity.Village, error) {
+	ret := _m.Called(ctx, code)
 
 	var r0 []entity.Village
 	if rf, ok := ret.Get(0).(func(context.Context, string) []entity.Village); ok {
-		r0 = rf(ctx, keyword)
+		r0 = rf(ctx, code)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]entity.Village)
@@ -119,10 +262,47 @@ func (_m *VillageRepository) FindByName(ctx context.Context, keyword string) ([]
 
 	var r1 error
 	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, keyword)
+		r1 = rf(ctx, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindLocated provides a mock function with given fields: ctx
+func (_m *VillageRepository) FindLocated(ctx context.Context) ([]entity.Village, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []entity.Village
+	if rf, ok := ret.Get(0).(func(context.Context) []entity.Village); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Village)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
 
 	return r0, r1
 }
+
+// Stream provides a mock function with given fields: ctx, districtID, districtKeyword, fn
+func (_m *VillageRepository) Stream(ctx context.Context, districtID string, districtKeyword string, fn func(entity.Village) error) error {
+	ret := _m.Called(ctx, districtID, districtKeyword, fn)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, func(entity.Village) error) error); ok {
+		r0 = rf(ctx, districtID, districtKeyword, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
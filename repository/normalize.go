@@ -0,0 +1,12 @@
+package repository
+
+import "fmt"
+
+// nameContains repeats normalize.Name in SQL, so the keyword bound to placeholder must already be normalized.
+func nameContains(column string, placeholder string) string {
+	return fmt.Sprintf(
+		"regexp_replace(replace(replace(replace(lower(%s), 'oe', 'u'), 'dj', 'j'), 'tj', 'c'), '[^[:alnum:]]', '', 'g') LIKE '%%' || %s || '%%'",
+		column,
+		placeholder,
+	)
+}
@@ -7,7 +7,8 @@ import (
 )
 
 type ProvinceRepository interface {
-	FindAll(ctx context.Context) (provinces []entity.Province, err error)
+	FindAll(ctx context.Context, query Query) (provinces []entity.Province, total int, err error)
 	FindByID(ctx context.Context, id string) (province entity.Province, err error)
-	FindByName(ctx context.Context, keyword string) (provinces []entity.Province, err error)
+	FindByName(ctx context.Context, keyword string, query Query) (provinces []entity.Province, total int, err error)
+	FindTree(ctx context.Context, id string, depth int) (rows []entity.Village, err error)
 }
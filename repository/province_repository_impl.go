@@ -6,44 +6,32 @@ import (
 	"log"
 
 	"github.com/erikrios/ponorogo-regency-api/entity"
+	"github.com/erikrios/ponorogo-regency-api/normalize"
 )
 
 type provinceRepositoryImpl struct {
 	db *sql.DB
 }
 
+var provinceListSpec = listSpec{
+	table:   "provinces p",
+	columns: "p.id, p.name",
+	id:      "p.id",
+	filters: map[string]filter{
+		"name": {nameColumn: "p.name"},
+	},
+	sorts: map[string]string{
+		"id":   "p.id",
+		"name": "p.name",
+	},
+}
+
 func NewProvinceRepositoryImpl(db *sql.DB) *provinceRepositoryImpl {
 	return &provinceRepositoryImpl{db: db}
 }
 
-func (p *provinceRepositoryImpl) FindAll(ctx context.Context) (provinces []entity.Province, err error) {
-	statement := "SELECT p.id, p.name FROM provinces p;"
-
-	rows, err := p.db.QueryContext(ctx, statement)
-	if err != nil {
-		log.Println(err)
-		err = ErrDatabase
-		return
-	}
-
-	defer func(rows *sql.Rows) {
-		if err = rows.Close(); err != nil {
-			log.Println(err.Error())
-		}
-	}(rows)
-
-	provinces = make([]entity.Province, 0)
-	for rows.Next() {
-		var province entity.Province
-		if err = rows.Scan(&province.ID, &province.Name); err != nil {
-			log.Println(err)
-			err = ErrDatabase
-			return
-		}
-		provinces = append(provinces, province)
-	}
-
-	return
+func (p *provinceRepositoryImpl) FindAll(ctx context.Context, query Query) (provinces []entity.Province, total int, err error) {
+	return p.list(ctx, "", 0, query)
 }
 
 func (p *provinceRepositoryImpl) FindByID(ctx context.Context, id string) (province entity.Province, err error) {
@@ -64,10 +52,25 @@ func (p *provinceRepositoryImpl) FindByID(ctx context.Context, id string) (provi
 	}
 }
 
-func (p *provinceRepositoryImpl) FindByName(ctx context.Context, keyword string) (provinces []entity.Province, err error) {
-	statement := "SELECT p.id, p.name FROM provinces p WHERE p.name ILIKE '%' || $1 || '%';"
+func (p *provinceRepositoryImpl) FindByName(ctx context.Context, keyword string, query Query) (provinces []entity.Province, total int, err error) {
+	return p.list(ctx, nameContains("p.name", "$1"), 0, query, normalize.Name(keyword))
+}
+
+func (p *provinceRepositoryImpl) FindTree(ctx context.Context, id string, depth int) (rows []entity.Village, err error) {
+	return findTree(ctx, p.db, "provinces p", 0, id, depth)
+}
+
+func (p *provinceRepositoryImpl) list(ctx context.Context, condition string, joins int, query Query, args ...any) (provinces []entity.Province, total int, err error) {
+	statements, err := query.build(provinceListSpec, condition, joins, args...)
+	if err != nil {
+		return
+	}
+
+	if total, err = count(ctx, p.db, statements); err != nil {
+		return
+	}
 
-	rows, err := p.db.QueryContext(ctx, statement, keyword)
+	rows, err := p.db.QueryContext(ctx, statements.query, statements.queryArgs...)
 	if err != nil {
 		log.Println(err)
 		err = ErrDatabase
@@ -8,6 +8,7 @@ import (
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/erikrios/ponorogo-regency-api/entity"
+	"github.com/erikrios/ponorogo-regency-api/normalize"
 	"github.com/joho/godotenv"
 	"github.com/stretchr/testify/assert"
 )
@@ -40,11 +41,12 @@ func TestProvinceRepositoryImpl(t *testing.T) {
 		}
 
 		t.Run("it should return valid provinces, when database successfully return the data", func(t *testing.T) {
-			mock.ExpectQuery(".*").WillReturnRows(returnedRows)
+			mock.ExpectQuery("SELECT COUNT").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(len(expectedProvinces)))
+			mock.ExpectQuery("ORDER BY").WillReturnRows(returnedRows)
 
 			var repo ProvinceRepository = NewProvinceRepositoryImpl(db)
 
-			got, err := repo.FindAll(context.Background())
+			got, total, err := repo.FindAll(context.Background(), Query{})
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -54,6 +56,7 @@ func TestProvinceRepositoryImpl(t *testing.T) {
 			}
 
 			assert.ElementsMatch(t, expectedProvinces, got)
+			assert.Equal(t, len(expectedProvinces), total)
 		})
 
 		t.Run("it should return error, when database return an error", func(t *testing.T) {
@@ -61,7 +64,7 @@ func TestProvinceRepositoryImpl(t *testing.T) {
 
 			var repo ProvinceRepository = NewProvinceRepositoryImpl(db)
 
-			if _, err := repo.FindAll(context.Background()); assert.Error(t, err) {
+			if _, _, err := repo.FindAll(context.Background(), Query{}); assert.Error(t, err) {
 				assert.Equal(t, ErrDatabase, err)
 			}
 
@@ -152,11 +155,12 @@ func TestProvinceRepositoryImpl(t *testing.T) {
 			returnedRows.AddRow(province.ID, province.Name)
 		}
 		t.Run("it should return valid provinces, when database successfully return the data", func(t *testing.T) {
-			mock.ExpectQuery(".*").WithArgs(expectedProvinces[0].Name).WillReturnRows(returnedRows)
+			mock.ExpectQuery("SELECT COUNT").WithArgs(normalize.Name(expectedProvinces[0].Name)).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(len(expectedProvinces)))
+			mock.ExpectQuery("ORDER BY").WithArgs(normalize.Name(expectedProvinces[0].Name)).WillReturnRows(returnedRows)
 
 			var repo ProvinceRepository = NewProvinceRepositoryImpl(db)
 
-			got, err := repo.FindByName(context.Background(), expectedProvinces[0].Name)
+			got, total, err := repo.FindByName(context.Background(), expectedProvinces[0].Name, Query{})
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -166,14 +170,113 @@ func TestProvinceRepositoryImpl(t *testing.T) {
 			}
 
 			assert.ElementsMatch(t, expectedProvinces, got)
+			assert.Equal(t, len(expectedProvinces), total)
 		})
 
 		t.Run("it should return error, when database return an error", func(t *testing.T) {
-			mock.ExpectQuery(".*").WithArgs(expectedProvinces[0].Name).WillReturnError(ErrDatabase)
+			mock.ExpectQuery(".*").WithArgs(normalize.Name(expectedProvinces[0].Name)).WillReturnError(ErrDatabase)
 
 			var repo ProvinceRepository = NewProvinceRepositoryImpl(db)
 
-			if _, err := repo.FindByName(context.Background(), expectedProvinces[0].Name); assert.Error(t, err) {
+			if _, _, err := repo.FindByName(context.Background(), expectedProvinces[0].Name, Query{}); assert.Error(t, err) {
+				assert.Equal(t, ErrDatabase, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+		})
+	})
+
+	t.Run("TestFindTree", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer db.Close()
+
+		expectedRows := []entity.Village{
+			{
+				ID:   "3502010001",
+				Name: "BAOSANKIDUL",
+				District: entity.District{
+					ID:   "3502010",
+					Name: "NGRAYUN",
+					Regency: entity.Regency{
+						ID:       "3502",
+						Name:     "KABUPATEN PONOROGO",
+						Province: entity.Province{ID: "35", Name: "JAWA TIMUR"},
+					},
+				},
+			},
+		}
+
+		t.Run("it should read the whole tree in one query, when database successfully return the data", func(t *testing.T) {
+			returnedRows := sqlmock.NewRows([]string{"id", "name", "regency_id", "regency_name", "district_id", "district_name", "village_id", "village_name"})
+			for _, row := range expectedRows {
+				returnedRows.AddRow(row.District.Regency.Province.ID, row.District.Regency.Province.Name, row.District.Regency.ID, row.District.Regency.Name, row.District.ID, row.District.Name, row.ID, row.Name)
+			}
+
+			mock.ExpectQuery("FROM provinces p LEFT JOIN regencies r on r.province_id = p.id LEFT JOIN districts d on d.regency_id = r.id LEFT JOIN villages v on v.district_id = d.id WHERE p.id = \\$1 ORDER BY r.id, d.id, v.id;").
+				WithArgs("35").
+				WillReturnRows(returnedRows)
+
+			var repo ProvinceRepository = NewProvinceRepositoryImpl(db)
+
+			got, err := repo.FindTree(context.Background(), "35", 3)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, expectedRows, got)
+		})
+
+		t.Run("it should only join the levels within depth, when given lower depth", func(t *testing.T) {
+			returnedRows := sqlmock.NewRows([]string{"id", "name", "regency_id", "regency_name"})
+			returnedRows.AddRow("35", "JAWA TIMUR", "3502", "KABUPATEN PONOROGO")
+
+			mock.ExpectQuery("SELECT p.id, p.name, COALESCE\\(r.id, ''\\), COALESCE\\(r.name, ''\\) FROM provinces p LEFT JOIN regencies r on r.province_id = p.id WHERE p.id = \\$1 ORDER BY r.id;").
+				WithArgs("35").
+				WillReturnRows(returnedRows)
+
+			var repo ProvinceRepository = NewProvinceRepositoryImpl(db)
+
+			got, err := repo.FindTree(context.Background(), "35", 1)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, []entity.Village{{District: entity.District{Regency: expectedRows[0].District.Regency}}}, got)
+		})
+
+		t.Run("it should return not found error, when given id not found in the database", func(t *testing.T) {
+			mock.ExpectQuery(".*").WithArgs("36").WillReturnRows(sqlmock.NewRows([]string{"id", "name"}))
+
+			var repo ProvinceRepository = NewProvinceRepositoryImpl(db)
+
+			if _, err := repo.FindTree(context.Background(), "36", 0); assert.Error(t, err) {
+				assert.Equal(t, ErrQueryNotFound, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+		})
+
+		t.Run("it should return error, when database return an error", func(t *testing.T) {
+			mock.ExpectQuery(".*").WithArgs("35").WillReturnError(ErrDatabase)
+
+			var repo ProvinceRepository = NewProvinceRepositoryImpl(db)
+
+			if _, err := repo.FindTree(context.Background(), "35", 3); assert.Error(t, err) {
 				assert.Equal(t, ErrDatabase, err)
 			}
 
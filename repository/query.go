@@ -0,0 +1,252 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/erikrios/ponorogo-regency-api/normalize"
+)
+
+type Query struct {
+	Limit  int
+	Offset int
+	// After is the ID of the row the list starts after, for keyset pagination.
+	After   string
+	Sort    string
+	Filters map[string]string
+	// Expand lists the parents read along each row: nil reads all of them, empty none.
+	Expand []string
+}
+
+type QueryError struct {
+	Reason string
+}
+
+func (e *QueryError) Error() string {
+	return fmt.Sprintf("repository: invalid query: %s", e.Reason)
+}
+
+type listSpec struct {
+	table   string
+	columns string
+	id      string
+	parents []parent
+	filters map[string]filter
+	sorts   map[string]string
+}
+
+type parent struct {
+	name    string
+	join    string
+	columns string
+}
+
+type filter struct {
+	// condition is formatted with the placeholder of the value, unless nameColumn is set.
+	condition  string
+	nameColumn string
+	joins      int
+}
+
+type listStatements struct {
+	count        string
+	countArgs    []any
+	query        string
+	queryArgs    []any
+	parents      int
+	checksParent bool
+}
+
+func (s listSpec) from(joins int) string {
+	from := s.table
+	for _, parent := range s.parents[:joins] {
+		from += " " + parent.join
+	}
+	return from
+}
+
+func (s listSpec) selection(parents int) string {
+	selection := "SELECT " + s.columns
+	for _, parent := range s.parents[:parents] {
+		selection += ", " + parent.columns
+	}
+	return selection
+}
+
+// childrenOf makes count also read whether the parent whose ID is the first arg exists, telling an
+// unknown parent from one without children.
+func (s listStatements) childrenOf(parent string) listStatements {
+	s.count = fmt.Sprintf("SELECT EXISTS (SELECT 1 FROM %s WHERE id = $1), (%s);", parent, strings.TrimSuffix(s.count, ";"))
+	s.checksParent = true
+	return s
+}
+
+func (q Query) build(spec listSpec, condition string, joins int, args ...any) (statements listStatements, err error) {
+	if statements.parents, err = q.expansion(spec); err != nil {
+		return
+	}
+
+	where, filterJoins, args, err := q.where(spec, condition, args...)
+	if err != nil {
+		return
+	}
+
+	if filterJoins > joins {
+		joins = filterJoins
+	}
+	if statements.parents > joins {
+		joins = statements.parents
+	}
+
+	from := spec.from(joins)
+
+	statements.count = "SELECT COUNT(*) FROM " + from + where + ";"
+	statements.countArgs = args
+	statements.query, statements.queryArgs, err = q.paginate(spec.selection(statements.parents)+" FROM "+from+where, spec, args...)
+	return
+}
+
+func (q Query) expansion(spec listSpec) (int, error) {
+	if q.Expand == nil {
+		return len(spec.parents), nil
+	}
+
+	parents := 0
+
+	for _, name := range q.Expand {
+		found := false
+		for i, parent := range spec.parents {
+			if parent.name == name {
+				found = true
+				if i+1 > parents {
+					parents = i + 1
+				}
+			}
+		}
+
+		if !found {
+			return 0, &QueryError{Reason: fmt.Sprintf("unknown expand field %q", name)}
+		}
+	}
+
+	return parents, nil
+}
+
+func (q Query) where(spec listSpec, condition string, args ...any) (string, int, []any, error) {
+	var conditions []string
+	if condition != "" {
+		conditions = append(conditions, condition)
+	}
+
+	fields := make([]string, 0, len(q.Filters))
+	for field := range q.Filters {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	joins := 0
+
+	for _, field := range fields {
+		filter, ok := spec.filters[field]
+		if !ok {
+			return "", 0, nil, &QueryError{Reason: fmt.Sprintf("unknown filter field %q", field)}
+		}
+
+		if filter.joins > joins {
+			joins = filter.joins
+		}
+
+		if filter.nameColumn != "" {
+			args = append(args, normalize.Name(q.Filters[field]))
+			conditions = append(conditions, nameContains(filter.nameColumn, fmt.Sprintf("$%d", len(args))))
+			continue
+		}
+
+		args = append(args, q.Filters[field])
+		conditions = append(conditions, fmt.Sprintf(filter.condition, fmt.Sprintf("$%d", len(args))))
+	}
+
+	if len(conditions) == 0 {
+		return "", joins, args, nil
+	}
+
+	return " WHERE " + strings.Join(conditions, " AND "), joins, args, nil
+}
+
+func (q Query) paginate(statement string, spec listSpec, args ...any) (string, []any, error) {
+	field := strings.TrimPrefix(q.Sort, "-")
+	descending := field != q.Sort
+
+	column := spec.id
+	if field != "" {
+		var ok bool
+		if column, ok = spec.sorts[field]; !ok {
+			return "", nil, &QueryError{Reason: fmt.Sprintf("unknown sort field %q", field)}
+		}
+	}
+
+	direction, comparison := "", ">"
+	if descending {
+		direction, comparison = " DESC", "<"
+	}
+
+	// Keyset pagination: compares the sort column, then the ID, to the ones of the After row.
+	if q.After != "" {
+		args = append(args, q.After)
+		if strings.Contains(statement, " WHERE ") {
+			statement += " AND "
+		} else {
+			statement += " WHERE "
+		}
+
+		if column == spec.id {
+			statement += fmt.Sprintf("%s %s $%d", spec.id, comparison, len(args))
+		} else {
+			statement += fmt.Sprintf(
+				"(%s, %s) %s (SELECT %s, %s FROM %s WHERE %s = $%d)",
+				column, spec.id, comparison, column, spec.id, spec.table, spec.id, len(args),
+			)
+		}
+	}
+
+	if column == spec.id {
+		statement += fmt.Sprintf(" ORDER BY %s%s", spec.id, direction)
+	} else {
+		statement += fmt.Sprintf(" ORDER BY %s%s, %s%s", column, direction, spec.id, direction)
+	}
+
+	if q.Limit > 0 {
+		args = append(args, q.Limit)
+		statement += fmt.Sprintf(" LIMIT $%d", len(args))
+	}
+
+	if q.Offset > 0 {
+		args = append(args, q.Offset)
+		statement += fmt.Sprintf(" OFFSET $%d", len(args))
+	}
+
+	return statement + ";", args, nil
+}
+
+func count(ctx context.Context, db *sql.DB, statements listStatements) (total int, err error) {
+	found := true
+	dests := []any{&total}
+	if statements.checksParent {
+		dests = []any{&found, &total}
+	}
+
+	if scanErr := db.QueryRowContext(ctx, statements.count, statements.countArgs...).Scan(dests...); scanErr != nil {
+		log.Println(scanErr)
+		err = ErrDatabase
+		return
+	}
+
+	if !found {
+		err = ErrQueryNotFound
+	}
+	return
+}
@@ -0,0 +1,194 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuery(t *testing.T) {
+	spec := listSpec{
+		table:   "villages v",
+		columns: "v.id, v.name, v.district_id",
+		id:      "v.id",
+		parents: []parent{
+			{name: "district", join: "INNER JOIN districts d on d.id = v.district_id", columns: "d.name AS district_name, d.regency_id"},
+			{name: "regency", join: "INNER JOIN regencies r on d.regency_id = r.id", columns: "r.name AS regency_name"},
+		},
+		filters: map[string]filter{
+			"name":        {nameColumn: "v.name"},
+			"district_id": {condition: "v.district_id = %s"},
+			"regency_id":  {condition: "d.regency_id = %s", joins: 1},
+		},
+		sorts: map[string]string{
+			"id":   "v.id",
+			"name": "v.name",
+		},
+	}
+
+	t.Run("TestBuild", func(t *testing.T) {
+		t.Run("it should read and join every parent, when given zero query", func(t *testing.T) {
+			got, err := Query{}.build(spec, "", 0)
+
+			assert.NoError(t, err)
+			assert.Equal(t, listStatements{
+				count:   "SELECT COUNT(*) FROM villages v INNER JOIN districts d on d.id = v.district_id INNER JOIN regencies r on d.regency_id = r.id;",
+				query:   "SELECT v.id, v.name, v.district_id, d.name AS district_name, d.regency_id, r.name AS regency_name FROM villages v INNER JOIN districts d on d.id = v.district_id INNER JOIN regencies r on d.regency_id = r.id ORDER BY v.id;",
+				parents: 2,
+			}, got)
+		})
+
+		t.Run("it should skip every join, when given empty expand", func(t *testing.T) {
+			got, err := Query{Expand: []string{}, Limit: 10}.build(spec, "v.district_id = $1", 0, "3502010")
+
+			assert.NoError(t, err)
+			assert.Equal(t, listStatements{
+				count:     "SELECT COUNT(*) FROM villages v WHERE v.district_id = $1;",
+				countArgs: []any{"3502010"},
+				query:     "SELECT v.id, v.name, v.district_id FROM villages v WHERE v.district_id = $1 ORDER BY v.id LIMIT $2;",
+				queryArgs: []any{"3502010", 10},
+			}, got)
+		})
+
+		t.Run("it should join without reading the parents needed by the filters, when they aren't expanded", func(t *testing.T) {
+			query := Query{Expand: []string{}, Filters: map[string]string{"regency_id": "3502"}}
+			got, err := query.build(spec, "", 0)
+
+			assert.NoError(t, err)
+			assert.Equal(t, "SELECT v.id, v.name, v.district_id FROM villages v INNER JOIN districts d on d.id = v.district_id WHERE d.regency_id = $1 ORDER BY v.id;", got.query)
+			assert.Zero(t, got.parents)
+		})
+
+		t.Run("it should read the parents up to the farthest expanded one, when given expand", func(t *testing.T) {
+			got, err := Query{Expand: []string{"district"}}.build(spec, "", 0)
+
+			assert.NoError(t, err)
+			assert.Equal(t, "SELECT v.id, v.name, v.district_id, d.name AS district_name, d.regency_id FROM villages v INNER JOIN districts d on d.id = v.district_id ORDER BY v.id;", got.query)
+			assert.Equal(t, 1, got.parents)
+		})
+
+		t.Run("it should return QueryError, when given unknown expand field", func(t *testing.T) {
+			_, err := Query{Expand: []string{"province"}}.build(spec, "", 0)
+
+			var queryErr *QueryError
+			if assert.ErrorAs(t, err, &queryErr) {
+				assert.Equal(t, `unknown expand field "province"`, queryErr.Reason)
+			}
+		})
+	})
+
+	t.Run("TestChildrenOf", func(t *testing.T) {
+		t.Run("it should read whether the parent exists along the count, when given parent table", func(t *testing.T) {
+			statements, err := Query{}.build(spec, "v.district_id = $1", 0, "3502010")
+			assert.NoError(t, err)
+
+			got := statements.childrenOf("districts")
+
+			assert.Equal(t, "SELECT EXISTS (SELECT 1 FROM districts WHERE id = $1), (SELECT COUNT(*) FROM villages v INNER JOIN districts d on d.id = v.district_id INNER JOIN regencies r on d.regency_id = r.id WHERE v.district_id = $1);", got.count)
+			assert.Equal(t, []any{"3502010"}, got.countArgs)
+			assert.True(t, got.checksParent)
+			assert.Equal(t, statements.query, got.query)
+		})
+	})
+
+	t.Run("TestWhere", func(t *testing.T) {
+		t.Run("it should return empty clause, when given no condition and no filter", func(t *testing.T) {
+			gotClause, gotJoins, gotArgs, err := Query{}.where(spec, "")
+
+			assert.NoError(t, err)
+			assert.Empty(t, gotClause)
+			assert.Zero(t, gotJoins)
+			assert.Empty(t, gotArgs)
+		})
+
+		t.Run("it should combine condition and filters sorted by field, when given both", func(t *testing.T) {
+			query := Query{Filters: map[string]string{"regency_id": "3502", "name": "WONO"}}
+			gotClause, gotJoins, gotArgs, err := query.where(spec, "d.name ILIKE '%' || $1 || '%'", "NGRA")
+
+			assert.NoError(t, err)
+			assert.Equal(t, " WHERE d.name ILIKE '%' || $1 || '%' AND "+nameContains("v.name", "$2")+" AND d.regency_id = $3", gotClause)
+			assert.Equal(t, 1, gotJoins)
+			assert.Equal(t, []any{"NGRA", "wono", "3502"}, gotArgs)
+		})
+
+		t.Run("it should bind the normalized name, when given a name filter written differently", func(t *testing.T) {
+			query := Query{Filters: map[string]string{"name": "Soekoredjo"}}
+			gotClause, _, gotArgs, err := query.where(spec, "")
+
+			assert.NoError(t, err)
+			assert.Equal(t, " WHERE "+nameContains("v.name", "$1"), gotClause)
+			assert.Equal(t, []any{"sukorejo"}, gotArgs)
+		})
+
+		t.Run("it should return QueryError, when given unknown filter field", func(t *testing.T) {
+			query := Query{Filters: map[string]string{"population": "1000"}}
+			_, _, _, err := query.where(spec, "")
+
+			var queryErr *QueryError
+			if assert.ErrorAs(t, err, &queryErr) {
+				assert.Equal(t, `unknown filter field "population"`, queryErr.Reason)
+			}
+		})
+	})
+
+	t.Run("TestPaginate", func(t *testing.T) {
+		const statement = "SELECT v.id, v.name FROM villages v"
+
+		t.Run("it should only order the rows, when given zero query", func(t *testing.T) {
+			gotStatement, gotArgs, err := Query{}.paginate(statement, spec)
+
+			assert.NoError(t, err)
+			assert.Equal(t, "SELECT v.id, v.name FROM villages v ORDER BY v.id;", gotStatement)
+			assert.Empty(t, gotArgs)
+		})
+
+		t.Run("it should append limit and offset, when given page query", func(t *testing.T) {
+			gotStatement, gotArgs, err := Query{Limit: 10, Offset: 20}.paginate(statement, spec)
+
+			assert.NoError(t, err)
+			assert.Equal(t, "SELECT v.id, v.name FROM villages v ORDER BY v.id LIMIT $1 OFFSET $2;", gotStatement)
+			assert.Equal(t, []any{10, 20}, gotArgs)
+		})
+
+		t.Run("it should append keyset condition after existing args, when given after query", func(t *testing.T) {
+			gotStatement, gotArgs, err := Query{Limit: 10, After: "3502010001"}.paginate(statement+" WHERE v.district_id = $1", spec, "3502010")
+
+			assert.NoError(t, err)
+			assert.Equal(t, "SELECT v.id, v.name FROM villages v WHERE v.district_id = $1 AND v.id > $2 ORDER BY v.id LIMIT $3;", gotStatement)
+			assert.Equal(t, []any{"3502010", "3502010001", 10}, gotArgs)
+		})
+
+		t.Run("it should add where clause, when given after query on statement without condition", func(t *testing.T) {
+			gotStatement, gotArgs, err := Query{After: "3502010001"}.paginate(statement, spec)
+
+			assert.NoError(t, err)
+			assert.Equal(t, "SELECT v.id, v.name FROM villages v WHERE v.id > $1 ORDER BY v.id;", gotStatement)
+			assert.Equal(t, []any{"3502010001"}, gotArgs)
+		})
+
+		t.Run("it should order by the sort column with id as tiebreaker, when given descending sort", func(t *testing.T) {
+			gotStatement, gotArgs, err := Query{Sort: "-name", Limit: 10}.paginate(statement, spec)
+
+			assert.NoError(t, err)
+			assert.Equal(t, "SELECT v.id, v.name FROM villages v ORDER BY v.name DESC, v.id DESC LIMIT $1;", gotStatement)
+			assert.Equal(t, []any{10}, gotArgs)
+		})
+
+		t.Run("it should compare against the after row, when given sort and after query", func(t *testing.T) {
+			gotStatement, gotArgs, err := Query{Sort: "name", After: "3502010001"}.paginate(statement, spec)
+
+			assert.NoError(t, err)
+			assert.Equal(t, "SELECT v.id, v.name FROM villages v WHERE (v.name, v.id) > (SELECT v.name, v.id FROM villages v WHERE v.id = $1) ORDER BY v.name, v.id;", gotStatement)
+			assert.Equal(t, []any{"3502010001"}, gotArgs)
+		})
+
+		t.Run("it should return QueryError, when given unknown sort field", func(t *testing.T) {
+			_, _, err := Query{Sort: "-population"}.paginate(statement, spec)
+
+			var queryErr *QueryError
+			if assert.ErrorAs(t, err, &queryErr) {
+				assert.Equal(t, `unknown sort field "population"`, queryErr.Reason)
+			}
+		})
+	})
+}
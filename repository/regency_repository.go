@@ -7,7 +7,9 @@ import (
 )
 
 type RegencyRepository interface {
-	FindAll(ctx context.Context) (regencies []entity.Regency, err error)
+	FindAll(ctx context.Context, query Query) (regencies []entity.Regency, total int, err error)
 	FindByID(ctx context.Context, id string) (regency entity.Regency, err error)
-	FindByName(ctx context.Context, keyword string) (regencies []entity.Regency, err error)
+	FindByName(ctx context.Context, keyword string, query Query) (regencies []entity.Regency, total int, err error)
+	FindByProvinceID(ctx context.Context, provinceID string, query Query) (regencies []entity.Regency, total int, err error)
+	FindTree(ctx context.Context, id string, depth int) (rows []entity.Village, err error)
 }
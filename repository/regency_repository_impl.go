@@ -6,44 +6,36 @@ import (
 	"log"
 
 	"github.com/erikrios/ponorogo-regency-api/entity"
+	"github.com/erikrios/ponorogo-regency-api/normalize"
 )
 
 type regencyRepositoryImpl struct {
 	db *sql.DB
 }
 
+var regencyListSpec = listSpec{
+	table:   "regencies r",
+	columns: "r.id, r.name, r.province_id",
+	id:      "r.id",
+	parents: []parent{
+		{name: "province", join: "INNER JOIN provinces p on r.province_id = p.id", columns: "p.name AS province_name"},
+	},
+	filters: map[string]filter{
+		"name":        {nameColumn: "r.name"},
+		"province_id": {condition: "r.province_id = %s"},
+	},
+	sorts: map[string]string{
+		"id":   "r.id",
+		"name": "r.name",
+	},
+}
+
 func NewRegencyRepositoryImpl(db *sql.DB) *regencyRepositoryImpl {
 	return &regencyRepositoryImpl{db: db}
 }
 
-func (r *regencyRepositoryImpl) FindAll(ctx context.Context) (regencies []entity.Regency, err error) {
-	statement := "SELECT r.id, r.name, r.province_id, p.name AS province_name FROM regencies r INNER JOIN provinces p on r.province_id = p.id;"
-
-	rows, err := r.db.QueryContext(ctx, statement)
-	if err != nil {
-		log.Println(err)
-		err = ErrDatabase
-		return
-	}
-
-	defer func(rows *sql.Rows) {
-		if err = rows.Close(); err != nil {
-			log.Println(err.Error())
-		}
-	}(rows)
-
-	regencies = make([]entity.Regency, 0)
-	for rows.Next() {
-		var regency entity.Regency
-		if err = rows.Scan(&regency.ID, &regency.Name, &regency.Province.ID, &regency.Province.Name); err != nil {
-			log.Println(err)
-			err = ErrDatabase
-			return
-		}
-		regencies = append(regencies, regency)
-	}
-
-	return
+func (r *regencyRepositoryImpl) FindAll(ctx context.Context, query Query) (regencies []entity.Regency, total int, err error) {
+	return r.list(ctx, "", "", 0, query)
 }
 
 func (r *regencyRepositoryImpl) FindByID(ctx context.Context, id string) (regency entity.Regency, err error) {
@@ -64,10 +56,33 @@ func (r *regencyRepositoryImpl) FindByID(ctx context.Context, id string) (regenc
 	}
 }
 
-func (r *regencyRepositoryImpl) FindByName(ctx context.Context, keyword string) (regencies []entity.Regency, err error) {
-	statement := "SELECT r.id, r.name, r.province_id, p.name AS province_name FROM regencies r INNER JOIN provinces p on r.province_id = p.id WHERE r.name ILIKE '%' || $1 || '%';"
+func (r *regencyRepositoryImpl) FindByName(ctx context.Context, keyword string, query Query) (regencies []entity.Regency, total int, err error) {
+	return r.list(ctx, "", nameContains("r.name", "$1"), 0, query, normalize.Name(keyword))
+}
 
-	rows, err := r.db.QueryContext(ctx, statement, keyword)
+func (r *regencyRepositoryImpl) FindByProvinceID(ctx context.Context, provinceID string, query Query) (regencies []entity.Regency, total int, err error) {
+	return r.list(ctx, "provinces", "r.province_id = $1", 0, query, provinceID)
+}
+
+func (r *regencyRepositoryImpl) FindTree(ctx context.Context, id string, depth int) (rows []entity.Village, err error) {
+	return findTree(ctx, r.db, "regencies r INNER JOIN provinces p on r.province_id = p.id", 1, id, depth)
+}
+
+func (r *regencyRepositoryImpl) list(ctx context.Context, parent, condition string, joins int, query Query, args ...any) (regencies []entity.Regency, total int, err error) {
+	statements, err := query.build(regencyListSpec, condition, joins, args...)
+	if err != nil {
+		return
+	}
+
+	if parent != "" {
+		statements = statements.childrenOf(parent)
+	}
+
+	if total, err = count(ctx, r.db, statements); err != nil {
+		return
+	}
+
+	rows, err := r.db.QueryContext(ctx, statements.query, statements.queryArgs...)
 	if err != nil {
 		log.Println(err)
 		err = ErrDatabase
@@ -83,7 +98,15 @@ func (r *regencyRepositoryImpl) FindByName(ctx context.Context, keyword string)
 	regencies = make([]entity.Regency, 0)
 	for rows.Next() {
 		var regency entity.Regency
-		if err = rows.Scan(&regency.ID, &regency.Name, &regency.Province.ID, &regency.Province.Name); err != nil {
+		dests := []any{&regency.ID, &regency.Name, &regency.Province.ID}
+		parents := [][]any{
+			{&regency.Province.Name},
+		}
+		for _, parent := range parents[:statements.parents] {
+			dests = append(dests, parent...)
+		}
+
+		if err = rows.Scan(dests...); err != nil {
 			log.Println(err)
 			err = ErrDatabase
 			return
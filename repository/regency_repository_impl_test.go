@@ -7,6 +7,7 @@ import (
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/erikrios/ponorogo-regency-api/entity"
+	"github.com/erikrios/ponorogo-regency-api/normalize"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -36,11 +37,12 @@ func TestRegencyRepositoryImpl(t *testing.T) {
 		}
 
 		t.Run("it should return valid regencies, when database successfully return the data", func(t *testing.T) {
-			mock.ExpectQuery(".*").WillReturnRows(returnedRows)
+			mock.ExpectQuery("SELECT COUNT").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(len(expectedRegencies)))
+			mock.ExpectQuery("ORDER BY").WillReturnRows(returnedRows)
 
 			var repo RegencyRepository = NewRegencyRepositoryImpl(db)
 
-			got, err := repo.FindAll(context.Background())
+			got, total, err := repo.FindAll(context.Background(), Query{})
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -50,6 +52,7 @@ func TestRegencyRepositoryImpl(t *testing.T) {
 			}
 
 			assert.ElementsMatch(t, expectedRegencies, got)
+			assert.Equal(t, len(expectedRegencies), total)
 		})
 
 		t.Run("it should return error, when database return an error", func(t *testing.T) {
@@ -57,7 +60,7 @@ func TestRegencyRepositoryImpl(t *testing.T) {
 
 			var repo RegencyRepository = NewRegencyRepositoryImpl(db)
 
-			if _, err := repo.FindAll(context.Background()); assert.Error(t, err) {
+			if _, _, err := repo.FindAll(context.Background(), Query{}); assert.Error(t, err) {
 				assert.Equal(t, ErrDatabase, err)
 			}
 
@@ -158,11 +161,12 @@ func TestRegencyRepositoryImpl(t *testing.T) {
 		}
 
 		t.Run("it should return valid regencies, when database successfully return the data", func(t *testing.T) {
-			mock.ExpectQuery(".*").WithArgs(expectedRegencies[0].Name).WillReturnRows(returnedRows)
+			mock.ExpectQuery("SELECT COUNT").WithArgs(normalize.Name(expectedRegencies[0].Name)).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(len(expectedRegencies)))
+			mock.ExpectQuery("ORDER BY").WithArgs(normalize.Name(expectedRegencies[0].Name)).WillReturnRows(returnedRows)
 
 			var repo RegencyRepository = NewRegencyRepositoryImpl(db)
 
-			got, err := repo.FindByName(context.Background(), expectedRegencies[0].Name)
+			got, total, err := repo.FindByName(context.Background(), expectedRegencies[0].Name, Query{})
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -172,14 +176,15 @@ func TestRegencyRepositoryImpl(t *testing.T) {
 			}
 
 			assert.ElementsMatch(t, expectedRegencies, got)
+			assert.Equal(t, len(expectedRegencies), total)
 		})
 
 		t.Run("it should return error, when database return an error", func(t *testing.T) {
-			mock.ExpectQuery(".*").WithArgs(expectedRegencies[0].Name).WillReturnError(ErrDatabase)
+			mock.ExpectQuery(".*").WithArgs(normalize.Name(expectedRegencies[0].Name)).WillReturnError(ErrDatabase)
 
 			var repo RegencyRepository = NewRegencyRepositoryImpl(db)
 
-			if _, err := repo.FindByName(context.Background(), expectedRegencies[0].Name); assert.Error(t, err) {
+			if _, _, err := repo.FindByName(context.Background(), expectedRegencies[0].Name, Query{}); assert.Error(t, err) {
 				assert.Equal(t, ErrDatabase, err)
 			}
 
@@ -188,4 +193,124 @@ func TestRegencyRepositoryImpl(t *testing.T) {
 			}
 		})
 	})
+
+	t.Run("TestFindByProvinceID", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer db.Close()
+
+		expectedRegencies := []entity.Regency{
+			{
+				ID:   "3502",
+				Name: "KABUPATEN PONOROGO",
+				Province: entity.Province{
+					ID:   "35",
+					Name: "JAWA TIMUR",
+				},
+			},
+		}
+
+		returnedRows := sqlmock.NewRows([]string{"id", "name", "province_id", "province_name"})
+		for _, regency := range expectedRegencies {
+			returnedRows.AddRow(regency.ID, regency.Name, regency.Province.ID, regency.Province.Name)
+		}
+
+		t.Run("it should return valid regencies, when database successfully return the data", func(t *testing.T) {
+			mock.ExpectQuery("SELECT EXISTS").WithArgs("35").WillReturnRows(sqlmock.NewRows([]string{"exists", "count"}).AddRow(true, len(expectedRegencies)))
+			mock.ExpectQuery("ORDER BY").WithArgs("35").WillReturnRows(returnedRows)
+
+			var repo RegencyRepository = NewRegencyRepositoryImpl(db)
+
+			got, total, err := repo.FindByProvinceID(context.Background(), "35", Query{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+
+			assert.ElementsMatch(t, expectedRegencies, got)
+			assert.Equal(t, len(expectedRegencies), total)
+		})
+
+		t.Run("it should return not found error, when given province id not found in the database", func(t *testing.T) {
+			mock.ExpectQuery("SELECT EXISTS").WithArgs("90").WillReturnRows(sqlmock.NewRows([]string{"exists", "count"}).AddRow(false, 0))
+
+			var repo RegencyRepository = NewRegencyRepositoryImpl(db)
+
+			if _, _, err := repo.FindByProvinceID(context.Background(), "90", Query{}); assert.Error(t, err) {
+				assert.Equal(t, ErrQueryNotFound, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+		})
+
+		t.Run("it should return error, when database return an error", func(t *testing.T) {
+			mock.ExpectQuery(".*").WithArgs("35").WillReturnError(ErrDatabase)
+
+			var repo RegencyRepository = NewRegencyRepositoryImpl(db)
+
+			if _, _, err := repo.FindByProvinceID(context.Background(), "35", Query{}); assert.Error(t, err) {
+				assert.Equal(t, ErrDatabase, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+		})
+	})
+
+	t.Run("TestFindTree", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer db.Close()
+
+		t.Run("it should read the regency with its province and descendants, when database successfully return the data", func(t *testing.T) {
+			returnedRows := sqlmock.NewRows([]string{"province_id", "province_name", "id", "name", "district_id", "district_name", "village_id", "village_name"})
+			returnedRows.AddRow("35", "JAWA TIMUR", "3502", "KABUPATEN PONOROGO", "3502010", "NGRAYUN", "3502010001", "BAOSANKIDUL")
+			returnedRows.AddRow("35", "JAWA TIMUR", "3502", "KABUPATEN PONOROGO", "3502010", "NGRAYUN", "3502010002", "WONODADI")
+
+			mock.ExpectQuery("FROM regencies r INNER JOIN provinces p on r.province_id = p.id LEFT JOIN districts d on d.regency_id = r.id LEFT JOIN villages v on v.district_id = d.id WHERE r.id = \\$1 ORDER BY d.id, v.id;").
+				WithArgs("3502").
+				WillReturnRows(returnedRows)
+
+			var repo RegencyRepository = NewRegencyRepositoryImpl(db)
+
+			got, err := repo.FindTree(context.Background(), "3502", 2)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+
+			if assert.Len(t, got, 2) {
+				assert.Equal(t, "WONODADI", got[1].Name)
+				assert.Equal(t, "NGRAYUN", got[1].District.Name)
+				assert.Equal(t, "JAWA TIMUR", got[1].District.Regency.Province.Name)
+			}
+		})
+
+		t.Run("it should return not found error, when given id not found in the database", func(t *testing.T) {
+			mock.ExpectQuery(".*").WithArgs("3503").WillReturnRows(sqlmock.NewRows([]string{"province_id", "province_name", "id", "name"}))
+
+			var repo RegencyRepository = NewRegencyRepositoryImpl(db)
+
+			if _, err := repo.FindTree(context.Background(), "3503", 0); assert.Error(t, err) {
+				assert.Equal(t, ErrQueryNotFound, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+		})
+	})
 }
@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/erikrios/ponorogo-regency-api/entity"
+)
+
+type treeLevel struct {
+	join string
+	id   string
+	name string
+}
+
+var treeLevels = []treeLevel{
+	{id: "p.id", name: "p.name"},
+	{join: "LEFT JOIN regencies r on r.province_id = p.id", id: "r.id", name: "r.name"},
+	{join: "LEFT JOIN districts d on d.regency_id = r.id", id: "d.id", name: "d.name"},
+	{join: "LEFT JOIN villages v on v.district_id = d.id", id: "v.id", name: "v.name"},
+}
+
+// findTree reads the node, its ancestors and its descendants in one query, as one row per leaf
+// ordered by ID.
+func findTree(ctx context.Context, db *sql.DB, from string, level int, id string, depth int) (rows []entity.Village, err error) {
+	last := level + depth
+	if depth < 0 {
+		last = level
+	} else if last >= len(treeLevels) {
+		last = len(treeLevels) - 1
+	}
+
+	var columns []string
+	var order []string
+
+	for i, treeLevel := range treeLevels[:last+1] {
+		if i <= level {
+			columns = append(columns, treeLevel.id, treeLevel.name)
+			continue
+		}
+
+		columns = append(columns, fmt.Sprintf("COALESCE(%s, '')", treeLevel.id), fmt.Sprintf("COALESCE(%s, '')", treeLevel.name))
+		from += " " + treeLevel.join
+		order = append(order, treeLevel.id)
+	}
+
+	statement := fmt.Sprintf("SELECT %s FROM %s WHERE %s = $1", strings.Join(columns, ", "), from, treeLevels[level].id)
+	if len(order) > 0 {
+		statement += " ORDER BY " + strings.Join(order, ", ")
+	}
+
+	result, err := db.QueryContext(ctx, statement+";", id)
+	if err != nil {
+		log.Println(err)
+		err = ErrDatabase
+		return
+	}
+
+	defer func(result *sql.Rows) {
+		if closeErr := result.Close(); closeErr != nil {
+			log.Println(closeErr.Error())
+		}
+	}(result)
+
+	rows = make([]entity.Village, 0)
+	for result.Next() {
+		var row entity.Village
+		dests := []any{
+			&row.District.Regency.Province.ID,
+			&row.District.Regency.Province.Name,
+			&row.District.Regency.ID,
+			&row.District.Regency.Name,
+			&row.District.ID,
+			&row.District.Name,
+			&row.ID,
+			&row.Name,
+		}
+
+		if err = result.Scan(dests[:2*(last+1)]...); err != nil {
+			log.Println(err)
+			err = ErrDatabase
+			return
+		}
+		rows = append(rows, row)
+	}
+
+	if len(rows) == 0 {
+		err = ErrQueryNotFound
+	}
+
+	return
+}
@@ -7,9 +7,16 @@ import (
 )
 
 type VillageRepository interface {
-	FindAll(ctx context.Context) (villages []entity.Village, err error)
+	FindAll(ctx context.Context, query Query) (villages []entity.Village, total int, err error)
 	FindByID(ctx context.Context, id string) (village entity.Village, err error)
-	FindByName(ctx context.Context, keyword string) (villages []entity.Village, err error)
-	FindByDistrictID(ctx context.Context, districtID string) (villages []entity.Village, err error)
-	FindByDistrictName(ctx context.Context, keyword string) (villages []entity.Village, err error)
+	FindByIDs(ctx context.Context, ids []string) (villages []entity.Village, err error)
+	FindByName(ctx context.Context, keyword string, query Query) (villages []entity.Village, total int, err error)
+	FindByDistrictID(ctx context.Context, districtID string, query Query) (villages []entity.Village, total int, err error)
+	FindByDistrictName(ctx context.Context, keyword string, query Query) (villages []entity.Village, total int, err error)
+	FindLocated(ctx context.Context) (villages []entity.Village, err error)
+	FindByPostalCode(ctx context.Context, code string) (villages []entity.Village, err error)
+	FindBoundaryByID(ctx context.Context, id string) (boundary entity.Boundary, err error)
+	FindBoundariesByDistrictID(ctx context.Context, districtID string) (boundaries []entity.Boundary, err error)
+	FindBoundaries(ctx context.Context) (boundaries []entity.Boundary, err error)
+	Stream(ctx context.Context, districtID string, districtKeyword string, fn func(village entity.Village) error) (err error)
 }
@@ -6,57 +6,46 @@ import (
 	"log"
 
 	"github.com/erikrios/ponorogo-regency-api/entity"
+	"github.com/erikrios/ponorogo-regency-api/normalize"
+	"github.com/lib/pq"
 )
 
 type villageRepositoryImpl struct {
 	db *sql.DB
 }
 
+var villageListSpec = listSpec{
+	table:   "villages v",
+	columns: "v.id, v.name, v.district_id, v.type, v.latitude, v.longitude, (SELECT array_agg(pc.code ORDER BY pc.code) FROM postal_codes pc WHERE pc.village_id = v.id) AS postal_codes",
+	id:      "v.id",
+	parents: []parent{
+		{name: "district", join: "INNER JOIN districts d on d.id = v.district_id", columns: "d.name AS district_name, d.regency_id"},
+		{name: "regency", join: "INNER JOIN regencies r on d.regency_id = r.id", columns: "r.name AS regency_name, r.province_id"},
+		{name: "province", join: "INNER JOIN provinces p on r.province_id = p.id", columns: "p.name AS province_name"},
+	},
+	filters: map[string]filter{
+		"name":        {nameColumn: "v.name"},
+		"district_id": {condition: "v.district_id = %s"},
+		"regency_id":  {condition: "d.regency_id = %s", joins: 1},
+		"province_id": {condition: "r.province_id = %s", joins: 2},
+		"type":        {condition: "v.type = %s"},
+	},
+	sorts: map[string]string{
+		"id":   "v.id",
+		"name": "v.name",
+	},
+}
+
 func NewVillageRepositoryImpl(db *sql.DB) *villageRepositoryImpl {
 	return &villageRepositoryImpl{db: db}
 }
 
-func (v *villageRepositoryImpl) FindAll(ctx context.Context) (villages []entity.Village, err error) {
-	statement := "SELECT v.id, v.name, v.district_id, d.name AS district_name, d.regency_id, r.name AS regency_name, r.province_id, p.name AS province_name FROM villages v INNER JOIN districts d on d.id = v.district_id INNER JOIN regencies r on d.regency_id = r.id INNER JOIN provinces p on r.province_id = p.id;"
-
-	rows, err := v.db.QueryContext(ctx, statement)
-	if err != nil {
-		log.Println(err)
-		err = ErrDatabase
-		return
-	}
-
-	defer func(rows *sql.Rows) {
-		if err = rows.Close(); err != nil {
-			log.Println(err.Error())
-		}
-	}(rows)
-
-	villages = make([]entity.Village, 0)
-	for rows.Next() {
-		var village entity.Village
-		if err = rows.Scan(
-			&village.ID,
-			&village.Name,
-			&village.District.ID,
-			&village.District.Name,
-			&village.District.Regency.ID,
-			&village.District.Regency.Name,
-			&village.District.Regency.Province.ID,
-			&village.District.Regency.Province.Name,
-		); err != nil {
-			log.Println(err)
-			err = ErrDatabase
-			return
-		}
-		villages = append(villages, village)
-	}
-
-	return
+func (v *villageRepositoryImpl) FindAll(ctx context.Context, query Query) (villages []entity.Village, total int, err error) {
+	return v.list(ctx, "", "", 0, query)
 }
 
 func (v *villageRepositoryImpl) FindByID(ctx context.Context, id string) (village entity.Village, err error) {
-	statement := "SELECT v.id, v.name, v.district_id, d.name AS district_name, d.regency_id, r.name AS regency_name, r.province_id, p.name AS province_name FROM villages v INNER JOIN districts d on d.id = v.district_id INNER JOIN regencies r on d.regency_id = r.id INNER JOIN provinces p on r.province_id = p.id WHERE v.id = $1;"
+	statement := "SELECT v.id, v.name, v.district_id, v.type, v.latitude, v.longitude, (SELECT array_agg(pc.code ORDER BY pc.code) FROM postal_codes pc WHERE pc.village_id = v.id) AS postal_codes, d.name AS district_name, d.regency_id, r.name AS regency_name, r.province_id, p.name AS province_name FROM villages v INNER JOIN districts d on d.id = v.district_id INNER JOIN regencies r on d.regency_id = r.id INNER JOIN provinces p on r.province_id = p.id WHERE v.id = $1;"
 
 	row := v.db.QueryRowContext(ctx, statement, id)
 
@@ -64,6 +53,10 @@ func (v *villageRepositoryImpl) FindByID(ctx context.Context, id string) (villag
 		&village.ID,
 		&village.Name,
 		&village.District.ID,
+		&village.Type,
+		&village.Latitude,
+		&village.Longitude,
+		pq.Array(&village.PostalCodes),
 		&village.District.Name,
 		&village.District.Regency.ID,
 		&village.District.Regency.Name,
@@ -82,10 +75,10 @@ func (v *villageRepositoryImpl) FindByID(ctx context.Context, id string) (villag
 	}
 }
 
-func (v *villageRepositoryImpl) FindByName(ctx context.Context, keyword string) (villages []entity.Village, err error) {
-	statement := "SELECT v.id, v.name, v.district_id, d.name AS district_name, d.regency_id, r.name AS regency_name, r.province_id, p.name AS province_name FROM villages v INNER JOIN districts d on d.id = v.district_id INNER JOIN regencies r on d.regency_id = r.id INNER JOIN provinces p on r.province_id = p.id WHERE v.name ILIKE '%' || $1 || '%';"
+func (v *villageRepositoryImpl) FindByIDs(ctx context.Context, ids []string) (villages []entity.Village, err error) {
+	statement := "SELECT v.id, v.name, v.district_id, v.type, v.latitude, v.longitude, (SELECT array_agg(pc.code ORDER BY pc.code) FROM postal_codes pc WHERE pc.village_id = v.id) AS postal_codes, d.name AS district_name, d.regency_id, r.name AS regency_name, r.province_id, p.name AS province_name FROM villages v INNER JOIN districts d on d.id = v.district_id INNER JOIN regencies r on d.regency_id = r.id INNER JOIN provinces p on r.province_id = p.id WHERE v.id = ANY($1) ORDER BY v.id;"
 
-	rows, err := v.db.QueryContext(ctx, statement, keyword)
+	rows, err := v.db.QueryContext(ctx, statement, pq.Array(ids))
 	if err != nil {
 		log.Println(err)
 		err = ErrDatabase
@@ -93,18 +86,22 @@ func (v *villageRepositoryImpl) FindByName(ctx context.Context, keyword string)
 	}
 
 	defer func(rows *sql.Rows) {
-		if err = rows.Close(); err != nil {
-			log.Println(err.Error())
+		if closeErr := rows.Close(); closeErr != nil {
+			log.Println(closeErr.Error())
 		}
 	}(rows)
 
-	villages = make([]entity.Village, 0)
+	villages = make([]entity.Village, 0, len(ids))
 	for rows.Next() {
 		var village entity.Village
 		if err = rows.Scan(
 			&village.ID,
 			&village.Name,
 			&village.District.ID,
+			&village.Type,
+			&village.Latitude,
+			&village.Longitude,
+			pq.Array(&village.PostalCodes),
 			&village.District.Name,
 			&village.District.Regency.ID,
 			&village.District.Regency.Name,
@@ -118,13 +115,64 @@ func (v *villageRepositoryImpl) FindByName(ctx context.Context, keyword string)
 		villages = append(villages, village)
 	}
 
+	if rowsErr := rows.Err(); rowsErr != nil {
+		log.Println(rowsErr)
+		err = ErrDatabase
+	}
+
+	return
+}
+
+func (v *villageRepositoryImpl) FindByName(ctx context.Context, keyword string, query Query) (villages []entity.Village, total int, err error) {
+	return v.list(ctx, "", nameContains("v.name", "$1"), 0, query, normalize.Name(keyword))
+}
+
+func (v *villageRepositoryImpl) FindByDistrictID(ctx context.Context, districtID string, query Query) (villages []entity.Village, total int, err error) {
+	return v.list(ctx, "districts", "v.district_id = $1", 0, query, districtID)
+}
+
+func (v *villageRepositoryImpl) FindByDistrictName(ctx context.Context, keyword string, query Query) (villages []entity.Village, total int, err error) {
+	return v.list(ctx, "", nameContains("d.name", "$1"), 1, query, normalize.Name(keyword))
+}
+
+func (v *villageRepositoryImpl) FindLocated(ctx context.Context) (villages []entity.Village, err error) {
+	villages, _, err = v.list(ctx, "", "v.latitude IS NOT NULL AND v.longitude IS NOT NULL", 0, Query{})
 	return
 }
 
-func (v *villageRepositoryImpl) FindByDistrictID(ctx context.Context, districtID string) (villages []entity.Village, err error) {
-	statement := "SELECT v.id, v.name, v.district_id, d.name AS district_name, d.regency_id, r.name AS regency_name, r.province_id, p.name AS province_name FROM villages v INNER JOIN districts d on d.id = v.district_id INNER JOIN regencies r on d.regency_id = r.id INNER JOIN provinces p on r.province_id = p.id WHERE v.district_id = $1;"
+func (v *villageRepositoryImpl) FindByPostalCode(ctx context.Context, code string) (villages []entity.Village, err error) {
+	villages, _, err = v.list(ctx, "", "v.id IN (SELECT pc.village_id FROM postal_codes pc WHERE pc.code = $1)", 0, Query{}, code)
+	return
+}
 
-	rows, err := v.db.QueryContext(ctx, statement, districtID)
+func (v *villageRepositoryImpl) FindBoundaryByID(ctx context.Context, id string) (boundary entity.Boundary, err error) {
+	return findBoundary(ctx, v.db, "villages", id)
+}
+
+func (v *villageRepositoryImpl) FindBoundariesByDistrictID(ctx context.Context, districtID string) (boundaries []entity.Boundary, err error) {
+	var found bool
+	if scanErr := v.db.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM districts WHERE id = $1);", districtID).Scan(&found); scanErr != nil {
+		log.Println(scanErr)
+		err = ErrDatabase
+		return
+	}
+
+	if !found {
+		err = ErrQueryNotFound
+		return
+	}
+
+	return queryBoundaries(ctx, v.db, "SELECT v.id, v.name, v.boundary::text FROM villages v WHERE v.district_id = $1 ORDER BY v.id;", districtID)
+}
+
+func (v *villageRepositoryImpl) FindBoundaries(ctx context.Context) (boundaries []entity.Boundary, err error) {
+	return queryBoundaries(ctx, v.db, "SELECT v.id, v.name, v.boundary::text FROM villages v WHERE v.boundary IS NOT NULL ORDER BY v.id;")
+}
+
+func (v *villageRepositoryImpl) Stream(ctx context.Context, districtID string, districtKeyword string, fn func(village entity.Village) error) (err error) {
+	statement := "SELECT v.id, v.name, v.district_id, v.type, v.latitude, v.longitude, (SELECT array_agg(pc.code ORDER BY pc.code) FROM postal_codes pc WHERE pc.village_id = v.id) AS postal_codes, d.name AS district_name, d.regency_id, r.name AS regency_name, r.province_id, p.name AS province_name FROM villages v INNER JOIN districts d on d.id = v.district_id INNER JOIN regencies r on d.regency_id = r.id INNER JOIN provinces p on r.province_id = p.id WHERE ($1 = '' OR v.district_id = $1) AND ($2 = '' OR " + nameContains("d.name", "$2") + ") ORDER BY v.id;"
+
+	rows, err := v.db.QueryContext(ctx, statement, districtID, normalize.Name(districtKeyword))
 	if err != nil {
 		log.Println(err)
 		err = ErrDatabase
@@ -132,18 +180,21 @@ func (v *villageRepositoryImpl) FindByDistrictID(ctx context.Context, districtID
 	}
 
 	defer func(rows *sql.Rows) {
-		if err = rows.Close(); err != nil {
-			log.Println(err.Error())
+		if closeErr := rows.Close(); closeErr != nil {
+			log.Println(closeErr.Error())
 		}
 	}(rows)
 
-	villages = make([]entity.Village, 0)
 	for rows.Next() {
 		var village entity.Village
 		if err = rows.Scan(
 			&village.ID,
 			&village.Name,
 			&village.District.ID,
+			&village.Type,
+			&village.Latitude,
+			&village.Longitude,
+			pq.Array(&village.PostalCodes),
 			&village.District.Name,
 			&village.District.Regency.ID,
 			&village.District.Regency.Name,
@@ -154,16 +205,35 @@ func (v *villageRepositoryImpl) FindByDistrictID(ctx context.Context, districtID
 			err = ErrDatabase
 			return
 		}
-		villages = append(villages, village)
+
+		if err = fn(village); err != nil {
+			return
+		}
+	}
+
+	if rowsErr := rows.Err(); rowsErr != nil {
+		log.Println(rowsErr)
+		err = ErrDatabase
 	}
 
 	return
 }
 
-func (v *villageRepositoryImpl) FindByDistrictName(ctx context.Context, keyword string) (villages []entity.Village, err error) {
-	statement := "SELECT v.id, v.name, v.district_id, d.name AS district_name, d.regency_id, r.name AS regency_name, r.province_id, p.name AS province_name FROM villages v INNER JOIN districts d on d.id = v.district_id INNER JOIN regencies r on d.regency_id = r.id INNER JOIN provinces p on r.province_id = p.id WHERE d.name ILIKE '%' || $1 || '%';"
+func (v *villageRepositoryImpl) list(ctx context.Context, parent, condition string, joins int, query Query, args ...any) (villages []entity.Village, total int, err error) {
+	statements, err := query.build(villageListSpec, condition, joins, args...)
+	if err != nil {
+		return
+	}
 
-	rows, err := v.db.QueryContext(ctx, statement, keyword)
+	if parent != "" {
+		statements = statements.childrenOf(parent)
+	}
+
+	if total, err = count(ctx, v.db, statements); err != nil {
+		return
+	}
+
+	rows, err := v.db.QueryContext(ctx, statements.query, statements.queryArgs...)
 	if err != nil {
 		log.Println(err)
 		err = ErrDatabase
@@ -179,16 +249,17 @@ func (v *villageRepositoryImpl) FindByDistrictName(ctx context.Context, keyword
 	villages = make([]entity.Village, 0)
 	for rows.Next() {
 		var village entity.Village
-		if err = rows.Scan(
-			&village.ID,
-			&village.Name,
-			&village.District.ID,
-			&village.District.Name,
-			&village.District.Regency.ID,
-			&village.District.Regency.Name,
-			&village.District.Regency.Province.ID,
-			&village.District.Regency.Province.Name,
-		); err != nil {
+		dests := []any{&village.ID, &village.Name, &village.District.ID, &village.Type, &village.Latitude, &village.Longitude, pq.Array(&village.PostalCodes)}
+		parents := [][]any{
+			{&village.District.Name, &village.District.Regency.ID},
+			{&village.District.Regency.Name, &village.District.Regency.Province.ID},
+			{&village.District.Regency.Province.Name},
+		}
+		for _, parent := range parents[:statements.parents] {
+			dests = append(dests, parent...)
+		}
+
+		if err = rows.Scan(dests...); err != nil {
 			log.Println(err)
 			err = ErrDatabase
 			return
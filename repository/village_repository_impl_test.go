@@ -3,14 +3,21 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/erikrios/ponorogo-regency-api/entity"
+	"github.com/erikrios/ponorogo-regency-api/normalize"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestVillageRepositoryImpl(t *testing.T) {
+	latitude, longitude := -8.0, 111.47
+	postalCode := "63472"
 
 	t.Run("TestFindAll", func(t *testing.T) {
 		db, mock, err := sqlmock.New()
@@ -21,8 +28,11 @@ func TestVillageRepositoryImpl(t *testing.T) {
 
 		expectedVillages := []entity.Village{
 			{
-				ID:   "4050101101",
-				Name: "Pager",
+				ID:        "4050101101",
+				Name:      "Pager",
+				Type:      "desa",
+				Latitude:  &latitude,
+				Longitude: &longitude,
 				District: entity.District{
 					ID:   "32010001",
 					Name: "Bungkal",
@@ -38,12 +48,16 @@ func TestVillageRepositoryImpl(t *testing.T) {
 			},
 		}
 
-		returnedRows := sqlmock.NewRows([]string{"id", "name", "district_id", "district_name", "regency_id", "regency_name", "province_id", "province_name"})
+		returnedRows := sqlmock.NewRows([]string{"id", "name", "district_id", "type", "latitude", "longitude", "postal_codes", "district_name", "regency_id", "regency_name", "province_id", "province_name"})
 		for _, village := range expectedVillages {
 			returnedRows.AddRow(
 				village.ID,
 				village.Name,
 				village.District.ID,
+				village.Type,
+				village.Latitude,
+				village.Longitude,
+				postalCodesValue(village.PostalCodes),
 				village.District.Name,
 				village.District.Regency.ID,
 				village.District.Regency.Name,
@@ -53,11 +67,12 @@ func TestVillageRepositoryImpl(t *testing.T) {
 		}
 
 		t.Run("it should return valid villages, when database successfully return the data", func(t *testing.T) {
-			mock.ExpectQuery(".*").WillReturnRows(returnedRows)
+			mock.ExpectQuery("SELECT COUNT").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(len(expectedVillages)))
+			mock.ExpectQuery("ORDER BY").WillReturnRows(returnedRows)
 
 			var repo VillageRepository = NewVillageRepositoryImpl(db)
 
-			got, err := repo.FindAll(context.Background())
+			got, total, err := repo.FindAll(context.Background(), Query{})
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -67,6 +82,7 @@ func TestVillageRepositoryImpl(t *testing.T) {
 			}
 
 			assert.ElementsMatch(t, expectedVillages, got)
+			assert.Equal(t, len(expectedVillages), total)
 		})
 
 		t.Run("it should return error, when database return an error", func(t *testing.T) {
@@ -74,10 +90,47 @@ func TestVillageRepositoryImpl(t *testing.T) {
 
 			var repo VillageRepository = NewVillageRepositoryImpl(db)
 
-			if _, err := repo.FindAll(context.Background()); assert.Error(t, err) {
+			if _, _, err := repo.FindAll(context.Background(), Query{}); assert.Error(t, err) {
 				assert.Equal(t, ErrDatabase, err)
 			}
 
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+		})
+		t.Run("it should read villages without their parents, when no parent is expanded", func(t *testing.T) {
+			rows := sqlmock.NewRows([]string{"id", "name", "district_id", "type", "latitude", "longitude", "postal_codes"})
+			for _, village := range expectedVillages {
+				rows.AddRow(village.ID, village.Name, village.District.ID, village.Type, village.Latitude, village.Longitude, postalCodesValue(village.PostalCodes))
+			}
+
+			mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM villages v;").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(len(expectedVillages)))
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT v.id, v.name, v.district_id, v.type, v.latitude, v.longitude, (SELECT array_agg(pc.code ORDER BY pc.code) FROM postal_codes pc WHERE pc.village_id = v.id) AS postal_codes FROM villages v ORDER BY")).WillReturnRows(rows)
+
+			var repo VillageRepository = NewVillageRepositoryImpl(db)
+
+			got, _, err := repo.FindAll(context.Background(), Query{Expand: []string{}})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+
+			for i, village := range expectedVillages {
+				assert.Equal(t, entity.Village{ID: village.ID, Name: village.Name, Type: village.Type, Latitude: village.Latitude, Longitude: village.Longitude, PostalCodes: village.PostalCodes, District: entity.District{ID: village.District.ID}}, got[i])
+			}
+		})
+
+		t.Run("it should return QueryError without querying, when filtering by unknown field", func(t *testing.T) {
+			var repo VillageRepository = NewVillageRepositoryImpl(db)
+
+			_, _, err := repo.FindAll(context.Background(), Query{Filters: map[string]string{"population": "1000"}})
+
+			var queryErr *QueryError
+			assert.ErrorAs(t, err, &queryErr)
+
 			if err := mock.ExpectationsWereMet(); err != nil {
 				t.Fatal(err)
 			}
@@ -93,8 +146,12 @@ func TestVillageRepositoryImpl(t *testing.T) {
 		defer db.Close()
 
 		expectedVillage := entity.Village{
-			ID:   "4050101101",
-			Name: "Pager",
+			ID:          "4050101101",
+			Name:        "Pager",
+			Type:        "desa",
+			Latitude:    &latitude,
+			Longitude:   &longitude,
+			PostalCodes: []string{postalCode},
 			District: entity.District{
 				ID:   "32010001",
 				Name: "Bungkal",
@@ -109,11 +166,15 @@ func TestVillageRepositoryImpl(t *testing.T) {
 			},
 		}
 
-		returnedRows := sqlmock.NewRows([]string{"id", "name", "district_id", "district_name", "regency_id", "regency_name", "province_id", "province_name"})
+		returnedRows := sqlmock.NewRows([]string{"id", "name", "district_id", "type", "latitude", "longitude", "postal_codes", "district_name", "regency_id", "regency_name", "province_id", "province_name"})
 		returnedRows.AddRow(
 			expectedVillage.ID,
 			expectedVillage.Name,
 			expectedVillage.District.ID,
+			expectedVillage.Type,
+			expectedVillage.Latitude,
+			expectedVillage.Longitude,
+			postalCodesValue(expectedVillage.PostalCodes),
 			expectedVillage.District.Name,
 			expectedVillage.District.Regency.ID,
 			expectedVillage.District.Regency.Name,
@@ -167,6 +228,84 @@ func TestVillageRepositoryImpl(t *testing.T) {
 		})
 	})
 
+	t.Run("TestFindByIDs", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer db.Close()
+
+		expectedVillages := []entity.Village{
+			{
+				ID:   "3502010001",
+				Name: "BAOSANKIDUL",
+				Type: "desa",
+				District: entity.District{
+					ID:   "3502010",
+					Name: "NGRAYUN",
+					Regency: entity.Regency{
+						ID:   "3502",
+						Name: "KABUPATEN PONOROGO",
+						Province: entity.Province{
+							ID:   "35",
+							Name: "JAWA TIMUR",
+						},
+					},
+				},
+			},
+		}
+		ids := []string{"3502010001", "3502010999"}
+
+		t.Run("it should return the found villages, when database successfully return the data", func(t *testing.T) {
+			returnedRows := sqlmock.NewRows([]string{"id", "name", "district_id", "type", "latitude", "longitude", "postal_codes", "district_name", "regency_id", "regency_name", "province_id", "province_name"})
+			for _, village := range expectedVillages {
+				returnedRows.AddRow(
+					village.ID,
+					village.Name,
+					village.District.ID,
+					village.Type,
+					village.Latitude,
+					village.Longitude,
+					postalCodesValue(village.PostalCodes),
+					village.District.Name,
+					village.District.Regency.ID,
+					village.District.Regency.Name,
+					village.District.Regency.Province.ID,
+					village.District.Regency.Province.Name,
+				)
+			}
+
+			mock.ExpectQuery(regexp.QuoteMeta("WHERE v.id = ANY($1)")).WithArgs(pq.Array(ids)).WillReturnRows(returnedRows)
+
+			var repo VillageRepository = NewVillageRepositoryImpl(db)
+
+			got, err := repo.FindByIDs(context.Background(), ids)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, expectedVillages, got)
+		})
+
+		t.Run("it should return error, when database return an error", func(t *testing.T) {
+			mock.ExpectQuery(".*").WithArgs(pq.Array(ids)).WillReturnError(ErrDatabase)
+
+			var repo VillageRepository = NewVillageRepositoryImpl(db)
+
+			if _, err := repo.FindByIDs(context.Background(), ids); assert.Error(t, err) {
+				assert.Equal(t, ErrDatabase, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+		})
+	})
+
 	t.Run("TestFindByName", func(t *testing.T) {
 		db, mock, err := sqlmock.New()
 		if err != nil {
@@ -178,6 +317,7 @@ func TestVillageRepositoryImpl(t *testing.T) {
 			{
 				ID:   "4050101101",
 				Name: "Pager",
+				Type: "desa",
 				District: entity.District{
 					ID:   "32010001",
 					Name: "Bungkal",
@@ -193,12 +333,16 @@ func TestVillageRepositoryImpl(t *testing.T) {
 			},
 		}
 
-		returnedRows := sqlmock.NewRows([]string{"id", "name", "district_id", "district_name", "regency_id", "regency_name", "province_id", "province_name"})
+		returnedRows := sqlmock.NewRows([]string{"id", "name", "district_id", "type", "latitude", "longitude", "postal_codes", "district_name", "regency_id", "regency_name", "province_id", "province_name"})
 		for _, village := range expectedVillages {
 			returnedRows.AddRow(
 				village.ID,
 				village.Name,
 				village.District.ID,
+				village.Type,
+				village.Latitude,
+				village.Longitude,
+				postalCodesValue(village.PostalCodes),
 				village.District.Name,
 				village.District.Regency.ID,
 				village.District.Regency.Name,
@@ -208,11 +352,12 @@ func TestVillageRepositoryImpl(t *testing.T) {
 		}
 
 		t.Run("it should return valid villages, when database successfully return the data", func(t *testing.T) {
-			mock.ExpectQuery(".*").WithArgs(expectedVillages[0].Name).WillReturnRows(returnedRows)
+			mock.ExpectQuery("SELECT COUNT").WithArgs(normalize.Name(expectedVillages[0].Name)).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(len(expectedVillages)))
+			mock.ExpectQuery("ORDER BY").WithArgs(normalize.Name(expectedVillages[0].Name)).WillReturnRows(returnedRows)
 
 			var repo VillageRepository = NewVillageRepositoryImpl(db)
 
-			got, err := repo.FindByName(context.Background(), expectedVillages[0].Name)
+			got, total, err := repo.FindByName(context.Background(), expectedVillages[0].Name, Query{})
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -222,14 +367,29 @@ func TestVillageRepositoryImpl(t *testing.T) {
 			}
 
 			assert.ElementsMatch(t, expectedVillages, got)
+			assert.Equal(t, len(expectedVillages), total)
+		})
+
+		t.Run("it should compare the normalized names, when given keyword written differently", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta(nameContains("v.name", "$1"))).WithArgs("baosankidul").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+			mock.ExpectQuery(regexp.QuoteMeta(nameContains("v.name", "$1"))).WithArgs("baosankidul").WillReturnRows(sqlmock.NewRows([]string{"id", "name", "district_id", "type", "latitude", "longitude", "postal_codes", "district_name", "regency_id", "regency_name", "province_id", "province_name"}))
+
+			var repo VillageRepository = NewVillageRepositoryImpl(db)
+
+			_, _, err := repo.FindByName(context.Background(), "Baosan Kidoel", Query{})
+			assert.NoError(t, err)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
 		})
 
 		t.Run("it should return error, when database return an error", func(t *testing.T) {
-			mock.ExpectQuery(".*").WithArgs(expectedVillages[0].Name).WillReturnError(ErrDatabase)
+			mock.ExpectQuery(".*").WithArgs(normalize.Name(expectedVillages[0].Name)).WillReturnError(ErrDatabase)
 
 			var repo VillageRepository = NewVillageRepositoryImpl(db)
 
-			if _, err := repo.FindByName(context.Background(), expectedVillages[0].Name); assert.Error(t, err) {
+			if _, _, err := repo.FindByName(context.Background(), expectedVillages[0].Name, Query{}); assert.Error(t, err) {
 				assert.Equal(t, ErrDatabase, err)
 			}
 
@@ -250,6 +410,7 @@ func TestVillageRepositoryImpl(t *testing.T) {
 			{
 				ID:   "4050101101",
 				Name: "Pager",
+				Type: "desa",
 				District: entity.District{
 					ID:   "32010001",
 					Name: "Bungkal",
@@ -265,12 +426,16 @@ func TestVillageRepositoryImpl(t *testing.T) {
 			},
 		}
 
-		returnedRows := sqlmock.NewRows([]string{"id", "name", "district_id", "district_name", "regency_id", "regency_name", "province_id", "province_name"})
+		returnedRows := sqlmock.NewRows([]string{"id", "name", "district_id", "type", "latitude", "longitude", "postal_codes", "district_name", "regency_id", "regency_name", "province_id", "province_name"})
 		for _, village := range expectedVillages {
 			returnedRows.AddRow(
 				village.ID,
 				village.Name,
 				village.District.ID,
+				village.Type,
+				village.Latitude,
+				village.Longitude,
+				postalCodesValue(village.PostalCodes),
 				village.District.Name,
 				village.District.Regency.ID,
 				village.District.Regency.Name,
@@ -280,11 +445,12 @@ func TestVillageRepositoryImpl(t *testing.T) {
 		}
 
 		t.Run("it should return valid villages, when database successfully return the data", func(t *testing.T) {
-			mock.ExpectQuery(".*").WithArgs(expectedVillages[0].District.ID).WillReturnRows(returnedRows)
+			mock.ExpectQuery("SELECT EXISTS").WithArgs(expectedVillages[0].District.ID).WillReturnRows(sqlmock.NewRows([]string{"exists", "count"}).AddRow(true, len(expectedVillages)))
+			mock.ExpectQuery("ORDER BY").WithArgs(expectedVillages[0].District.ID).WillReturnRows(returnedRows)
 
 			var repo VillageRepository = NewVillageRepositoryImpl(db)
 
-			got, err := repo.FindByDistrictID(context.Background(), expectedVillages[0].District.ID)
+			got, total, err := repo.FindByDistrictID(context.Background(), expectedVillages[0].District.ID, Query{})
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -294,6 +460,7 @@ func TestVillageRepositoryImpl(t *testing.T) {
 			}
 
 			assert.ElementsMatch(t, expectedVillages, got)
+			assert.Equal(t, len(expectedVillages), total)
 		})
 
 		t.Run("it should return error, when database return an error", func(t *testing.T) {
@@ -301,7 +468,7 @@ func TestVillageRepositoryImpl(t *testing.T) {
 
 			var repo VillageRepository = NewVillageRepositoryImpl(db)
 
-			if _, err := repo.FindByDistrictID(context.Background(), expectedVillages[0].District.ID); assert.Error(t, err) {
+			if _, _, err := repo.FindByDistrictID(context.Background(), expectedVillages[0].District.ID, Query{}); assert.Error(t, err) {
 				assert.Equal(t, ErrDatabase, err)
 			}
 
@@ -309,6 +476,20 @@ func TestVillageRepositoryImpl(t *testing.T) {
 				t.Fatal(err)
 			}
 		})
+
+		t.Run("it should return not found error, when given district id not found in the database", func(t *testing.T) {
+			mock.ExpectQuery("SELECT EXISTS").WithArgs("3502999").WillReturnRows(sqlmock.NewRows([]string{"exists", "count"}).AddRow(false, 0))
+
+			var repo VillageRepository = NewVillageRepositoryImpl(db)
+
+			if _, _, err := repo.FindByDistrictID(context.Background(), "3502999", Query{}); assert.Error(t, err) {
+				assert.Equal(t, ErrQueryNotFound, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+		})
 	})
 
 	t.Run("TestFindByDistrictName", func(t *testing.T) {
@@ -322,6 +503,7 @@ func TestVillageRepositoryImpl(t *testing.T) {
 			{
 				ID:   "4050101101",
 				Name: "Pager",
+				Type: "desa",
 				District: entity.District{
 					ID:   "32010001",
 					Name: "Bungkal",
@@ -337,12 +519,16 @@ func TestVillageRepositoryImpl(t *testing.T) {
 			},
 		}
 
-		returnedRows := sqlmock.NewRows([]string{"id", "name", "district_id", "district_name", "regency_id", "regency_name", "province_id", "province_name"})
+		returnedRows := sqlmock.NewRows([]string{"id", "name", "district_id", "type", "latitude", "longitude", "postal_codes", "district_name", "regency_id", "regency_name", "province_id", "province_name"})
 		for _, village := range expectedVillages {
 			returnedRows.AddRow(
 				village.ID,
 				village.Name,
 				village.District.ID,
+				village.Type,
+				village.Latitude,
+				village.Longitude,
+				postalCodesValue(village.PostalCodes),
 				village.District.Name,
 				village.District.Regency.ID,
 				village.District.Regency.Name,
@@ -352,11 +538,12 @@ func TestVillageRepositoryImpl(t *testing.T) {
 		}
 
 		t.Run("it should return valid villages, when database successfully return the data", func(t *testing.T) {
-			mock.ExpectQuery(".*").WithArgs(expectedVillages[0].District.Name).WillReturnRows(returnedRows)
+			mock.ExpectQuery("SELECT COUNT").WithArgs(normalize.Name(expectedVillages[0].District.Name)).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(len(expectedVillages)))
+			mock.ExpectQuery("ORDER BY").WithArgs(normalize.Name(expectedVillages[0].District.Name)).WillReturnRows(returnedRows)
 
 			var repo VillageRepository = NewVillageRepositoryImpl(db)
 
-			got, err := repo.FindByDistrictName(context.Background(), expectedVillages[0].District.Name)
+			got, total, err := repo.FindByDistrictName(context.Background(), expectedVillages[0].District.Name, Query{})
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -366,14 +553,15 @@ func TestVillageRepositoryImpl(t *testing.T) {
 			}
 
 			assert.ElementsMatch(t, expectedVillages, got)
+			assert.Equal(t, len(expectedVillages), total)
 		})
 
 		t.Run("it should return error, when database return an error", func(t *testing.T) {
-			mock.ExpectQuery(".*").WithArgs(expectedVillages[0].District.Name).WillReturnError(ErrDatabase)
+			mock.ExpectQuery(".*").WithArgs(normalize.Name(expectedVillages[0].District.Name)).WillReturnError(ErrDatabase)
 
 			var repo VillageRepository = NewVillageRepositoryImpl(db)
 
-			if _, err := repo.FindByDistrictName(context.Background(), expectedVillages[0].District.Name); assert.Error(t, err) {
+			if _, _, err := repo.FindByDistrictName(context.Background(), expectedVillages[0].District.Name, Query{}); assert.Error(t, err) {
 				assert.Equal(t, ErrDatabase, err)
 			}
 
@@ -382,4 +570,446 @@ func TestVillageRepositoryImpl(t *testing.T) {
 			}
 		})
 	})
+
+	t.Run("TestFindLocated", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer db.Close()
+
+		expectedVillages := []entity.Village{
+			{
+				ID:        "3502030009",
+				Name:      "BUNGKAL",
+				Type:      "desa",
+				Latitude:  &latitude,
+				Longitude: &longitude,
+				District: entity.District{
+					ID:   "3502030",
+					Name: "BUNGKAL",
+					Regency: entity.Regency{
+						ID:   "3502",
+						Name: "KABUPATEN PONOROGO",
+						Province: entity.Province{
+							ID:   "35",
+							Name: "JAWA TIMUR",
+						},
+					},
+				},
+			},
+		}
+
+		t.Run("it should return the villages with a centroid, when database successfully return the data", func(t *testing.T) {
+			returnedRows := sqlmock.NewRows([]string{"id", "name", "district_id", "type", "latitude", "longitude", "postal_codes", "district_name", "regency_id", "regency_name", "province_id", "province_name"})
+			for _, village := range expectedVillages {
+				returnedRows.AddRow(
+					village.ID,
+					village.Name,
+					village.District.ID,
+					village.Type,
+					village.Latitude,
+					village.Longitude,
+					postalCodesValue(village.PostalCodes),
+					village.District.Name,
+					village.District.Regency.ID,
+					village.District.Regency.Name,
+					village.District.Regency.Province.ID,
+					village.District.Regency.Province.Name,
+				)
+			}
+
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(*) FROM villages v INNER JOIN districts d on d.id = v.district_id INNER JOIN regencies r on d.regency_id = r.id INNER JOIN provinces p on r.province_id = p.id WHERE v.latitude IS NOT NULL AND v.longitude IS NOT NULL;")).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(len(expectedVillages)))
+			mock.ExpectQuery("WHERE v.latitude IS NOT NULL AND v.longitude IS NOT NULL ORDER BY").WillReturnRows(returnedRows)
+
+			var repo VillageRepository = NewVillageRepositoryImpl(db)
+
+			got, err := repo.FindLocated(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, expectedVillages, got)
+		})
+
+		t.Run("it should return error, when database return an error", func(t *testing.T) {
+			mock.ExpectQuery(".*").WillReturnError(ErrDatabase)
+
+			var repo VillageRepository = NewVillageRepositoryImpl(db)
+
+			if _, err := repo.FindLocated(context.Background()); assert.Error(t, err) {
+				assert.Equal(t, ErrDatabase, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+		})
+	})
+
+	t.Run("TestFindByPostalCode", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer db.Close()
+
+		expectedVillages := []entity.Village{
+			{
+				ID:          "3502080007",
+				Name:        "NGLUMPANG",
+				Type:        "desa",
+				PostalCodes: []string{postalCode},
+				District: entity.District{
+					ID:   "3502080",
+					Name: "MLARAK",
+					Regency: entity.Regency{
+						ID:   "3502",
+						Name: "KABUPATEN PONOROGO",
+						Province: entity.Province{
+							ID:   "35",
+							Name: "JAWA TIMUR",
+						},
+					},
+				},
+			},
+		}
+
+		t.Run("it should return the villages served by the postal code, when database successfully return the data", func(t *testing.T) {
+			returnedRows := sqlmock.NewRows([]string{"id", "name", "district_id", "type", "latitude", "longitude", "postal_codes", "district_name", "regency_id", "regency_name", "province_id", "province_name"})
+			for _, village := range expectedVillages {
+				returnedRows.AddRow(
+					village.ID,
+					village.Name,
+					village.District.ID,
+					village.Type,
+					village.Latitude,
+					village.Longitude,
+					postalCodesValue(village.PostalCodes),
+					village.District.Name,
+					village.District.Regency.ID,
+					village.District.Regency.Name,
+					village.District.Regency.Province.ID,
+					village.District.Regency.Province.Name,
+				)
+			}
+
+			mock.ExpectQuery("SELECT COUNT").WithArgs(postalCode).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(len(expectedVillages)))
+			mock.ExpectQuery(regexp.QuoteMeta("WHERE v.id IN (SELECT pc.village_id FROM postal_codes pc WHERE pc.code = $1) ORDER BY")).WithArgs(postalCode).WillReturnRows(returnedRows)
+
+			var repo VillageRepository = NewVillageRepositoryImpl(db)
+
+			got, err := repo.FindByPostalCode(context.Background(), postalCode)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, expectedVillages, got)
+		})
+
+		t.Run("it should return error, when database return an error", func(t *testing.T) {
+			mock.ExpectQuery(".*").WillReturnError(ErrDatabase)
+
+			var repo VillageRepository = NewVillageRepositoryImpl(db)
+
+			if _, err := repo.FindByPostalCode(context.Background(), postalCode); assert.Error(t, err) {
+				assert.Equal(t, ErrDatabase, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+		})
+	})
+
+	t.Run("TestFindBoundaryByID", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer db.Close()
+
+		geometry := `{"type": "Polygon", "coordinates": [[[111.4, -7.9], [111.5, -7.9], [111.5, -7.8], [111.4, -7.9]]]}`
+		expectedBoundary := entity.Boundary{ID: "3502010001", Name: "BAOSANKIDUL", Geometry: &geometry}
+
+		t.Run("it should return the boundary as GeoJSON text, when database successfully return the data", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT id, name, boundary::text FROM villages WHERE id = $1;")).WithArgs(expectedBoundary.ID).WillReturnRows(sqlmock.NewRows([]string{"id", "name", "boundary"}).AddRow(expectedBoundary.ID, expectedBoundary.Name, geometry))
+
+			var repo VillageRepository = NewVillageRepositoryImpl(db)
+
+			got, err := repo.FindBoundaryByID(context.Background(), expectedBoundary.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, expectedBoundary, got)
+		})
+
+		t.Run("it should return not found error, when given id not found in the database", func(t *testing.T) {
+			mock.ExpectQuery("FROM villages").WithArgs("3502010999").WillReturnRows(sqlmock.NewRows([]string{"id", "name", "boundary"}))
+
+			var repo VillageRepository = NewVillageRepositoryImpl(db)
+
+			if _, err := repo.FindBoundaryByID(context.Background(), "3502010999"); assert.Error(t, err) {
+				assert.Equal(t, ErrQueryNotFound, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+		})
+	})
+
+	t.Run("TestFindBoundariesByDistrictID", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer db.Close()
+
+		geometry := `{"type": "Polygon", "coordinates": [[[111.4, -7.9], [111.5, -7.9], [111.5, -7.8], [111.4, -7.9]]]}`
+		expectedBoundaries := []entity.Boundary{
+			{ID: "3502010001", Name: "BAOSANKIDUL", Geometry: &geometry},
+			{ID: "3502010002", Name: "WONODADI"},
+		}
+
+		t.Run("it should return the boundaries of the villages, when database successfully return the data", func(t *testing.T) {
+			mock.ExpectQuery("SELECT EXISTS").WithArgs("3502010").WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+			mock.ExpectQuery(regexp.QuoteMeta("WHERE v.district_id = $1 ORDER BY v.id;")).WithArgs("3502010").WillReturnRows(
+				sqlmock.NewRows([]string{"id", "name", "boundary"}).
+					AddRow(expectedBoundaries[0].ID, expectedBoundaries[0].Name, geometry).
+					AddRow(expectedBoundaries[1].ID, expectedBoundaries[1].Name, nil),
+			)
+
+			var repo VillageRepository = NewVillageRepositoryImpl(db)
+
+			got, err := repo.FindBoundariesByDistrictID(context.Background(), "3502010")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, expectedBoundaries, got)
+		})
+
+		t.Run("it should return not found error, when given district id not found in the database", func(t *testing.T) {
+			mock.ExpectQuery("SELECT EXISTS").WithArgs("3502999").WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+
+			var repo VillageRepository = NewVillageRepositoryImpl(db)
+
+			if _, err := repo.FindBoundariesByDistrictID(context.Background(), "3502999"); assert.Error(t, err) {
+				assert.Equal(t, ErrQueryNotFound, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+		})
+
+		t.Run("it should return error, when database return an error", func(t *testing.T) {
+			mock.ExpectQuery("SELECT EXISTS").WithArgs("3502010").WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+			mock.ExpectQuery("FROM villages").WithArgs("3502010").WillReturnError(ErrDatabase)
+
+			var repo VillageRepository = NewVillageRepositoryImpl(db)
+
+			if _, err := repo.FindBoundariesByDistrictID(context.Background(), "3502010"); assert.Error(t, err) {
+				assert.Equal(t, ErrDatabase, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+		})
+	})
+
+	t.Run("TestFindBoundaries", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer db.Close()
+
+		geometry := `{"type": "Polygon", "coordinates": [[[111.4, -7.9], [111.5, -7.9], [111.5, -7.8], [111.4, -7.9]]]}`
+		expectedBoundaries := []entity.Boundary{
+			{ID: "3502010001", Name: "BAOSANKIDUL", Geometry: &geometry},
+		}
+
+		t.Run("it should return the known boundaries, when database successfully return the data", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta("WHERE v.boundary IS NOT NULL ORDER BY v.id;")).WillReturnRows(
+				sqlmock.NewRows([]string{"id", "name", "boundary"}).AddRow(expectedBoundaries[0].ID, expectedBoundaries[0].Name, geometry),
+			)
+
+			var repo VillageRepository = NewVillageRepositoryImpl(db)
+
+			got, err := repo.FindBoundaries(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, expectedBoundaries, got)
+		})
+
+		t.Run("it should return error, when database return an error", func(t *testing.T) {
+			mock.ExpectQuery("FROM villages").WillReturnError(ErrDatabase)
+
+			var repo VillageRepository = NewVillageRepositoryImpl(db)
+
+			if _, err := repo.FindBoundaries(context.Background()); assert.Error(t, err) {
+				assert.Equal(t, ErrDatabase, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+		})
+	})
+
+	t.Run("TestStream", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer db.Close()
+
+		expectedVillages := []entity.Village{
+			{
+				ID:   "3502010001",
+				Name: "WONODADI",
+				Type: "desa",
+				District: entity.District{
+					ID:   "3502010",
+					Name: "NGRAYUN",
+					Regency: entity.Regency{
+						ID:   "3502",
+						Name: "KABUPATEN PONOROGO",
+						Province: entity.Province{
+							ID:   "35",
+							Name: "JAWA TIMUR",
+						},
+					},
+				},
+			},
+			{
+				ID:   "3502010002",
+				Name: "SELUR",
+				Type: "desa",
+				District: entity.District{
+					ID:   "3502010",
+					Name: "NGRAYUN",
+					Regency: entity.Regency{
+						ID:   "3502",
+						Name: "KABUPATEN PONOROGO",
+						Province: entity.Province{
+							ID:   "35",
+							Name: "JAWA TIMUR",
+						},
+					},
+				},
+			},
+		}
+
+		newReturnedRows := func() *sqlmock.Rows {
+			returnedRows := sqlmock.NewRows([]string{"id", "name", "district_id", "type", "latitude", "longitude", "postal_codes", "district_name", "regency_id", "regency_name", "province_id", "province_name"})
+			for _, village := range expectedVillages {
+				returnedRows.AddRow(
+					village.ID,
+					village.Name,
+					village.District.ID,
+					village.Type,
+					village.Latitude,
+					village.Longitude,
+					postalCodesValue(village.PostalCodes),
+					village.District.Name,
+					village.District.Regency.ID,
+					village.District.Regency.Name,
+					village.District.Regency.Province.ID,
+					village.District.Regency.Province.Name,
+				)
+			}
+			return returnedRows
+		}
+
+		t.Run("it should hand every village to the callback, when database successfully return the data", func(t *testing.T) {
+			mock.ExpectQuery(".*").WithArgs(expectedVillages[0].District.ID, "").WillReturnRows(newReturnedRows())
+
+			var repo VillageRepository = NewVillageRepositoryImpl(db)
+
+			got := make([]entity.Village, 0)
+			if err := repo.Stream(context.Background(), expectedVillages[0].District.ID, "", func(village entity.Village) error {
+				got = append(got, village)
+				return nil
+			}); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, expectedVillages, got)
+		})
+
+		t.Run("it should stop and return the callback error, when the callback fails", func(t *testing.T) {
+			mock.ExpectQuery(".*").WithArgs("", "").WillReturnRows(newReturnedRows())
+
+			var repo VillageRepository = NewVillageRepositoryImpl(db)
+
+			callbackErr := errors.New("send failed")
+			calls := 0
+			err := repo.Stream(context.Background(), "", "", func(village entity.Village) error {
+				calls++
+				return callbackErr
+			})
+			assert.Equal(t, callbackErr, err)
+			assert.Equal(t, 1, calls)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+		})
+
+		t.Run("it should return error, when database return an error", func(t *testing.T) {
+			mock.ExpectQuery(".*").WithArgs("", normalize.Name(expectedVillages[0].District.Name)).WillReturnError(ErrDatabase)
+
+			var repo VillageRepository = NewVillageRepositoryImpl(db)
+
+			err := repo.Stream(context.Background(), "", expectedVillages[0].District.Name, func(village entity.Village) error {
+				return nil
+			})
+			if assert.Error(t, err) {
+				assert.Equal(t, ErrDatabase, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+		})
+	})
+}
+
+// postalCodesValue returns the postal_codes column as PostgreSQL sends it, NULL when the village has no code.
+func postalCodesValue(codes []string) any {
+	if codes == nil {
+		return nil
+	}
+	return "{" + strings.Join(codes, ",") + "}"
 }
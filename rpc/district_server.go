@@ -0,0 +1,112 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/erikrios/ponorogo-regency-api/model"
+	"github.com/erikrios/ponorogo-regency-api/pb"
+	"github.com/erikrios/ponorogo-regency-api/service"
+)
+
+type DistrictServer struct {
+	pb.UnimplementedDistrictServiceServer
+	service service.DistrictService
+}
+
+func NewDistrictServer(service service.DistrictService) *DistrictServer {
+	return &DistrictServer{
+		service: service,
+	}
+}
+
+func (d *DistrictServer) GetDistricts(
+	ctx context.Context,
+	req *pb.GetDistrictsRequest,
+) (res *pb.GetDistrictsResponse, err error) {
+	filter := req.GetFilter()
+
+	responses, _, serviceErr := d.service.GetAll(ctx, filter.GetName(), model.ListQuery{})
+	if serviceErr != nil {
+		err = handleError(serviceErr, resourceDistrict, "")
+		return
+	}
+
+	res = &pb.GetDistrictsResponse{}
+
+	for _, response := range responses {
+		res.Districts = append(res.Districts, newDistrictMessage(response))
+	}
+
+	return
+}
+
+func (d *DistrictServer) GetDistrict(
+	ctx context.Context,
+	req *pb.GetDistrictRequest,
+) (res *pb.GetDistrictResponse, err error) {
+	id := req.GetId()
+	if err = validateID(resourceDistrict, "id", id); err != nil {
+		return
+	}
+
+	response, serviceErr := d.service.GetByID(ctx, id)
+	if serviceErr != nil {
+		err = handleError(serviceErr, resourceDistrict, id)
+		return
+	}
+
+	res = &pb.GetDistrictResponse{
+		District: newDistrictMessage(response),
+	}
+
+	return
+}
+
+func (d *DistrictServer) GetVillagesByDistrictID(
+	ctx context.Context,
+	req *pb.GetVillagesByDistrictIDRequest,
+) (res *pb.GetVillagesByDistrictIDResponse, err error) {
+	id := req.GetId()
+	if err = validateID(resourceDistrict, "id", id); err != nil {
+		return
+	}
+
+	responses, _, serviceErr := d.service.GetVillagesByDistrictID(ctx, id, model.ListQuery{})
+	if serviceErr != nil {
+		err = handleError(serviceErr, resourceDistrict, id)
+		return
+	}
+
+	res = &pb.GetVillagesByDistrictIDResponse{
+		Villages: newVillageMessages(responses),
+	}
+
+	return
+}
+
+func (d *DistrictServer) GetVillagesByDistrictName(
+	ctx context.Context,
+	req *pb.GetVillagesByDistrictNameRequest,
+) (res *pb.GetVillagesByDistrictNameResponse, err error) {
+	filter := req.GetFilter()
+
+	responses, _, serviceErr := d.service.GetVillagesByDistrictName(ctx, filter.GetName(), model.ListQuery{})
+	if serviceErr != nil {
+		err = handleError(serviceErr, resourceVillage, "")
+		return
+	}
+
+	res = &pb.GetVillagesByDistrictNameResponse{
+		Villages: newVillageMessages(responses),
+	}
+
+	return
+}
+
+func newDistrictMessage(m model.District) *pb.District {
+	return &pb.District{
+		Id:      m.ID,
+		Name:    m.Name,
+		Regency: newRegencyMessage(m.Regency),
+	}
+}
@@ -0,0 +1,286 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/erikrios/ponorogo-regency-api/model"
+	"github.com/erikrios/ponorogo-regency-api/pb"
+	"github.com/erikrios/ponorogo-regency-api/service"
+	"github.com/erikrios/ponorogo-regency-api/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newDistrictTestServer(service service.DistrictService) *grpc.Server {
+	server := grpc.NewServer()
+	pb.RegisterDistrictServiceServer(server, NewDistrictServer(service))
+	return server
+}
+
+func TestDistrictServer(t *testing.T) {
+	dummyDistrict := model.District{
+		ID:   "3502010",
+		Name: "NGRAYUN",
+		Regency: model.Regency{
+			ID:   "3502",
+			Name: "KABUPATEN PONOROGO",
+			Province: model.Province{
+				ID:   "35",
+				Name: "JAWA TIMUR",
+			},
+		},
+	}
+
+	dummyVillages := []model.Village{
+		{
+			ID:       "3502010001",
+			Name:     "WONODADI",
+			District: dummyDistrict,
+		},
+	}
+
+	t.Run("TestNewDistrictServer", func(t *testing.T) {
+		mockService := &mocks.DistrictService{}
+		server := NewDistrictServer(mockService)
+		assert.NotNil(t, server)
+	})
+
+	t.Run("TestGetDistricts", func(t *testing.T) {
+		mockService := &mocks.DistrictService{}
+
+		t.Run("success scenario", func(t *testing.T) {
+			mockService.On("GetAll", mock.Anything, "NGRAYUN", mock.AnythingOfType("model.ListQuery")).Return(
+				func(ctx context.Context, keyword string, query model.ListQuery) []model.District {
+					return []model.District{dummyDistrict}
+				},
+				func(ctx context.Context, keyword string, query model.ListQuery) int {
+					return len([]model.District{dummyDistrict})
+				},
+				func(ctx context.Context, keyword string, query model.ListQuery) error {
+					return nil
+				},
+			).Once()
+
+			t.Run("it should return valid districts, when there is no error", func(t *testing.T) {
+				client := pb.NewDistrictServiceClient(dialBufconn(t, newDistrictTestServer(mockService)))
+
+				got, err := client.GetDistricts(context.Background(), &pb.GetDistrictsRequest{Filter: &pb.Filter{Name: "NGRAYUN"}})
+				if assert.NoError(t, err) && assert.Equal(t, 1, len(got.GetDistricts())) {
+					gotDistrict := got.GetDistricts()[0]
+					assert.Equal(t, dummyDistrict.ID, gotDistrict.GetId())
+					assert.Equal(t, dummyDistrict.Name, gotDistrict.GetName())
+					assert.Equal(t, dummyDistrict.Regency.ID, gotDistrict.GetRegency().GetId())
+					assert.Equal(t, dummyDistrict.Regency.Province.ID, gotDistrict.GetRegency().GetProvince().GetId())
+				}
+			})
+		})
+
+		t.Run("failed scenario", func(t *testing.T) {
+			mockService.On("GetAll", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("model.ListQuery")).Return(
+				func(ctx context.Context, keyword string, query model.ListQuery) []model.District {
+					return []model.District{}
+				},
+				func(ctx context.Context, keyword string, query model.ListQuery) int {
+					return 0
+				},
+				func(ctx context.Context, keyword string, query model.ListQuery) error {
+					return service.ErrRepository
+				},
+			).Once()
+
+			t.Run("it should return Internal status code, when error happened", func(t *testing.T) {
+				client := pb.NewDistrictServiceClient(dialBufconn(t, newDistrictTestServer(mockService)))
+
+				_, err := client.GetDistricts(context.Background(), &pb.GetDistrictsRequest{})
+				assert.Equal(t, codes.Internal, status.Code(err))
+			})
+		})
+	})
+
+	t.Run("TestGetDistrict", func(t *testing.T) {
+		mockService := &mocks.DistrictService{}
+
+		t.Run("success scenario", func(t *testing.T) {
+			mockService.On("GetByID", mock.Anything, dummyDistrict.ID).Return(
+				func(ctx context.Context, id string) model.District {
+					return dummyDistrict
+				},
+				func(ctx context.Context, id string) error {
+					return nil
+				},
+			).Once()
+
+			t.Run("it should return valid district, when there is no error", func(t *testing.T) {
+				client := pb.NewDistrictServiceClient(dialBufconn(t, newDistrictTestServer(mockService)))
+
+				got, err := client.GetDistrict(context.Background(), &pb.GetDistrictRequest{Id: dummyDistrict.ID})
+				if assert.NoError(t, err) {
+					assert.Equal(t, dummyDistrict.ID, got.GetDistrict().GetId())
+					assert.Equal(t, dummyDistrict.Name, got.GetDistrict().GetName())
+					assert.Equal(t, dummyDistrict.Regency.Name, got.GetDistrict().GetRegency().GetName())
+					assert.Equal(t, dummyDistrict.Regency.Province.Name, got.GetDistrict().GetRegency().GetProvince().GetName())
+				}
+			})
+		})
+
+		t.Run("failed scenario", func(t *testing.T) {
+			mockService.On("GetByID", mock.Anything, mock.AnythingOfType("string")).Return(
+				func(ctx context.Context, id string) model.District {
+					return model.District{}
+				},
+				func(ctx context.Context, id string) error {
+					if id != dummyDistrict.ID {
+						return service.ErrDataNotFound
+					}
+					return service.ErrRepository
+				},
+			).Twice()
+
+			testCases := []struct {
+				name         string
+				id           string
+				expectedCode codes.Code
+			}{
+				{
+					name:         "it should return NotFound status code, when given ID not found",
+					id:           "3502011",
+					expectedCode: codes.NotFound,
+				},
+				{
+					name:         "it should return InvalidArgument status code, when given ID is malformed",
+					id:           "abc",
+					expectedCode: codes.InvalidArgument,
+				},
+				{
+					name:         "it should return Internal status code, when error happened",
+					id:           dummyDistrict.ID,
+					expectedCode: codes.Internal,
+				},
+			}
+
+			for _, testCase := range testCases {
+				t.Run(testCase.name, func(t *testing.T) {
+					client := pb.NewDistrictServiceClient(dialBufconn(t, newDistrictTestServer(mockService)))
+
+					_, err := client.GetDistrict(context.Background(), &pb.GetDistrictRequest{Id: testCase.id})
+					assert.Equal(t, testCase.expectedCode, status.Code(err))
+				})
+			}
+		})
+	})
+
+	t.Run("TestGetVillagesByDistrictID", func(t *testing.T) {
+		mockService := &mocks.DistrictService{}
+
+		t.Run("success scenario", func(t *testing.T) {
+			mockService.On("GetVillagesByDistrictID", mock.Anything, dummyDistrict.ID, mock.AnythingOfType("model.ListQuery")).Return(
+				func(ctx context.Context, id string, query model.ListQuery) []model.Village {
+					return dummyVillages
+				},
+				func(ctx context.Context, id string, query model.ListQuery) int {
+					return len(dummyVillages)
+				},
+				func(ctx context.Context, id string, query model.ListQuery) error {
+					return nil
+				},
+			).Once()
+
+			t.Run("it should return valid villages, when there is no error", func(t *testing.T) {
+				client := pb.NewDistrictServiceClient(dialBufconn(t, newDistrictTestServer(mockService)))
+
+				got, err := client.GetVillagesByDistrictID(context.Background(), &pb.GetVillagesByDistrictIDRequest{Id: dummyDistrict.ID})
+				if assert.NoError(t, err) && assert.Equal(t, len(dummyVillages), len(got.GetVillages())) {
+					for i, village := range dummyVillages {
+						assertVillageMessage(t, village, got.GetVillages()[i])
+					}
+				}
+			})
+		})
+
+		t.Run("failed scenario", func(t *testing.T) {
+			mockService.On("GetVillagesByDistrictID", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("model.ListQuery")).Return(
+				func(ctx context.Context, id string, query model.ListQuery) []model.Village {
+					return []model.Village{}
+				},
+				func(ctx context.Context, id string, query model.ListQuery) int {
+					return 0
+				},
+				func(ctx context.Context, id string, query model.ListQuery) error {
+					if id != dummyDistrict.ID {
+						return service.ErrDataNotFound
+					} else {
+						return service.ErrRepository
+					}
+				},
+			).Twice()
+
+			t.Run("it should return Internal status code, when error happened", func(t *testing.T) {
+				client := pb.NewDistrictServiceClient(dialBufconn(t, newDistrictTestServer(mockService)))
+
+				_, err := client.GetVillagesByDistrictID(context.Background(), &pb.GetVillagesByDistrictIDRequest{Id: dummyDistrict.ID})
+				assert.Equal(t, codes.Internal, status.Code(err))
+			})
+
+			t.Run("it should return NotFound status code, when the district doesn't exist", func(t *testing.T) {
+				client := pb.NewDistrictServiceClient(dialBufconn(t, newDistrictTestServer(mockService)))
+
+				_, err := client.GetVillagesByDistrictID(context.Background(), &pb.GetVillagesByDistrictIDRequest{Id: "3502999"})
+				assert.Equal(t, codes.NotFound, status.Code(err))
+			})
+		})
+	})
+
+	t.Run("TestGetVillagesByDistrictName", func(t *testing.T) {
+		mockService := &mocks.DistrictService{}
+
+		t.Run("success scenario", func(t *testing.T) {
+			mockService.On("GetVillagesByDistrictName", mock.Anything, "NGRA", mock.AnythingOfType("model.ListQuery")).Return(
+				func(ctx context.Context, keyword string, query model.ListQuery) []model.Village {
+					return dummyVillages
+				},
+				func(ctx context.Context, keyword string, query model.ListQuery) int {
+					return len(dummyVillages)
+				},
+				func(ctx context.Context, keyword string, query model.ListQuery) error {
+					return nil
+				},
+			).Once()
+
+			t.Run("it should return valid villages, when there is no error", func(t *testing.T) {
+				client := pb.NewDistrictServiceClient(dialBufconn(t, newDistrictTestServer(mockService)))
+
+				got, err := client.GetVillagesByDistrictName(context.Background(), &pb.GetVillagesByDistrictNameRequest{Filter: &pb.Filter{Name: "NGRA"}})
+				if assert.NoError(t, err) && assert.Equal(t, len(dummyVillages), len(got.GetVillages())) {
+					for i, village := range dummyVillages {
+						assertVillageMessage(t, village, got.GetVillages()[i])
+					}
+				}
+			})
+		})
+
+		t.Run("failed scenario", func(t *testing.T) {
+			mockService.On("GetVillagesByDistrictName", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("model.ListQuery")).Return(
+				func(ctx context.Context, keyword string, query model.ListQuery) []model.Village {
+					return []model.Village{}
+				},
+				func(ctx context.Context, keyword string, query model.ListQuery) int {
+					return 0
+				},
+				func(ctx context.Context, keyword string, query model.ListQuery) error {
+					return service.ErrRepository
+				},
+			).Once()
+
+			t.Run("it should return Internal status code, when error happened", func(t *testing.T) {
+				client := pb.NewDistrictServiceClient(dialBufconn(t, newDistrictTestServer(mockService)))
+
+				_, err := client.GetVillagesByDistrictName(context.Background(), &pb.GetVillagesByDistrictNameRequest{})
+				assert.Equal(t, codes.Internal, status.Code(err))
+			})
+		})
+	})
+}
@@ -2,25 +2,95 @@ package rpc
 
 import (
 	"errors"
+	"fmt"
+	"log"
 
 	"github.com/erikrios/ponorogo-regency-api/service"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/runtime/protoiface"
 )
 
-func handleError(from error) error {
-	var code codes.Code
-	var message string
+const errorDomain = "ponorogo-regency-api"
+
+const (
+	resourceProvince = service.LevelProvince
+	resourceRegency  = service.LevelRegency
+	resourceDistrict = service.LevelDistrict
+	resourceVillage  = service.LevelVillage
+)
+
+func handleError(from error, resource string, id string) error {
+	var idErr *service.IDError
+	if errors.As(from, &idErr) {
+		return newStatusError(
+			codes.InvalidArgument,
+			fmt.Sprintf("Invalid %s ID %q.", idErr.Level, idErr.ID),
+			&errdetails.ErrorInfo{
+				Reason:   "INVALID_ID",
+				Domain:   errorDomain,
+				Metadata: map[string]string{"resource_type": idErr.Level, "id": idErr.ID},
+			},
+			&errdetails.BadRequest{
+				FieldViolations: []*errdetails.BadRequest_FieldViolation{
+					{
+						Field:       idErr.Field,
+						Description: fmt.Sprintf("%s ID %s", idErr.Level, idErr.Reason),
+					},
+				},
+			},
+		)
+	}
+
 	if errors.Is(from, service.ErrDataNotFound) {
-		code = codes.NotFound
-		message = "Resource with given ID not found."
-	} else if errors.Is(from, service.ErrRepository) {
-		code = codes.Internal
-		message = "Something went wrong."
-	} else {
-		code = codes.Internal
-		message = "Something went wrong."
+		return newStatusError(
+			codes.NotFound,
+			fmt.Sprintf("No %s found with ID %s.", resource, id),
+			&errdetails.ErrorInfo{
+				Reason:   "RESOURCE_NOT_FOUND",
+				Domain:   errorDomain,
+				Metadata: map[string]string{"resource_type": resource, "id": id},
+			},
+			&errdetails.ResourceInfo{
+				ResourceType: resource,
+				ResourceName: id,
+				Description:  fmt.Sprintf("%s with ID %s does not exist.", resource, id),
+			},
+		)
+	}
+
+	metadata := map[string]string{"resource_type": resource}
+	if id != "" {
+		metadata["id"] = id
+	}
+
+	return newStatusError(
+		codes.Internal,
+		"Something went wrong.",
+		&errdetails.ErrorInfo{
+			Reason:   "INTERNAL",
+			Domain:   errorDomain,
+			Metadata: metadata,
+		},
+	)
+}
+
+func validateID(resource string, field string, id string) error {
+	if err := service.ValidateID(resource, field, id); err != nil {
+		return handleError(err, resource, id)
+	}
+	return nil
+}
+
+func newStatusError(code codes.Code, message string, details ...protoiface.MessageV1) error {
+	st := status.New(code, message)
+
+	withDetails, err := st.WithDetails(details...)
+	if err != nil {
+		log.Println(err)
+		return st.Err()
 	}
 
-	return status.Errorf(code, message)
+	return withDetails.Err()
 }
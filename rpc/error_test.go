@@ -0,0 +1,114 @@
+package rpc
+
+import (
+	"testing"
+
+	"github.com/erikrios/ponorogo-regency-api/service"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestError(t *testing.T) {
+	t.Run("TestHandleError", func(t *testing.T) {
+		t.Run("it should return NotFound status with the resource type and ID, when data not found", func(t *testing.T) {
+			st := status.Convert(handleError(service.ErrDataNotFound, resourceDistrict, "3502011"))
+			assert.Equal(t, codes.NotFound, st.Code())
+			assert.Equal(t, "No district found with ID 3502011.", st.Message())
+
+			details := st.Details()
+			if assert.Len(t, details, 2) {
+				if errorInfo, ok := details[0].(*errdetails.ErrorInfo); assert.True(t, ok) {
+					assert.Equal(t, "RESOURCE_NOT_FOUND", errorInfo.GetReason())
+					assert.Equal(t, errorDomain, errorInfo.GetDomain())
+					assert.Equal(t, map[string]string{"resource_type": "district", "id": "3502011"}, errorInfo.GetMetadata())
+				}
+				if resourceInfo, ok := details[1].(*errdetails.ResourceInfo); assert.True(t, ok) {
+					assert.Equal(t, "district", resourceInfo.GetResourceType())
+					assert.Equal(t, "3502011", resourceInfo.GetResourceName())
+				}
+			}
+		})
+
+		t.Run("it should return InvalidArgument status with the offending field, when ID is invalid", func(t *testing.T) {
+			from := &service.IDError{Level: "district", Field: "district_id", ID: "3602010", Reason: `doesn't match regency ID "3502"`}
+
+			st := status.Convert(handleError(from, resourceRegency, "3502"))
+			assert.Equal(t, codes.InvalidArgument, st.Code())
+			assert.Equal(t, `Invalid district ID "3602010".`, st.Message())
+
+			details := st.Details()
+			if assert.Len(t, details, 2) {
+				if badRequest, ok := details[1].(*errdetails.BadRequest); assert.True(t, ok) && assert.Len(t, badRequest.GetFieldViolations(), 1) {
+					assert.Equal(t, "district_id", badRequest.GetFieldViolations()[0].GetField())
+					assert.Equal(t, `district ID doesn't match regency ID "3502"`, badRequest.GetFieldViolations()[0].GetDescription())
+				}
+			}
+		})
+
+		t.Run("it should return Internal status with the resource type, when repository error happened", func(t *testing.T) {
+			st := status.Convert(handleError(service.ErrRepository, resourceVillage, ""))
+			assert.Equal(t, codes.Internal, st.Code())
+			assert.Equal(t, "Something went wrong.", st.Message())
+
+			details := st.Details()
+			if assert.Len(t, details, 1) {
+				if errorInfo, ok := details[0].(*errdetails.ErrorInfo); assert.True(t, ok) {
+					assert.Equal(t, "INTERNAL", errorInfo.GetReason())
+					assert.Equal(t, map[string]string{"resource_type": "village"}, errorInfo.GetMetadata())
+				}
+			}
+		})
+	})
+
+	t.Run("TestValidateID", func(t *testing.T) {
+		validTestCases := []struct {
+			name     string
+			resource string
+			id       string
+		}{
+			{name: "it should accept a province ID", resource: resourceProvince, id: "35"},
+			{name: "it should accept a regency ID", resource: resourceRegency, id: "3502"},
+			{name: "it should accept a district ID", resource: resourceDistrict, id: "3502010"},
+			{name: "it should accept a village ID", resource: resourceVillage, id: "3502010001"},
+		}
+
+		for _, testCase := range validTestCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				assert.NoError(t, validateID(testCase.resource, "id", testCase.id))
+			})
+		}
+
+		invalidTestCases := []struct {
+			name     string
+			resource string
+			field    string
+			id       string
+		}{
+			{name: "it should reject an empty ID", resource: resourceProvince, field: "id", id: ""},
+			{name: "it should reject an ID with non-digit characters", resource: resourceRegency, field: "id", id: "35O2"},
+			{name: "it should reject an ID with a wrong length", resource: resourceDistrict, field: "district_id", id: "35020100"},
+		}
+
+		for _, testCase := range invalidTestCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				st := status.Convert(validateID(testCase.resource, testCase.field, testCase.id))
+				assert.Equal(t, codes.InvalidArgument, st.Code())
+
+				details := st.Details()
+				if assert.Len(t, details, 2) {
+					if errorInfo, ok := details[0].(*errdetails.ErrorInfo); assert.True(t, ok) {
+						assert.Equal(t, "INVALID_ID", errorInfo.GetReason())
+						assert.Equal(t, testCase.resource, errorInfo.GetMetadata()["resource_type"])
+						assert.Equal(t, testCase.id, errorInfo.GetMetadata()["id"])
+					}
+					if badRequest, ok := details[1].(*errdetails.BadRequest); assert.True(t, ok) && assert.Len(t, badRequest.GetFieldViolations(), 1) {
+						assert.Equal(t, testCase.field, badRequest.GetFieldViolations()[0].GetField())
+						assert.NotEmpty(t, badRequest.GetFieldViolations()[0].GetDescription())
+					}
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,40 @@
+package rpc
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/erikrios/ponorogo-regency-api/pb"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func NewGateway(ctx context.Context, conn *grpc.ClientConn) (http.Handler, error) {
+	mux := runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
+			MarshalOptions: protojson.MarshalOptions{
+				UseProtoNames:   true,
+				EmitUnpopulated: true,
+			},
+			UnmarshalOptions: protojson.UnmarshalOptions{
+				DiscardUnknown: true,
+			},
+		}),
+	)
+
+	registers := []func(context.Context, *runtime.ServeMux, *grpc.ClientConn) error{
+		pb.RegisterProvinceServiceHandler,
+		pb.RegisterRegencyServiceHandler,
+		pb.RegisterDistrictServiceHandler,
+		pb.RegisterVillageServiceHandler,
+	}
+
+	for _, register := range registers {
+		if err := register(ctx, mux, conn); err != nil {
+			return nil, err
+		}
+	}
+
+	return mux, nil
+}
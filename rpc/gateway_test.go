@@ -0,0 +1,106 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/erikrios/ponorogo-regency-api/model"
+	"github.com/erikrios/ponorogo-regency-api/service"
+	"github.com/erikrios/ponorogo-regency-api/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGateway(t *testing.T) {
+	dummyProvince := model.Province{
+		ID:   "35",
+		Name: "JAWA TIMUR",
+	}
+
+	dummyVillages := []model.Village{
+		{
+			ID:   "3502010001",
+			Name: "BAOSANKIDUL",
+			District: model.District{
+				ID:   "3502010",
+				Name: "NGRAYUN",
+				Regency: model.Regency{
+					ID:       "3502",
+					Name:     "KABUPATEN PONOROGO",
+					Province: dummyProvince,
+				},
+			},
+		},
+	}
+
+	mockProvinceService := &mocks.ProvinceService{}
+	mockProvinceService.On("GetByID", mock.Anything, dummyProvince.ID).Return(dummyProvince, nil)
+	mockProvinceService.On("GetByID", mock.Anything, "36").Return(model.Province{}, service.ErrDataNotFound)
+
+	mockDistrictService := &mocks.DistrictService{}
+	mockDistrictService.On("GetVillagesByDistrictName", mock.Anything, "NGRA", mock.AnythingOfType("model.ListQuery")).Return(dummyVillages, len(dummyVillages), nil)
+
+	conn := dialBufconn(t, NewServer(
+		mockProvinceService,
+		&mocks.RegencyService{},
+		mockDistrictService,
+		&mocks.VillageService{},
+	))
+
+	gateway, err := NewGateway(context.Background(), conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("it should return 200 status code with the province, when the province exists", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v2/provinces/35", nil)
+		rec := httptest.NewRecorder()
+		gateway.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		response := make(map[string]any)
+		if assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response)) {
+			province := response["province"].(map[string]any)
+			assert.Equal(t, dummyProvince.ID, province["id"])
+			assert.Equal(t, dummyProvince.Name, province["name"])
+		}
+	})
+
+	t.Run("it should return 404 status code, when the province does not exist", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v2/provinces/36", nil)
+		rec := httptest.NewRecorder()
+		gateway.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("it should return 400 status code, when the province ID is malformed", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v2/provinces/abc", nil)
+		rec := httptest.NewRecorder()
+		gateway.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("it should route to the villages by district name, when the path is not a district ID", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v2/districts/villages?filter.name=NGRA", nil)
+		rec := httptest.NewRecorder()
+		gateway.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		response := make(map[string]any)
+		if assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response)) {
+			villages := response["villages"].([]any)
+			if assert.Len(t, villages, 1) {
+				village := villages[0].(map[string]any)
+				assert.Equal(t, dummyVillages[0].ID, village["id"])
+				assert.Equal(t, dummyVillages[0].District.ID, village["district"].(map[string]any)["id"])
+			}
+		}
+	})
+}
@@ -0,0 +1,53 @@
+package rpc
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/erikrios/ponorogo-regency-api/pb"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+type Pinger interface {
+	PingContext(ctx context.Context) error
+}
+
+func WatchHealth(ctx context.Context, server *health.Server, pinger Pinger, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		checkHealth(ctx, server, pinger, interval)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func checkHealth(ctx context.Context, server *health.Server, pinger Pinger, timeout time.Duration) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	servingStatus := healthpb.HealthCheckResponse_SERVING
+	if err := pinger.PingContext(ctx); err != nil {
+		log.Printf("health check failed: %s\n", err.Error())
+		servingStatus = healthpb.HealthCheckResponse_NOT_SERVING
+	}
+
+	for _, service := range healthCheckedServices {
+		server.SetServingStatus(service, servingStatus)
+	}
+}
+
+var healthCheckedServices = []string{
+	"",
+	pb.ProvinceService_ServiceDesc.ServiceName,
+	pb.RegencyService_ServiceDesc.ServiceName,
+	pb.DistrictService_ServiceDesc.ServiceName,
+	pb.VillageService_ServiceDesc.ServiceName,
+}
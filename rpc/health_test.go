@@ -0,0 +1,82 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+type fakePinger struct {
+	err error
+}
+
+func (f *fakePinger) PingContext(ctx context.Context) error {
+	return f.err
+}
+
+func TestHealth(t *testing.T) {
+	t.Run("TestCheckHealth", func(t *testing.T) {
+		testCases := []struct {
+			name     string
+			pingErr  error
+			expected healthpb.HealthCheckResponse_ServingStatus
+		}{
+			{
+				name:     "it should report SERVING, when the database answers the ping",
+				pingErr:  nil,
+				expected: healthpb.HealthCheckResponse_SERVING,
+			},
+			{
+				name:     "it should report NOT_SERVING, when the database does not answer the ping",
+				pingErr:  errors.New("connection refused"),
+				expected: healthpb.HealthCheckResponse_NOT_SERVING,
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				server := health.NewServer()
+				checkHealth(context.Background(), server, &fakePinger{err: testCase.pingErr}, time.Second)
+
+				for _, service := range healthCheckedServices {
+					got, err := server.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+					if assert.NoError(t, err) {
+						assert.Equal(t, testCase.expected, got.GetStatus())
+					}
+				}
+			})
+		}
+	})
+
+	t.Run("TestWatchHealth", func(t *testing.T) {
+		t.Run("it should check the database immediately and stop, when the context is done", func(t *testing.T) {
+			server := health.NewServer()
+			pinger := &fakePinger{err: errors.New("connection refused")}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			done := make(chan struct{})
+			go func() {
+				WatchHealth(ctx, server, pinger, time.Hour)
+				close(done)
+			}()
+
+			assert.Eventually(t, func() bool {
+				got, err := server.Check(context.Background(), &healthpb.HealthCheckRequest{})
+				return err == nil && got.GetStatus() == healthpb.HealthCheckResponse_NOT_SERVING
+			}, time.Second, 10*time.Millisecond)
+
+			cancel()
+
+			select {
+			case <-done:
+			case <-time.After(time.Second):
+				t.Fatal("WatchHealth did not return after the context was cancelled")
+			}
+		})
+	})
+}
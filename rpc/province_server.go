@@ -3,6 +3,7 @@ package rpc
 import (
 	"context"
 
+	"github.com/erikrios/ponorogo-regency-api/model"
 	"github.com/erikrios/ponorogo-regency-api/pb"
 	"github.com/erikrios/ponorogo-regency-api/service"
 )
@@ -24,20 +25,16 @@ func (p *ProvinceServer) GetProvinces(
 ) (res *pb.GetProvincesResponse, err error) {
 	filter := req.GetFilter()
 
-	responses, serviceErr := p.service.GetAll(ctx, filter.GetName())
+	responses, _, serviceErr := p.service.GetAll(ctx, filter.GetName(), model.ListQuery{})
 	if serviceErr != nil {
-		err = handleError(serviceErr)
+		err = handleError(serviceErr, resourceProvince, "")
 		return
 	}
 
 	res = &pb.GetProvincesResponse{}
 
 	for _, response := range responses {
-		province := &pb.Province{
-			Id:   response.ID,
-			Name: response.Name,
-		}
-		res.Provinces = append(res.Provinces, province)
+		res.Provinces = append(res.Provinces, newProvinceMessage(response))
 	}
 
 	return
@@ -48,19 +45,26 @@ func (p *ProvinceServer) GetProvince(
 	req *pb.GetProvinceRequest,
 ) (res *pb.GetProvinceResponse, err error) {
 	id := req.GetId()
+	if err = validateID(resourceProvince, "id", id); err != nil {
+		return
+	}
 
 	response, serviceErr := p.service.GetByID(ctx, id)
 	if serviceErr != nil {
-		err = handleError(serviceErr)
+		err = handleError(serviceErr, resourceProvince, id)
 		return
 	}
 
 	res = &pb.GetProvinceResponse{
-		Province: &pb.Province{
-			Id:   response.ID,
-			Name: response.Name,
-		},
+		Province: newProvinceMessage(response),
 	}
 
 	return
 }
+
+func newProvinceMessage(m model.Province) *pb.Province {
+	return &pb.Province{
+		Id:   m.ID,
+		Name: m.Name,
+	}
+}
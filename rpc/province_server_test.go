@@ -0,0 +1,165 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/erikrios/ponorogo-regency-api/model"
+	"github.com/erikrios/ponorogo-regency-api/pb"
+	"github.com/erikrios/ponorogo-regency-api/service"
+	"github.com/erikrios/ponorogo-regency-api/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newProvinceTestServer(service service.ProvinceService) *grpc.Server {
+	server := grpc.NewServer()
+	pb.RegisterProvinceServiceServer(server, NewProvinceServer(service))
+	return server
+}
+
+func TestProvinceServer(t *testing.T) {
+	t.Run("TestNewProvinceServer", func(t *testing.T) {
+		mockService := &mocks.ProvinceService{}
+		server := NewProvinceServer(mockService)
+		assert.NotNil(t, server)
+	})
+
+	t.Run("TestGetProvinces", func(t *testing.T) {
+		mockService := &mocks.ProvinceService{}
+
+		dummyProvinces := []model.Province{
+			{
+				ID:   "35",
+				Name: "JAWA TIMUR",
+			},
+		}
+
+		t.Run("success scenario", func(t *testing.T) {
+			mockService.On("GetAll", mock.Anything, "JAWA", mock.AnythingOfType("model.ListQuery")).Return(
+				func(ctx context.Context, keyword string, query model.ListQuery) []model.Province {
+					return dummyProvinces
+				},
+				func(ctx context.Context, keyword string, query model.ListQuery) int {
+					return len(dummyProvinces)
+				},
+				func(ctx context.Context, keyword string, query model.ListQuery) error {
+					return nil
+				},
+			).Once()
+
+			t.Run("it should return valid provinces, when there is no error", func(t *testing.T) {
+				client := pb.NewProvinceServiceClient(dialBufconn(t, newProvinceTestServer(mockService)))
+
+				got, err := client.GetProvinces(context.Background(), &pb.GetProvincesRequest{Filter: &pb.Filter{Name: "JAWA"}})
+				if assert.NoError(t, err) {
+					if assert.Equal(t, len(dummyProvinces), len(got.GetProvinces())) {
+						for i, province := range dummyProvinces {
+							assert.Equal(t, province.ID, got.GetProvinces()[i].GetId())
+							assert.Equal(t, province.Name, got.GetProvinces()[i].GetName())
+						}
+					}
+				}
+			})
+		})
+
+		t.Run("failed scenario", func(t *testing.T) {
+			mockService.On("GetAll", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("model.ListQuery")).Return(
+				func(ctx context.Context, keyword string, query model.ListQuery) []model.Province {
+					return []model.Province{}
+				},
+				func(ctx context.Context, keyword string, query model.ListQuery) int {
+					return 0
+				},
+				func(ctx context.Context, keyword string, query model.ListQuery) error {
+					return service.ErrRepository
+				},
+			).Once()
+
+			t.Run("it should return Internal status code, when error happened", func(t *testing.T) {
+				client := pb.NewProvinceServiceClient(dialBufconn(t, newProvinceTestServer(mockService)))
+
+				_, err := client.GetProvinces(context.Background(), &pb.GetProvincesRequest{})
+				assert.Equal(t, codes.Internal, status.Code(err))
+			})
+		})
+	})
+
+	t.Run("TestGetProvince", func(t *testing.T) {
+		mockService := &mocks.ProvinceService{}
+
+		dummyProvince := model.Province{
+			ID:   "35",
+			Name: "JAWA TIMUR",
+		}
+
+		t.Run("success scenario", func(t *testing.T) {
+			mockService.On("GetByID", mock.Anything, dummyProvince.ID).Return(
+				func(ctx context.Context, id string) model.Province {
+					return dummyProvince
+				},
+				func(ctx context.Context, id string) error {
+					return nil
+				},
+			).Once()
+
+			t.Run("it should return valid province, when there is no error", func(t *testing.T) {
+				client := pb.NewProvinceServiceClient(dialBufconn(t, newProvinceTestServer(mockService)))
+
+				got, err := client.GetProvince(context.Background(), &pb.GetProvinceRequest{Id: dummyProvince.ID})
+				if assert.NoError(t, err) {
+					assert.Equal(t, dummyProvince.ID, got.GetProvince().GetId())
+					assert.Equal(t, dummyProvince.Name, got.GetProvince().GetName())
+				}
+			})
+		})
+
+		t.Run("failed scenario", func(t *testing.T) {
+			mockService.On("GetByID", mock.Anything, mock.AnythingOfType("string")).Return(
+				func(ctx context.Context, id string) model.Province {
+					return model.Province{}
+				},
+				func(ctx context.Context, id string) error {
+					if id != dummyProvince.ID {
+						return service.ErrDataNotFound
+					}
+					return service.ErrRepository
+				},
+			).Twice()
+
+			testCases := []struct {
+				name         string
+				id           string
+				expectedCode codes.Code
+			}{
+				{
+					name:         "it should return NotFound status code, when given ID not found",
+					id:           "36",
+					expectedCode: codes.NotFound,
+				},
+				{
+					name:         "it should return InvalidArgument status code, when given ID is malformed",
+					id:           "abc",
+					expectedCode: codes.InvalidArgument,
+				},
+				{
+					name:         "it should return Internal status code, when error happened",
+					id:           dummyProvince.ID,
+					expectedCode: codes.Internal,
+				},
+			}
+
+			for _, testCase := range testCases {
+				t.Run(testCase.name, func(t *testing.T) {
+					client := pb.NewProvinceServiceClient(dialBufconn(t, newProvinceTestServer(mockService)))
+
+					_, err := client.GetProvince(context.Background(), &pb.GetProvinceRequest{Id: testCase.id})
+					assert.Equal(t, testCase.expectedCode, status.Code(err))
+				})
+			}
+		})
+	})
+}
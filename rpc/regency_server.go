@@ -0,0 +1,71 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/erikrios/ponorogo-regency-api/model"
+	"github.com/erikrios/ponorogo-regency-api/pb"
+	"github.com/erikrios/ponorogo-regency-api/service"
+)
+
+type RegencyServer struct {
+	pb.UnimplementedRegencyServiceServer
+	service service.RegencyService
+}
+
+func NewRegencyServer(service service.RegencyService) *RegencyServer {
+	return &RegencyServer{
+		service: service,
+	}
+}
+
+func (r *RegencyServer) GetRegencies(
+	ctx context.Context,
+	req *pb.GetRegenciesRequest,
+) (res *pb.GetRegenciesResponse, err error) {
+	filter := req.GetFilter()
+
+	responses, _, serviceErr := r.service.GetAll(ctx, filter.GetName(), model.ListQuery{})
+	if serviceErr != nil {
+		err = handleError(serviceErr, resourceRegency, "")
+		return
+	}
+
+	res = &pb.GetRegenciesResponse{}
+
+	for _, response := range responses {
+		res.Regencies = append(res.Regencies, newRegencyMessage(response))
+	}
+
+	return
+}
+
+func (r *RegencyServer) GetRegency(
+	ctx context.Context,
+	req *pb.GetRegencyRequest,
+) (res *pb.GetRegencyResponse, err error) {
+	id := req.GetId()
+	if err = validateID(resourceRegency, "id", id); err != nil {
+		return
+	}
+
+	response, serviceErr := r.service.GetByID(ctx, id)
+	if serviceErr != nil {
+		err = handleError(serviceErr, resourceRegency, id)
+		return
+	}
+
+	res = &pb.GetRegencyResponse{
+		Regency: newRegencyMessage(response),
+	}
+
+	return
+}
+
+func newRegencyMessage(m model.Regency) *pb.Regency {
+	return &pb.Regency{
+		Id:       m.ID,
+		Name:     m.Name,
+		Province: newProvinceMessage(m.Province),
+	}
+}
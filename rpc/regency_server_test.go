@@ -0,0 +1,178 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/erikrios/ponorogo-regency-api/model"
+	"github.com/erikrios/ponorogo-regency-api/pb"
+	"github.com/erikrios/ponorogo-regency-api/service"
+	"github.com/erikrios/ponorogo-regency-api/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newRegencyTestServer(service service.RegencyService) *grpc.Server {
+	server := grpc.NewServer()
+	pb.RegisterRegencyServiceServer(server, NewRegencyServer(service))
+	return server
+}
+
+func TestRegencyServer(t *testing.T) {
+	t.Run("TestNewRegencyServer", func(t *testing.T) {
+		mockService := &mocks.RegencyService{}
+		server := NewRegencyServer(mockService)
+		assert.NotNil(t, server)
+	})
+
+	t.Run("TestGetRegencies", func(t *testing.T) {
+		mockService := &mocks.RegencyService{}
+
+		dummyRegencies := []model.Regency{
+			{
+				ID:   "3502",
+				Name: "KABUPATEN PONOROGO",
+				Province: model.Province{
+					ID:   "35",
+					Name: "JAWA TIMUR",
+				},
+			},
+		}
+
+		t.Run("success scenario", func(t *testing.T) {
+			mockService.On("GetAll", mock.Anything, "PONOROGO", mock.AnythingOfType("model.ListQuery")).Return(
+				func(ctx context.Context, keyword string, query model.ListQuery) []model.Regency {
+					return dummyRegencies
+				},
+				func(ctx context.Context, keyword string, query model.ListQuery) int {
+					return len(dummyRegencies)
+				},
+				func(ctx context.Context, keyword string, query model.ListQuery) error {
+					return nil
+				},
+			).Once()
+
+			t.Run("it should return valid regencies, when there is no error", func(t *testing.T) {
+				client := pb.NewRegencyServiceClient(dialBufconn(t, newRegencyTestServer(mockService)))
+
+				got, err := client.GetRegencies(context.Background(), &pb.GetRegenciesRequest{Filter: &pb.Filter{Name: "PONOROGO"}})
+				if assert.NoError(t, err) {
+					if assert.Equal(t, len(dummyRegencies), len(got.GetRegencies())) {
+						for i, regency := range dummyRegencies {
+							gotRegency := got.GetRegencies()[i]
+							assert.Equal(t, regency.ID, gotRegency.GetId())
+							assert.Equal(t, regency.Name, gotRegency.GetName())
+							assert.Equal(t, regency.Province.ID, gotRegency.GetProvince().GetId())
+							assert.Equal(t, regency.Province.Name, gotRegency.GetProvince().GetName())
+						}
+					}
+				}
+			})
+		})
+
+		t.Run("failed scenario", func(t *testing.T) {
+			mockService.On("GetAll", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("model.ListQuery")).Return(
+				func(ctx context.Context, keyword string, query model.ListQuery) []model.Regency {
+					return []model.Regency{}
+				},
+				func(ctx context.Context, keyword string, query model.ListQuery) int {
+					return 0
+				},
+				func(ctx context.Context, keyword string, query model.ListQuery) error {
+					return service.ErrRepository
+				},
+			).Once()
+
+			t.Run("it should return Internal status code, when error happened", func(t *testing.T) {
+				client := pb.NewRegencyServiceClient(dialBufconn(t, newRegencyTestServer(mockService)))
+
+				_, err := client.GetRegencies(context.Background(), &pb.GetRegenciesRequest{})
+				assert.Equal(t, codes.Internal, status.Code(err))
+			})
+		})
+	})
+
+	t.Run("TestGetRegency", func(t *testing.T) {
+		mockService := &mocks.RegencyService{}
+
+		dummyRegency := model.Regency{
+			ID:   "3502",
+			Name: "KABUPATEN PONOROGO",
+			Province: model.Province{
+				ID:   "35",
+				Name: "JAWA TIMUR",
+			},
+		}
+
+		t.Run("success scenario", func(t *testing.T) {
+			mockService.On("GetByID", mock.Anything, dummyRegency.ID).Return(
+				func(ctx context.Context, id string) model.Regency {
+					return dummyRegency
+				},
+				func(ctx context.Context, id string) error {
+					return nil
+				},
+			).Once()
+
+			t.Run("it should return valid regency, when there is no error", func(t *testing.T) {
+				client := pb.NewRegencyServiceClient(dialBufconn(t, newRegencyTestServer(mockService)))
+
+				got, err := client.GetRegency(context.Background(), &pb.GetRegencyRequest{Id: dummyRegency.ID})
+				if assert.NoError(t, err) {
+					assert.Equal(t, dummyRegency.ID, got.GetRegency().GetId())
+					assert.Equal(t, dummyRegency.Name, got.GetRegency().GetName())
+					assert.Equal(t, dummyRegency.Province.ID, got.GetRegency().GetProvince().GetId())
+					assert.Equal(t, dummyRegency.Province.Name, got.GetRegency().GetProvince().GetName())
+				}
+			})
+		})
+
+		t.Run("failed scenario", func(t *testing.T) {
+			mockService.On("GetByID", mock.Anything, mock.AnythingOfType("string")).Return(
+				func(ctx context.Context, id string) model.Regency {
+					return model.Regency{}
+				},
+				func(ctx context.Context, id string) error {
+					if id != dummyRegency.ID {
+						return service.ErrDataNotFound
+					}
+					return service.ErrRepository
+				},
+			).Twice()
+
+			testCases := []struct {
+				name         string
+				id           string
+				expectedCode codes.Code
+			}{
+				{
+					name:         "it should return NotFound status code, when given ID not found",
+					id:           "3503",
+					expectedCode: codes.NotFound,
+				},
+				{
+					name:         "it should return InvalidArgument status code, when given ID is malformed",
+					id:           "abc",
+					expectedCode: codes.InvalidArgument,
+				},
+				{
+					name:         "it should return Internal status code, when error happened",
+					id:           dummyRegency.ID,
+					expectedCode: codes.Internal,
+				},
+			}
+
+			for _, testCase := range testCases {
+				t.Run(testCase.name, func(t *testing.T) {
+					client := pb.NewRegencyServiceClient(dialBufconn(t, newRegencyTestServer(mockService)))
+
+					_, err := client.GetRegency(context.Background(), &pb.GetRegencyRequest{Id: testCase.id})
+					assert.Equal(t, testCase.expectedCode, status.Code(err))
+				})
+			}
+		})
+	})
+}
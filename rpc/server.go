@@ -0,0 +1,24 @@
+package rpc
+
+import (
+	"github.com/erikrios/ponorogo-regency-api/pb"
+	"github.com/erikrios/ponorogo-regency-api/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+func NewServer(
+	provinceService service.ProvinceService,
+	regencyService service.RegencyService,
+	districtService service.DistrictService,
+	villageService service.VillageService,
+	opts ...grpc.ServerOption,
+) *grpc.Server {
+	server := grpc.NewServer(opts...)
+	pb.RegisterProvinceServiceServer(server, NewProvinceServer(provinceService))
+	pb.RegisterRegencyServiceServer(server, NewRegencyServer(regencyService))
+	pb.RegisterDistrictServiceServer(server, NewDistrictServer(districtService))
+	pb.RegisterVillageServiceServer(server, NewVillageServer(villageService))
+	reflection.Register(server)
+	return server
+}
@@ -0,0 +1,61 @@
+package rpc
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/erikrios/ponorogo-regency-api/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func dialBufconn(t *testing.T, server *grpc.Server) *grpc.ClientConn {
+	listener := bufconn.Listen(1024 * 1024)
+
+	go func() {
+		if err := server.Serve(listener); err != nil {
+			t.Log(err)
+		}
+	}()
+
+	conn, err := grpc.DialContext(
+		context.Background(),
+		"bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, s string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		conn.Close()
+		server.Stop()
+	})
+
+	return conn
+}
+
+func TestServer(t *testing.T) {
+	t.Run("TestNewServer", func(t *testing.T) {
+		server := NewServer(
+			&mocks.ProvinceService{},
+			&mocks.RegencyService{},
+			&mocks.DistrictService{},
+			&mocks.VillageService{},
+		)
+		assert.NotNil(t, server)
+
+		serviceInfo := server.GetServiceInfo()
+		assert.Contains(t, serviceInfo, "erikrios.ponorogoregencyapi.ProvinceService")
+		assert.Contains(t, serviceInfo, "erikrios.ponorogoregencyapi.RegencyService")
+		assert.Contains(t, serviceInfo, "erikrios.ponorogoregencyapi.DistrictService")
+		assert.Contains(t, serviceInfo, "erikrios.ponorogoregencyapi.VillageService")
+		assert.Contains(t, serviceInfo, "grpc.reflection.v1alpha.ServerReflection")
+	})
+}
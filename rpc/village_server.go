@@ -0,0 +1,110 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/erikrios/ponorogo-regency-api/model"
+	"github.com/erikrios/ponorogo-regency-api/pb"
+	"github.com/erikrios/ponorogo-regency-api/service"
+	"google.golang.org/grpc/status"
+)
+
+type VillageServer struct {
+	pb.UnimplementedVillageServiceServer
+	service service.VillageService
+}
+
+func NewVillageServer(service service.VillageService) *VillageServer {
+	return &VillageServer{
+		service: service,
+	}
+}
+
+func (v *VillageServer) GetVillages(
+	ctx context.Context,
+	req *pb.GetVillagesRequest,
+) (res *pb.GetVillagesResponse, err error) {
+	filter := req.GetFilter()
+
+	responses, _, serviceErr := v.service.GetAll(ctx, filter.GetName(), model.ListQuery{})
+	if serviceErr != nil {
+		err = handleError(serviceErr, resourceVillage, "")
+		return
+	}
+
+	res = &pb.GetVillagesResponse{
+		Villages: newVillageMessages(responses),
+	}
+
+	return
+}
+
+func (v *VillageServer) GetVillage(
+	ctx context.Context,
+	req *pb.GetVillageRequest,
+) (res *pb.GetVillageResponse, err error) {
+	id := req.GetId()
+	if err = validateID(resourceVillage, "id", id); err != nil {
+		return
+	}
+
+	response, serviceErr := v.service.GetByID(ctx, id)
+	if serviceErr != nil {
+		err = handleError(serviceErr, resourceVillage, id)
+		return
+	}
+
+	res = &pb.GetVillageResponse{
+		Village: newVillageMessage(response),
+	}
+
+	return
+}
+
+func (v *VillageServer) StreamVillages(
+	req *pb.StreamVillagesRequest,
+	stream pb.VillageService_StreamVillagesServer,
+) (err error) {
+	districtID := req.GetDistrictId()
+	if districtID != "" {
+		if err = validateID(resourceDistrict, "district_id", districtID); err != nil {
+			return
+		}
+	}
+
+	serviceErr := v.service.Stream(
+		stream.Context(),
+		districtID,
+		req.GetDistrictName(),
+		func(response model.Village) error {
+			return stream.Send(newVillageMessage(response))
+		},
+	)
+	if serviceErr != nil {
+		if _, ok := status.FromError(serviceErr); ok {
+			err = serviceErr
+			return
+		}
+		err = handleError(serviceErr, resourceVillage, "")
+	}
+
+	return
+}
+
+func newVillageMessage(m model.Village) *pb.Village {
+	return &pb.Village{
+		Id:       m.ID,
+		Name:     m.Name,
+		District: newDistrictMessage(m.District),
+	}
+}
+
+func newVillageMessages(models []model.Village) []*pb.Village {
+	villages := make([]*pb.Village, len(models))
+
+	for i, m := range models {
+		villages[i] = newVillageMessage(m)
+	}
+
+	return villages
+}
@@ -0,0 +1,296 @@
+package rpc
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/erikrios/ponorogo-regency-api/model"
+	"github.com/erikrios/ponorogo-regency-api/pb"
+	"github.com/erikrios/ponorogo-regency-api/service"
+	"github.com/erikrios/ponorogo-regency-api/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newVillageTestServer(service service.VillageService) *grpc.Server {
+	server := grpc.NewServer()
+	pb.RegisterVillageServiceServer(server, NewVillageServer(service))
+	return server
+}
+
+func assertVillageMessage(t *testing.T, expected model.Village, got *pb.Village) {
+	assert.Equal(t, expected.ID, got.GetId())
+	assert.Equal(t, expected.Name, got.GetName())
+	assert.Equal(t, expected.District.ID, got.GetDistrict().GetId())
+	assert.Equal(t, expected.District.Name, got.GetDistrict().GetName())
+	assert.Equal(t, expected.District.Regency.ID, got.GetDistrict().GetRegency().GetId())
+	assert.Equal(t, expected.District.Regency.Name, got.GetDistrict().GetRegency().GetName())
+	assert.Equal(t, expected.District.Regency.Province.ID, got.GetDistrict().GetRegency().GetProvince().GetId())
+	assert.Equal(t, expected.District.Regency.Province.Name, got.GetDistrict().GetRegency().GetProvince().GetName())
+}
+
+func TestVillageServer(t *testing.T) {
+	t.Run("TestNewVillageServer", func(t *testing.T) {
+		mockService := &mocks.VillageService{}
+		server := NewVillageServer(mockService)
+		assert.NotNil(t, server)
+	})
+
+	t.Run("TestGetVillages", func(t *testing.T) {
+		mockService := &mocks.VillageService{}
+
+		dummyVillages := []model.Village{
+			{
+				ID:   "3502010001",
+				Name: "WONODADI",
+				District: model.District{
+					ID:   "3502010",
+					Name: "NGRAYUN",
+					Regency: model.Regency{
+						ID:   "3502",
+						Name: "KABUPATEN PONOROGO",
+						Province: model.Province{
+							ID:   "35",
+							Name: "JAWA TIMUR",
+						},
+					},
+				},
+			},
+		}
+
+		t.Run("success scenario", func(t *testing.T) {
+			mockService.On("GetAll", mock.Anything, "WONO", mock.AnythingOfType("model.ListQuery")).Return(
+				func(ctx context.Context, keyword string, query model.ListQuery) []model.Village {
+					return dummyVillages
+				},
+				func(ctx context.Context, keyword string, query model.ListQuery) int {
+					return len(dummyVillages)
+				},
+				func(ctx context.Context, keyword string, query model.ListQuery) error {
+					return nil
+				},
+			).Once()
+
+			t.Run("it should return valid villages, when there is no error", func(t *testing.T) {
+				client := pb.NewVillageServiceClient(dialBufconn(t, newVillageTestServer(mockService)))
+
+				got, err := client.GetVillages(context.Background(), &pb.GetVillagesRequest{Filter: &pb.Filter{Name: "WONO"}})
+				if assert.NoError(t, err) {
+					if assert.Equal(t, len(dummyVillages), len(got.GetVillages())) {
+						for i, village := range dummyVillages {
+							assertVillageMessage(t, village, got.GetVillages()[i])
+						}
+					}
+				}
+			})
+		})
+
+		t.Run("failed scenario", func(t *testing.T) {
+			mockService.On("GetAll", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("model.ListQuery")).Return(
+				func(ctx context.Context, keyword string, query model.ListQuery) []model.Village {
+					return []model.Village{}
+				},
+				func(ctx context.Context, keyword string, query model.ListQuery) int {
+					return 0
+				},
+				func(ctx context.Context, keyword string, query model.ListQuery) error {
+					return service.ErrRepository
+				},
+			).Once()
+
+			t.Run("it should return Internal status code, when error happened", func(t *testing.T) {
+				client := pb.NewVillageServiceClient(dialBufconn(t, newVillageTestServer(mockService)))
+
+				_, err := client.GetVillages(context.Background(), &pb.GetVillagesRequest{})
+				assert.Equal(t, codes.Internal, status.Code(err))
+			})
+		})
+	})
+
+	t.Run("TestGetVillage", func(t *testing.T) {
+		mockService := &mocks.VillageService{}
+
+		dummyVillage := model.Village{
+			ID:   "3502010001",
+			Name: "WONODADI",
+			District: model.District{
+				ID:   "3502010",
+				Name: "NGRAYUN",
+				Regency: model.Regency{
+					ID:   "3502",
+					Name: "KABUPATEN PONOROGO",
+					Province: model.Province{
+						ID:   "35",
+						Name: "JAWA TIMUR",
+					},
+				},
+			},
+		}
+
+		t.Run("success scenario", func(t *testing.T) {
+			mockService.On("GetByID", mock.Anything, dummyVillage.ID).Return(
+				func(ctx context.Context, id string) model.Village {
+					return dummyVillage
+				},
+				func(ctx context.Context, id string) error {
+					return nil
+				},
+			).Once()
+
+			t.Run("it should return valid village, when there is no error", func(t *testing.T) {
+				client := pb.NewVillageServiceClient(dialBufconn(t, newVillageTestServer(mockService)))
+
+				got, err := client.GetVillage(context.Background(), &pb.GetVillageRequest{Id: dummyVillage.ID})
+				if assert.NoError(t, err) {
+					assertVillageMessage(t, dummyVillage, got.GetVillage())
+				}
+			})
+		})
+
+		t.Run("failed scenario", func(t *testing.T) {
+			mockService.On("GetByID", mock.Anything, mock.AnythingOfType("string")).Return(
+				func(ctx context.Context, id string) model.Village {
+					return model.Village{}
+				},
+				func(ctx context.Context, id string) error {
+					if id != dummyVillage.ID {
+						return service.ErrDataNotFound
+					}
+					return service.ErrRepository
+				},
+			).Twice()
+
+			testCases := []struct {
+				name         string
+				id           string
+				expectedCode codes.Code
+			}{
+				{
+					name:         "it should return NotFound status code, when given ID not found",
+					id:           "3502010099",
+					expectedCode: codes.NotFound,
+				},
+				{
+					name:         "it should return InvalidArgument status code, when given ID is malformed",
+					id:           "abc",
+					expectedCode: codes.InvalidArgument,
+				},
+				{
+					name:         "it should return Internal status code, when error happened",
+					id:           dummyVillage.ID,
+					expectedCode: codes.Internal,
+				},
+			}
+
+			for _, testCase := range testCases {
+				t.Run(testCase.name, func(t *testing.T) {
+					client := pb.NewVillageServiceClient(dialBufconn(t, newVillageTestServer(mockService)))
+
+					_, err := client.GetVillage(context.Background(), &pb.GetVillageRequest{Id: testCase.id})
+					assert.Equal(t, testCase.expectedCode, status.Code(err))
+				})
+			}
+		})
+	})
+
+	t.Run("TestStreamVillages", func(t *testing.T) {
+		mockService := &mocks.VillageService{}
+
+		dummyVillages := []model.Village{
+			{
+				ID:   "3502010001",
+				Name: "WONODADI",
+				District: model.District{
+					ID:   "3502010",
+					Name: "NGRAYUN",
+					Regency: model.Regency{
+						ID:   "3502",
+						Name: "KABUPATEN PONOROGO",
+						Province: model.Province{
+							ID:   "35",
+							Name: "JAWA TIMUR",
+						},
+					},
+				},
+			},
+			{
+				ID:   "3502010002",
+				Name: "SELUR",
+				District: model.District{
+					ID:   "3502010",
+					Name: "NGRAYUN",
+					Regency: model.Regency{
+						ID:   "3502",
+						Name: "KABUPATEN PONOROGO",
+						Province: model.Province{
+							ID:   "35",
+							Name: "JAWA TIMUR",
+						},
+					},
+				},
+			},
+		}
+
+		t.Run("success scenario", func(t *testing.T) {
+			mockService.On("Stream", mock.Anything, "3502010", "", mock.Anything).Return(
+				func(ctx context.Context, districtID string, districtKeyword string, fn func(model.Village) error) error {
+					for _, village := range dummyVillages {
+						if err := fn(village); err != nil {
+							return err
+						}
+					}
+					return nil
+				},
+			).Once()
+
+			t.Run("it should stream every village, when there is no error", func(t *testing.T) {
+				client := pb.NewVillageServiceClient(dialBufconn(t, newVillageTestServer(mockService)))
+
+				stream, err := client.StreamVillages(context.Background(), &pb.StreamVillagesRequest{DistrictId: "3502010"})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				got := make([]*pb.Village, 0)
+				for {
+					village, err := stream.Recv()
+					if err == io.EOF {
+						break
+					}
+					if err != nil {
+						t.Fatal(err)
+					}
+					got = append(got, village)
+				}
+
+				if assert.Equal(t, len(dummyVillages), len(got)) {
+					for i, village := range dummyVillages {
+						assertVillageMessage(t, village, got[i])
+					}
+				}
+			})
+		})
+
+		t.Run("failed scenario", func(t *testing.T) {
+			mockService.On("Stream", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.Anything).Return(
+				service.ErrRepository,
+			).Once()
+
+			t.Run("it should return Internal status code, when error happened", func(t *testing.T) {
+				client := pb.NewVillageServiceClient(dialBufconn(t, newVillageTestServer(mockService)))
+
+				stream, err := client.StreamVillages(context.Background(), &pb.StreamVillagesRequest{})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				_, err = stream.Recv()
+				assert.Equal(t, codes.Internal, status.Code(err))
+			})
+		})
+	})
+}
@@ -0,0 +1,42 @@
+package service
+
+func batchIDs(level string, ids []string) (valid []string, invalid []string) {
+	valid = make([]string, 0, len(ids))
+	invalid = make([]string, 0)
+	seen := make(map[string]bool, len(ids))
+
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		if err := ValidateID(level, "ids", id); err != nil {
+			invalid = append(invalid, id)
+		} else {
+			valid = append(valid, id)
+		}
+	}
+
+	return
+}
+
+func orderBatch[T any](ids []string, found []T, idOf func(T) string) (items []T, missing []string) {
+	byID := make(map[string]T, len(found))
+	for _, item := range found {
+		byID[idOf(item)] = item
+	}
+
+	items = make([]T, 0, len(found))
+	missing = make([]string, 0)
+
+	for _, id := range ids {
+		if item, ok := byID[id]; ok {
+			items = append(items, item)
+		} else {
+			missing = append(missing, id)
+		}
+	}
+
+	return
+}
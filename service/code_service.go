@@ -0,0 +1,11 @@
+package service
+
+import (
+	"context"
+
+	"github.com/erikrios/ponorogo-regency-api/model"
+)
+
+type CodeService interface {
+	Decode(ctx context.Context, code string) (response model.Code, err error)
+}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/erikrios/ponorogo-regency-api/model"
+	"github.com/erikrios/ponorogo-regency-api/repository"
+)
+
+type codeServiceImpl struct {
+	provinceRepository repository.ProvinceRepository
+	regencyRepository  repository.RegencyRepository
+	districtRepository repository.DistrictRepository
+	villageRepository  repository.VillageRepository
+}
+
+func NewCodeServiceImpl(
+	provinceRepository repository.ProvinceRepository,
+	regencyRepository repository.RegencyRepository,
+	districtRepository repository.DistrictRepository,
+	villageRepository repository.VillageRepository,
+) *codeServiceImpl {
+	return &codeServiceImpl{
+		provinceRepository: provinceRepository,
+		regencyRepository:  regencyRepository,
+		districtRepository: districtRepository,
+		villageRepository:  villageRepository,
+	}
+}
+
+func (c *codeServiceImpl) Decode(ctx context.Context, code string) (response model.Code, err error) {
+	depth := levelOf(code)
+	if depth < 0 {
+		err = &IDError{
+			Level:  "administrative",
+			Field:  "code",
+			ID:     code,
+			Reason: "must consist of 2, 4, 7 or 10 digits",
+		}
+		return
+	}
+
+	response = model.Code{Code: code, Level: levels[depth]}
+	for _, level := range levels[:depth+1] {
+		response.Parts = append(response.Parts, model.CodePart{Level: level, Code: code[:idLengths[level]]})
+	}
+
+	// The row of the narrowest existing part carries the names of all its ancestors.
+	for i := depth; i >= 0; i-- {
+		names, repoErr := c.resolve(ctx, levels[i], response.Parts[i].Code)
+		if errors.Is(repoErr, repository.ErrQueryNotFound) {
+			continue
+		} else if repoErr != nil {
+			err = mapError(repoErr)
+			return
+		}
+
+		for j, name := range names {
+			response.Parts[j].Name = name
+			response.Parts[j].Known = true
+		}
+		break
+	}
+
+	response.Known = response.Parts[depth].Known
+	return
+}
+
+func (c *codeServiceImpl) resolve(ctx context.Context, level string, id string) (names []string, err error) {
+	switch level {
+	case LevelProvince:
+		province, repoErr := c.provinceRepository.FindByID(ctx, id)
+		names, err = []string{province.Name}, repoErr
+	case LevelRegency:
+		regency, repoErr := c.regencyRepository.FindByID(ctx, id)
+		names, err = []string{regency.Province.Name, regency.Name}, repoErr
+	case LevelDistrict:
+		district, repoErr := c.districtRepository.FindByID(ctx, id)
+		names, err = []string{district.Regency.Province.Name, district.Regency.Name, district.Name}, repoErr
+	case LevelVillage:
+		village, repoErr := c.villageRepository.FindByID(ctx, id)
+		names, err = []string{
+			village.District.Regency.Province.Name,
+			village.District.Regency.Name,
+			village.District.Name,
+			village.Name,
+		}, repoErr
+	}
+	return
+}
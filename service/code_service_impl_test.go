@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/erikrios/ponorogo-regency-api/entity"
+	"github.com/erikrios/ponorogo-regency-api/model"
+	"github.com/erikrios/ponorogo-regency-api/repository"
+	"github.com/erikrios/ponorogo-regency-api/repository/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCodeServiceImpl(t *testing.T) {
+	province := entity.Province{ID: "35", Name: "JAWA TIMUR"}
+	regency := entity.Regency{ID: "3502", Name: "KABUPATEN PONOROGO", Province: province}
+	district := entity.District{ID: "3502010", Name: "NGRAYUN", Regency: regency}
+	village := entity.Village{ID: "3502010001", Name: "BAOSANKIDUL", District: district}
+
+	t.Run("TestNewCodeServiceImpl", func(t *testing.T) {
+		t.Run("it should return valid code service instance, when invoke the function", func(t *testing.T) {
+			var service CodeService = NewCodeServiceImpl(
+				&mocks.ProvinceRepository{},
+				&mocks.RegencyRepository{},
+				&mocks.DistrictRepository{},
+				&mocks.VillageRepository{},
+			)
+			assert.NotNil(t, service)
+		})
+	})
+
+	t.Run("TestDecode", func(t *testing.T) {
+		t.Run("it should resolve every part from the code row, when the code exists", func(t *testing.T) {
+			mockVillageRepo := &mocks.VillageRepository{}
+			mockVillageRepo.On("FindByID", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), village.ID).Return(village, nil).Once()
+
+			var service CodeService = NewCodeServiceImpl(
+				&mocks.ProvinceRepository{},
+				&mocks.RegencyRepository{},
+				&mocks.DistrictRepository{},
+				mockVillageRepo,
+			)
+
+			got, err := service.Decode(context.Background(), village.ID)
+			assert.NoError(t, err)
+			assert.Equal(t, model.Code{
+				Code:  "3502010001",
+				Level: LevelVillage,
+				Known: true,
+				Parts: []model.CodePart{
+					{Level: LevelProvince, Code: "35", Name: "JAWA TIMUR", Known: true},
+					{Level: LevelRegency, Code: "3502", Name: "KABUPATEN PONOROGO", Known: true},
+					{Level: LevelDistrict, Code: "3502010", Name: "NGRAYUN", Known: true},
+					{Level: LevelVillage, Code: "3502010001", Name: "BAOSANKIDUL", Known: true},
+				},
+			}, got)
+			mockVillageRepo.AssertExpectations(t)
+		})
+
+		t.Run("it should flag the unknown parts, when only some ancestors exist", func(t *testing.T) {
+			mockRegencyRepo := &mocks.RegencyRepository{}
+			mockRegencyRepo.On("FindByID", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), regency.ID).Return(regency, nil).Once()
+			mockDistrictRepo := &mocks.DistrictRepository{}
+			mockDistrictRepo.On("FindByID", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), "3502999").Return(entity.District{}, repository.ErrQueryNotFound).Once()
+			mockVillageRepo := &mocks.VillageRepository{}
+			mockVillageRepo.On("FindByID", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), "3502999001").Return(entity.Village{}, repository.ErrQueryNotFound).Once()
+
+			var service CodeService = NewCodeServiceImpl(&mocks.ProvinceRepository{}, mockRegencyRepo, mockDistrictRepo, mockVillageRepo)
+
+			got, err := service.Decode(context.Background(), "3502999001")
+			assert.NoError(t, err)
+			assert.Equal(t, model.Code{
+				Code:  "3502999001",
+				Level: LevelVillage,
+				Known: false,
+				Parts: []model.CodePart{
+					{Level: LevelProvince, Code: "35", Name: "JAWA TIMUR", Known: true},
+					{Level: LevelRegency, Code: "3502", Name: "KABUPATEN PONOROGO", Known: true},
+					{Level: LevelDistrict, Code: "3502999"},
+					{Level: LevelVillage, Code: "3502999001"},
+				},
+			}, got)
+		})
+
+		t.Run("it should report every part unknown, when not even the province exists", func(t *testing.T) {
+			mockProvinceRepo := &mocks.ProvinceRepository{}
+			mockProvinceRepo.On("FindByID", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), "99").Return(entity.Province{}, repository.ErrQueryNotFound).Once()
+			mockRegencyRepo := &mocks.RegencyRepository{}
+			mockRegencyRepo.On("FindByID", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), "9901").Return(entity.Regency{}, repository.ErrQueryNotFound).Once()
+
+			var service CodeService = NewCodeServiceImpl(mockProvinceRepo, mockRegencyRepo, &mocks.DistrictRepository{}, &mocks.VillageRepository{})
+
+			got, err := service.Decode(context.Background(), "9901")
+			assert.NoError(t, err)
+			assert.Equal(t, model.Code{
+				Code:  "9901",
+				Level: LevelRegency,
+				Parts: []model.CodePart{
+					{Level: LevelProvince, Code: "99"},
+					{Level: LevelRegency, Code: "9901"},
+				},
+			}, got)
+		})
+
+		t.Run("it should return ErrInvalidID instance, when the code has no level's length", func(t *testing.T) {
+			var service CodeService = NewCodeServiceImpl(
+				&mocks.ProvinceRepository{},
+				&mocks.RegencyRepository{},
+				&mocks.DistrictRepository{},
+				&mocks.VillageRepository{},
+			)
+
+			for _, code := range []string{"", "350", "35020100", "35O2"} {
+				_, err := service.Decode(context.Background(), code)
+				assert.ErrorIs(t, err, ErrInvalidID)
+			}
+		})
+
+		t.Run("it should return ErrRepository instance, when error happened", func(t *testing.T) {
+			mockDistrictRepo := &mocks.DistrictRepository{}
+			mockDistrictRepo.On("FindByID", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), district.ID).Return(entity.District{}, repository.ErrDatabase).Once()
+
+			var service CodeService = NewCodeServiceImpl(&mocks.ProvinceRepository{}, &mocks.RegencyRepository{}, mockDistrictRepo, &mocks.VillageRepository{})
+
+			_, err := service.Decode(context.Background(), district.ID)
+			assert.ErrorIs(t, err, ErrRepository)
+		})
+	})
+}
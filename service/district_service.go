@@ -3,12 +3,16 @@ package service
 import (
 	"context"
 
+	"github.com/erikrios/ponorogo-regency-api/geojson"
 	"github.com/erikrios/ponorogo-regency-api/model"
 )
 
 type DistrictService interface {
-	GetAll(ctx context.Context, keyword string) (responses []model.District, err error)
+	GetAll(ctx context.Context, keyword string, query model.ListQuery) (responses []model.District, total int, err error)
 	GetByID(ctx context.Context, id string) (response model.District, err error)
-	GetVillagesByDistrictID(ctx context.Context, id string) (responses []model.Village, err error)
-	GetVillagesByDistrictName(ctx context.Context, keyword string) (responses []model.Village, err error)
+	GetByIDs(ctx context.Context, ids []string) (responses []model.District, missing []string, invalid []string, err error)
+	GetVillagesByDistrictID(ctx context.Context, id string, query model.ListQuery) (responses []model.Village, total int, err error)
+	GetVillagesByDistrictName(ctx context.Context, keyword string, query model.ListQuery) (responses []model.Village, total int, err error)
+	GetGeometry(ctx context.Context, id string, tolerance float64) (response geojson.Feature, err error)
+	GetVillagesGeometry(ctx context.Context, id string, tolerance float64) (response geojson.FeatureCollection, err error)
 }
@@ -3,7 +3,9 @@ package service
 import (
 	"context"
 
+	"github.com/erikrios/ponorogo-regency-api/display"
 	"github.com/erikrios/ponorogo-regency-api/entity"
+	"github.com/erikrios/ponorogo-regency-api/geojson"
 	"github.com/erikrios/ponorogo-regency-api/model"
 	"github.com/erikrios/ponorogo-regency-api/repository"
 )
@@ -23,14 +25,22 @@ func NewDistrictServiceImpl(
 	}
 }
 
-func (d *districtServiceImpl) GetAll(ctx context.Context, keyword string) (responses []model.District, err error) {
+func (d *districtServiceImpl) GetAll(ctx context.Context, keyword string, query model.ListQuery) (responses []model.District, total int, err error) {
+	if err = validateIDs("", "", query.Filters); err != nil {
+		return
+	}
+
+	if query.Match == model.MatchFuzzy {
+		return d.getAllFuzzy(ctx, keyword, query)
+	}
+
 	var districts []entity.District
 	var repoErr error
 
 	if keyword == "" {
-		districts, repoErr = d.districtRepository.FindAll(ctx)
+		districts, total, repoErr = d.districtRepository.FindAll(ctx, mapQuery(query))
 	} else {
-		districts, repoErr = d.districtRepository.FindByName(ctx, keyword)
+		districts, total, repoErr = d.districtRepository.FindByName(ctx, keyword, mapQuery(query))
 	}
 
 	if repoErr != nil {
@@ -41,24 +51,58 @@ func (d *districtServiceImpl) GetAll(ctx context.Context, keyword string) (respo
 	responses = make([]model.District, len(districts))
 
 	for i, district := range districts {
-		responses[i] = d.mapToModel(district)
+		responses[i] = mapDistrict(district)
 	}
 	return
 }
 
 func (d *districtServiceImpl) GetByID(ctx context.Context, id string) (response model.District, err error) {
+	if err = validateIDs(LevelDistrict, id, nil); err != nil {
+		return
+	}
+
 	district, repoErr := d.districtRepository.FindByID(ctx, id)
 	if repoErr != nil {
 		err = mapError(repoErr)
 		return
 	}
 
-	response = d.mapToModel(district)
+	response = mapDistrict(district)
+	return
+}
+
+func (d *districtServiceImpl) GetByIDs(ctx context.Context, ids []string) (responses []model.District, missing []string, invalid []string, err error) {
+	ids, invalid = batchIDs(LevelDistrict, ids)
+	if len(ids) == 0 {
+		responses, missing = make([]model.District, 0), make([]string, 0)
+		return
+	}
+
+	districts, repoErr := d.districtRepository.FindByIDs(ctx, ids)
+	if repoErr != nil {
+		err = mapError(repoErr)
+		return
+	}
+
+	districts, missing = orderBatch(ids, districts, func(district entity.District) string { return district.ID })
+
+	responses = make([]model.District, len(districts))
+	for i, district := range districts {
+		responses[i] = mapDistrict(district)
+	}
 	return
 }
 
-func (d *districtServiceImpl) GetVillagesByDistrictID(ctx context.Context, id string) (responses []model.Village, err error) {
-	villages, repoErr := d.villageRepository.FindByDistrictID(ctx, id)
+func (d *districtServiceImpl) GetVillagesByDistrictID(ctx context.Context, id string, query model.ListQuery) (responses []model.Village, total int, err error) {
+	if err = validateIDs(LevelDistrict, id, query.Filters); err != nil {
+		return
+	}
+
+	if err = validateVillageType(query.Filters); err != nil {
+		return
+	}
+
+	villages, total, repoErr := d.villageRepository.FindByDistrictID(ctx, id, mapQuery(query))
 	if repoErr != nil {
 		err = mapError(repoErr)
 		return
@@ -68,8 +112,16 @@ func (d *districtServiceImpl) GetVillagesByDistrictID(ctx context.Context, id st
 	return
 }
 
-func (d *districtServiceImpl) GetVillagesByDistrictName(ctx context.Context, keyword string) (responses []model.Village, err error) {
-	villages, repoErr := d.villageRepository.FindByDistrictName(ctx, keyword)
+func (d *districtServiceImpl) GetVillagesByDistrictName(ctx context.Context, keyword string, query model.ListQuery) (responses []model.Village, total int, err error) {
+	if err = validateIDs("", "", query.Filters); err != nil {
+		return
+	}
+
+	if err = validateVillageType(query.Filters); err != nil {
+		return
+	}
+
+	villages, total, repoErr := d.villageRepository.FindByDistrictName(ctx, keyword, mapQuery(query))
 	if repoErr != nil {
 		err = mapError(repoErr)
 		return
@@ -79,16 +131,87 @@ func (d *districtServiceImpl) GetVillagesByDistrictName(ctx context.Context, key
 	return
 }
 
-func (d *districtServiceImpl) mapToModel(e entity.District) model.District {
+func (d *districtServiceImpl) getAllFuzzy(ctx context.Context, keyword string, query model.ListQuery) (responses []model.District, total int, err error) {
+	repoQuery, err := fuzzyQuery(keyword, query)
+	if err != nil {
+		return
+	}
+
+	districts, _, repoErr := d.districtRepository.FindAll(ctx, repoQuery)
+	if repoErr != nil {
+		err = mapError(repoErr)
+		return
+	}
+
+	districts, scores, total := rankFuzzy(keyword, districts, func(district entity.District) string { return district.Name }, query)
+
+	responses = make([]model.District, len(districts))
+	for i, district := range districts {
+		responses[i] = mapDistrict(district)
+		responses[i].Score = scores[i]
+	}
+	return
+}
+
+func (d *districtServiceImpl) GetGeometry(ctx context.Context, id string, tolerance float64) (response geojson.Feature, err error) {
+	if err = validateIDs(LevelDistrict, id, nil); err != nil {
+		return
+	}
+
+	if err = validateTolerance(tolerance); err != nil {
+		return
+	}
+
+	boundary, repoErr := d.districtRepository.FindBoundaryByID(ctx, id)
+	if repoErr != nil {
+		err = mapError(repoErr)
+		return
+	}
+
+	return newFeature(boundary, tolerance)
+}
+
+func (d *districtServiceImpl) GetVillagesGeometry(ctx context.Context, id string, tolerance float64) (response geojson.FeatureCollection, err error) {
+	if err = validateIDs(LevelDistrict, id, nil); err != nil {
+		return
+	}
+
+	if err = validateTolerance(tolerance); err != nil {
+		return
+	}
+
+	boundaries, repoErr := d.villageRepository.FindBoundariesByDistrictID(ctx, id)
+	if repoErr != nil {
+		err = mapError(repoErr)
+		return
+	}
+
+	features := make([]geojson.Feature, len(boundaries))
+	for i, boundary := range boundaries {
+		if features[i], err = newFeature(boundary, tolerance); err != nil {
+			return
+		}
+	}
+
+	response = geojson.NewFeatureCollection(features)
+	return
+}
+
+func mapDistrict(e entity.District) model.District {
 	return model.District{
-		ID:   e.ID,
-		Name: e.Name,
+		ID:          e.ID,
+		Name:        e.Name,
+		DisplayName: display.Name(e.Name),
+		Latitude:    e.Latitude,
+		Longitude:   e.Longitude,
 		Regency: model.Regency{
-			ID:   e.Regency.ID,
-			Name: e.Regency.Name,
+			ID:          e.Regency.ID,
+			Name:        e.Regency.Name,
+			DisplayName: display.Name(e.Regency.Name),
 			Province: model.Province{
-				ID:   e.Regency.Province.ID,
-				Name: e.Regency.Province.Name,
+				ID:          e.Regency.Province.ID,
+				Name:        e.Regency.Province.Name,
+				DisplayName: display.Name(e.Regency.Province.Name),
 			},
 		},
 	}
@@ -98,11 +221,16 @@ func (d *districtServiceImpl) mapToModels(entities []entity.Village) []model.Vil
 	villages := make([]model.Village, len(entities))
 
 	for i, e := range entities {
-		district := d.mapToModel(e.District)
+		district := mapDistrict(e.District)
 		village := model.Village{
-			ID:       e.ID,
-			Name:     e.Name,
-			District: district,
+			ID:          e.ID,
+			Name:        e.Name,
+			DisplayName: display.Name(e.Name),
+			Type:        e.Type,
+			Latitude:    e.Latitude,
+			Longitude:   e.Longitude,
+			PostalCodes: e.PostalCodes,
+			District:    district,
 		}
 
 		villages[i] = village
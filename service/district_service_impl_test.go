@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/erikrios/ponorogo-regency-api/display"
 	"github.com/erikrios/ponorogo-regency-api/entity"
+	"github.com/erikrios/ponorogo-regency-api/geojson"
 	"github.com/erikrios/ponorogo-regency-api/model"
 	"github.com/erikrios/ponorogo-regency-api/repository"
 	"github.com/erikrios/ponorogo-regency-api/repository/mocks"
@@ -45,19 +47,25 @@ func TestDistrictServiceImpl(t *testing.T) {
 		}
 
 		t.Run("success scenario", func(t *testing.T) {
-			mockDistrictRepo.On("FindAll", mock.AnythingOfType(fmt.Sprintf("%T", context.Background()))).Return(
-				func(ctx context.Context) []entity.District {
+			mockDistrictRepo.On("FindAll", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("repository.Query")).Return(
+				func(ctx context.Context, query repository.Query) []entity.District {
 					return dummyDistricts
 				},
-				func(ctx context.Context) error {
+				func(ctx context.Context, query repository.Query) int {
+					return len(dummyDistricts)
+				},
+				func(ctx context.Context, query repository.Query) error {
 					return nil
 				},
 			).Once()
-			mockDistrictRepo.On("FindByName", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string")).Return(
-				func(ctx context.Context, keyword string) []entity.District {
+			mockDistrictRepo.On("FindByName", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string"), mock.AnythingOfType("repository.Query")).Return(
+				func(ctx context.Context, keyword string, query repository.Query) []entity.District {
 					return dummyDistricts
 				},
-				func(ctx context.Context, keyword string) error {
+				func(ctx context.Context, keyword string, query repository.Query) int {
+					return len(dummyDistricts)
+				},
+				func(ctx context.Context, keyword string, query repository.Query) error {
 					return nil
 				},
 			).Once()
@@ -83,27 +91,34 @@ func TestDistrictServiceImpl(t *testing.T) {
 
 			for _, testCase := range testCases {
 				t.Run(testCase.name, func(t *testing.T) {
-					got, err := service.GetAll(context.Background(), testCase.keyword)
+					got, total, err := service.GetAll(context.Background(), testCase.keyword, model.ListQuery{})
 					assert.NoError(t, err)
 					assert.ElementsMatch(t, testCase.expected, got)
+					assert.Equal(t, len(testCase.expected), total)
 				})
 			}
 		})
 
 		t.Run("failed scenario", func(t *testing.T) {
-			mockDistrictRepo.On("FindAll", mock.AnythingOfType(fmt.Sprintf("%T", context.Background()))).Return(
-				func(ctx context.Context) []entity.District {
+			mockDistrictRepo.On("FindAll", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("repository.Query")).Return(
+				func(ctx context.Context, query repository.Query) []entity.District {
 					return []entity.District{}
 				},
-				func(ctx context.Context) error {
+				func(ctx context.Context, query repository.Query) int {
+					return 0
+				},
+				func(ctx context.Context, query repository.Query) error {
 					return repository.ErrDatabase
 				},
 			).Once()
-			mockDistrictRepo.On("FindByName", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string")).Return(
-				func(ctx context.Context, keyword string) []entity.District {
+			mockDistrictRepo.On("FindByName", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string"), mock.AnythingOfType("repository.Query")).Return(
+				func(ctx context.Context, keyword string, query repository.Query) []entity.District {
 					return []entity.District{}
 				},
-				func(ctx context.Context, keyword string) error {
+				func(ctx context.Context, keyword string, query repository.Query) int {
+					return 0
+				},
+				func(ctx context.Context, keyword string, query repository.Query) error {
 					return repository.ErrDatabase
 				},
 			).Once()
@@ -129,7 +144,7 @@ func TestDistrictServiceImpl(t *testing.T) {
 
 			for _, testCase := range testCases {
 				t.Run(testCase.name, func(t *testing.T) {
-					_, err := service.GetAll(context.Background(), testCase.keyword)
+					_, _, err := service.GetAll(context.Background(), testCase.keyword, model.ListQuery{})
 					assert.ErrorIs(t, err, testCase.expected)
 				})
 			}
@@ -141,7 +156,7 @@ func TestDistrictServiceImpl(t *testing.T) {
 		mockVillageRepo := &mocks.VillageRepository{}
 
 		dummyDistrict := entity.District{
-			ID:   "35200101",
+			ID:   "3302010",
 			Name: "Bungkal",
 			Regency: entity.Regency{
 				ID:   "3302",
@@ -212,7 +227,7 @@ func TestDistrictServiceImpl(t *testing.T) {
 				},
 				{
 					name:     "it should return ErrDataNotFound instance, when ID is not match and error happened",
-					id:       "9090",
+					id:       "3302999",
 					expected: ErrDataNotFound,
 				},
 			}
@@ -228,22 +243,103 @@ func TestDistrictServiceImpl(t *testing.T) {
 		})
 	})
 
+	t.Run("TestGetByIDs", func(t *testing.T) {
+		mockDistrictRepo := &mocks.DistrictRepository{}
+		mockVillageRepo := &mocks.VillageRepository{}
+
+		regency := entity.Regency{
+			ID:       "3502",
+			Name:     "KABUPATEN PONOROGO",
+			Province: entity.Province{ID: "35", Name: "JAWA TIMUR"},
+		}
+		dummyDistricts := []entity.District{
+			{ID: "3502010", Name: "NGRAYUN", Regency: regency},
+			{ID: "3502020", Name: "SLAHUNG", Regency: regency},
+		}
+
+		t.Run("success scenario", func(t *testing.T) {
+			mockDistrictRepo.On("FindByIDs", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), []string{"3502020", "3502999", "3502010"}).Return(
+				func(ctx context.Context, ids []string) []entity.District {
+					return dummyDistricts
+				},
+				func(ctx context.Context, ids []string) error {
+					return nil
+				},
+			).Once()
+
+			t.Run("it should return the districts in the requested order with the missing and malformed IDs, when there is no error", func(t *testing.T) {
+				var service DistrictService = NewDistrictServiceImpl(mockDistrictRepo, mockVillageRepo)
+
+				got, missing, invalid, err := service.GetByIDs(context.Background(), []string{"3502020", "35020100", "3502999", "3502010", "35020100"})
+				assert.NoError(t, err)
+				assert.Equal(t, []model.District{
+					mapToDistrictModel(dummyDistricts[1]),
+					mapToDistrictModel(dummyDistricts[0]),
+				}, got)
+				assert.Equal(t, []string{"3502999"}, missing)
+				assert.Equal(t, []string{"35020100"}, invalid)
+			})
+
+			t.Run("it should only return the malformed IDs without querying, when every ID is malformed", func(t *testing.T) {
+				var service DistrictService = NewDistrictServiceImpl(mockDistrictRepo, mockVillageRepo)
+
+				got, missing, invalid, err := service.GetByIDs(context.Background(), []string{"3502-010"})
+				assert.NoError(t, err)
+				assert.Empty(t, got)
+				assert.Empty(t, missing)
+				assert.Equal(t, []string{"3502-010"}, invalid)
+			})
+		})
+
+		t.Run("failed scenario", func(t *testing.T) {
+			mockDistrictRepo.On("FindByIDs", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("[]string")).Return(
+				func(ctx context.Context, ids []string) []entity.District {
+					return nil
+				},
+				func(ctx context.Context, ids []string) error {
+					return repository.ErrDatabase
+				},
+			).Once()
+
+			testCases := []struct {
+				name     string
+				ids      []string
+				expected error
+			}{
+				{
+					name:     "it should return ErrRepository instance, when error happened",
+					ids:      []string{"3502010"},
+					expected: ErrRepository,
+				},
+			}
+
+			var service DistrictService = NewDistrictServiceImpl(mockDistrictRepo, mockVillageRepo)
+
+			for _, testCase := range testCases {
+				t.Run(testCase.name, func(t *testing.T) {
+					_, _, _, err := service.GetByIDs(context.Background(), testCase.ids)
+					assert.ErrorIs(t, err, testCase.expected)
+				})
+			}
+		})
+	})
+
 	t.Run("TestGetVillagesByDistrictID", func(t *testing.T) {
 		mockDistrictRepo := &mocks.DistrictRepository{}
 		mockVillageRepo := &mocks.VillageRepository{}
 
 		dummyVillages := []entity.Village{
 			{
-				ID:   "35200101",
+				ID:   "3502010001",
 				Name: "Pager",
 				District: entity.District{
-					ID:   "350100",
+					ID:   "3502010",
 					Name: "Bungkal",
 					Regency: entity.Regency{
-						ID:   "5301",
+						ID:   "3502",
 						Name: "Ponorogo",
 						Province: entity.Province{
-							ID:   "53",
+							ID:   "35",
 							Name: "Jawa Timur",
 						},
 					},
@@ -252,11 +348,14 @@ func TestDistrictServiceImpl(t *testing.T) {
 		}
 
 		t.Run("success scenario", func(t *testing.T) {
-			mockVillageRepo.On("FindByDistrictID", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), dummyVillages[0].ID).Return(
-				func(ctx context.Context, id string) []entity.Village {
+			mockVillageRepo.On("FindByDistrictID", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), dummyVillages[0].District.ID, mock.AnythingOfType("repository.Query")).Return(
+				func(ctx context.Context, id string, query repository.Query) []entity.Village {
 					return dummyVillages
 				},
-				func(ctx context.Context, id string) error {
+				func(ctx context.Context, id string, query repository.Query) int {
+					return len(dummyVillages)
+				},
+				func(ctx context.Context, id string, query repository.Query) error {
 					return nil
 				},
 			).Once()
@@ -268,7 +367,7 @@ func TestDistrictServiceImpl(t *testing.T) {
 			}{
 				{
 					name:       "it should return valid villages, when districtID is valid",
-					districtID: dummyVillages[0].ID,
+					districtID: dummyVillages[0].District.ID,
 					expected:   mapToVillagesModel(dummyVillages),
 				},
 			}
@@ -277,22 +376,30 @@ func TestDistrictServiceImpl(t *testing.T) {
 
 			for _, testCase := range testCases {
 				t.Run(testCase.name, func(t *testing.T) {
-					got, err := service.GetVillagesByDistrictID(context.Background(), testCase.districtID)
+					got, total, err := service.GetVillagesByDistrictID(context.Background(), testCase.districtID, model.ListQuery{})
 					assert.NoError(t, err)
 					assert.ElementsMatch(t, testCase.expected, got)
+					assert.Equal(t, len(testCase.expected), total)
 				})
 			}
 		})
 
 		t.Run("failed scenario", func(t *testing.T) {
-			mockVillageRepo.On("FindByDistrictID", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), dummyVillages[0].ID).Return(
-				func(ctx context.Context, id string) []entity.Village {
+			mockVillageRepo.On("FindByDistrictID", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string"), mock.AnythingOfType("repository.Query")).Return(
+				func(ctx context.Context, id string, query repository.Query) []entity.Village {
 					return []entity.Village{}
 				},
-				func(ctx context.Context, id string) error {
-					return repository.ErrDatabase
+				func(ctx context.Context, id string, query repository.Query) int {
+					return 0
 				},
-			).Once()
+				func(ctx context.Context, id string, query repository.Query) error {
+					if id != dummyVillages[0].District.ID {
+						return repository.ErrQueryNotFound
+					} else {
+						return repository.ErrDatabase
+					}
+				},
+			).Twice()
 
 			testCases := []struct {
 				name       string
@@ -301,16 +408,21 @@ func TestDistrictServiceImpl(t *testing.T) {
 			}{
 				{
 					name:       "it should return ErrRepository instance, when error happened",
-					districtID: dummyVillages[0].ID,
+					districtID: dummyVillages[0].District.ID,
 					expected:   ErrRepository,
 				},
+				{
+					name:       "it should return ErrDataNotFound instance, when the district doesn't exist",
+					districtID: "3502999",
+					expected:   ErrDataNotFound,
+				},
 			}
 
 			var service DistrictService = NewDistrictServiceImpl(mockDistrictRepo, mockVillageRepo)
 
 			for _, testCase := range testCases {
 				t.Run(testCase.name, func(t *testing.T) {
-					_, err := service.GetVillagesByDistrictID(context.Background(), testCase.districtID)
+					_, _, err := service.GetVillagesByDistrictID(context.Background(), testCase.districtID, model.ListQuery{})
 					assert.ErrorIs(t, err, testCase.expected)
 				})
 			}
@@ -341,11 +453,14 @@ func TestDistrictServiceImpl(t *testing.T) {
 		}
 
 		t.Run("success scenario", func(t *testing.T) {
-			mockVillageRepo.On("FindByDistrictName", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), dummyVillages[0].Name).Return(
-				func(ctx context.Context, keyword string) []entity.Village {
+			mockVillageRepo.On("FindByDistrictName", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), dummyVillages[0].Name, mock.AnythingOfType("repository.Query")).Return(
+				func(ctx context.Context, keyword string, query repository.Query) []entity.Village {
 					return dummyVillages
 				},
-				func(ctx context.Context, keyword string) error {
+				func(ctx context.Context, keyword string, query repository.Query) int {
+					return len(dummyVillages)
+				},
+				func(ctx context.Context, keyword string, query repository.Query) error {
 					return nil
 				},
 			).Once()
@@ -366,19 +481,23 @@ func TestDistrictServiceImpl(t *testing.T) {
 
 			for _, testCase := range testCases {
 				t.Run(testCase.name, func(t *testing.T) {
-					got, err := service.GetVillagesByDistrictName(context.Background(), testCase.keyword)
+					got, total, err := service.GetVillagesByDistrictName(context.Background(), testCase.keyword, model.ListQuery{})
 					assert.NoError(t, err)
 					assert.ElementsMatch(t, testCase.expected, got)
+					assert.Equal(t, len(testCase.expected), total)
 				})
 			}
 		})
 
 		t.Run("failed scenario", func(t *testing.T) {
-			mockVillageRepo.On("FindByDistrictName", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), dummyVillages[0].Name).Return(
-				func(ctx context.Context, keyword string) []entity.Village {
+			mockVillageRepo.On("FindByDistrictName", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), dummyVillages[0].Name, mock.AnythingOfType("repository.Query")).Return(
+				func(ctx context.Context, keyword string, query repository.Query) []entity.Village {
 					return []entity.Village{}
 				},
-				func(ctx context.Context, keyword string) error {
+				func(ctx context.Context, keyword string, query repository.Query) int {
+					return 0
+				},
+				func(ctx context.Context, keyword string, query repository.Query) error {
 					return repository.ErrDatabase
 				},
 			).Once()
@@ -399,7 +518,155 @@ func TestDistrictServiceImpl(t *testing.T) {
 
 			for _, testCase := range testCases {
 				t.Run(testCase.name, func(t *testing.T) {
-					_, err := service.GetVillagesByDistrictName(context.Background(), testCase.keyword)
+					_, _, err := service.GetVillagesByDistrictName(context.Background(), testCase.keyword, model.ListQuery{})
+					assert.ErrorIs(t, err, testCase.expected)
+				})
+			}
+		})
+	})
+
+	t.Run("TestGetGeometry", func(t *testing.T) {
+		mockDistrictRepo := &mocks.DistrictRepository{}
+		mockVillageRepo := &mocks.VillageRepository{}
+
+		geometry := `{"type": "Polygon", "coordinates": [[[111.4, -7.9], [111.45, -7.9001], [111.5, -7.9], [111.5, -7.8], [111.4, -7.9]]]}`
+		corrupt := `{"type": "Point", "coordinates": [111.4, -7.9]}`
+
+		t.Run("success scenario", func(t *testing.T) {
+			mockDistrictRepo.On("FindBoundaryByID", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), "3502010").Return(
+				func(ctx context.Context, id string) entity.Boundary {
+					return entity.Boundary{ID: id, Name: "NGRAYUN", Geometry: &geometry}
+				},
+				func(ctx context.Context, id string) error {
+					return nil
+				},
+			).Twice()
+
+			var service DistrictService = NewDistrictServiceImpl(mockDistrictRepo, mockVillageRepo)
+
+			t.Run("it should return the boundary as a feature, when there is no error", func(t *testing.T) {
+				got, err := service.GetGeometry(context.Background(), "3502010", 0)
+				assert.NoError(t, err)
+				assert.Equal(t, geojson.TypeFeature, got.Type)
+				assert.Equal(t, "3502010", got.ID)
+				assert.Equal(t, map[string]any{"id": "3502010", "name": "NGRAYUN", "display_name": "Ngrayun"}, got.Properties)
+				if assert.NotNil(t, got.Geometry) {
+					assert.Len(t, got.Geometry.Polygons[0][0], 5)
+				}
+			})
+
+			t.Run("it should simplify the geometry, when given a tolerance", func(t *testing.T) {
+				got, err := service.GetGeometry(context.Background(), "3502010", 0.001)
+				assert.NoError(t, err)
+				if assert.NotNil(t, got.Geometry) {
+					assert.Len(t, got.Geometry.Polygons[0][0], 4)
+				}
+			})
+		})
+
+		t.Run("failed scenario", func(t *testing.T) {
+			mockDistrictRepo.On("FindBoundaryByID", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string")).Return(
+				func(ctx context.Context, id string) entity.Boundary {
+					return entity.Boundary{ID: id, Name: "NGRAYUN", Geometry: &corrupt}
+				},
+				func(ctx context.Context, id string) error {
+					switch id {
+					case "3502999":
+						return repository.ErrQueryNotFound
+					case "3502020":
+						return repository.ErrDatabase
+					}
+					return nil
+				},
+			).Times(3)
+
+			testCases := []struct {
+				name      string
+				id        string
+				tolerance float64
+				expected  error
+			}{
+				{name: "it should return ErrInvalidID instance, when given malformed ID", id: "35020", expected: ErrInvalidID},
+				{name: "it should return ErrInvalidQuery instance, when given negative tolerance", id: "3502010", tolerance: -1, expected: ErrInvalidQuery},
+				{name: "it should return ErrDataNotFound instance, when given ID not found", id: "3502999", expected: ErrDataNotFound},
+				{name: "it should return ErrRepository instance, when error happened", id: "3502020", expected: ErrRepository},
+				{name: "it should return ErrRepository instance, when the stored geometry is invalid", id: "3502010", expected: ErrRepository},
+			}
+
+			var service DistrictService = NewDistrictServiceImpl(mockDistrictRepo, mockVillageRepo)
+
+			for _, testCase := range testCases {
+				t.Run(testCase.name, func(t *testing.T) {
+					_, err := service.GetGeometry(context.Background(), testCase.id, testCase.tolerance)
+					assert.ErrorIs(t, err, testCase.expected)
+				})
+			}
+		})
+	})
+
+	t.Run("TestGetVillagesGeometry", func(t *testing.T) {
+		mockDistrictRepo := &mocks.DistrictRepository{}
+		mockVillageRepo := &mocks.VillageRepository{}
+
+		geometry := `{"type": "Polygon", "coordinates": [[[111.4, -7.9], [111.5, -7.9], [111.5, -7.8], [111.4, -7.9]]]}`
+		dummyBoundaries := []entity.Boundary{
+			{ID: "3502010001", Name: "BAOSANKIDUL", Geometry: &geometry},
+			{ID: "3502010002", Name: "WONODADI"},
+		}
+
+		t.Run("success scenario", func(t *testing.T) {
+			mockVillageRepo.On("FindBoundariesByDistrictID", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), "3502010").Return(
+				func(ctx context.Context, districtID string) []entity.Boundary {
+					return dummyBoundaries
+				},
+				func(ctx context.Context, districtID string) error {
+					return nil
+				},
+			).Once()
+
+			t.Run("it should return a feature per village, when there is no error", func(t *testing.T) {
+				var service DistrictService = NewDistrictServiceImpl(mockDistrictRepo, mockVillageRepo)
+
+				got, err := service.GetVillagesGeometry(context.Background(), "3502010", 0)
+				assert.NoError(t, err)
+				assert.Equal(t, geojson.TypeFeatureCollection, got.Type)
+				if assert.Len(t, got.Features, 2) {
+					assert.Equal(t, "3502010001", got.Features[0].ID)
+					assert.NotNil(t, got.Features[0].Geometry)
+					assert.Equal(t, "3502010002", got.Features[1].ID)
+					assert.Nil(t, got.Features[1].Geometry)
+				}
+			})
+		})
+
+		t.Run("failed scenario", func(t *testing.T) {
+			mockVillageRepo.On("FindBoundariesByDistrictID", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string")).Return(
+				func(ctx context.Context, districtID string) []entity.Boundary {
+					return nil
+				},
+				func(ctx context.Context, districtID string) error {
+					if districtID == "3502999" {
+						return repository.ErrQueryNotFound
+					}
+					return repository.ErrDatabase
+				},
+			).Twice()
+
+			testCases := []struct {
+				name     string
+				id       string
+				expected error
+			}{
+				{name: "it should return ErrInvalidID instance, when given malformed ID", id: "35020", expected: ErrInvalidID},
+				{name: "it should return ErrDataNotFound instance, when given ID not found", id: "3502999", expected: ErrDataNotFound},
+				{name: "it should return ErrRepository instance, when error happened", id: "3502010", expected: ErrRepository},
+			}
+
+			var service DistrictService = NewDistrictServiceImpl(mockDistrictRepo, mockVillageRepo)
+
+			for _, testCase := range testCases {
+				t.Run(testCase.name, func(t *testing.T) {
+					_, err := service.GetVillagesGeometry(context.Background(), testCase.id, 0)
 					assert.ErrorIs(t, err, testCase.expected)
 				})
 			}
@@ -409,14 +676,19 @@ func TestDistrictServiceImpl(t *testing.T) {
 
 func mapToDistrictModel(e entity.District) model.District {
 	return model.District{
-		ID:   e.ID,
-		Name: e.Name,
+		ID:          e.ID,
+		Name:        e.Name,
+		DisplayName: display.Name(e.Name),
+		Latitude:    e.Latitude,
+		Longitude:   e.Longitude,
 		Regency: model.Regency{
-			ID:   e.Regency.ID,
-			Name: e.Regency.Name,
+			ID:          e.Regency.ID,
+			Name:        e.Regency.Name,
+			DisplayName: display.Name(e.Regency.Name),
 			Province: model.Province{
-				ID:   e.Regency.Province.ID,
-				Name: e.Regency.Province.Name,
+				ID:          e.Regency.Province.ID,
+				Name:        e.Regency.Province.Name,
+				DisplayName: display.Name(e.Regency.Province.Name),
 			},
 		},
 	}
@@ -0,0 +1,105 @@
+package service
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/erikrios/ponorogo-regency-api/model"
+	"github.com/erikrios/ponorogo-regency-api/normalize"
+	"github.com/erikrios/ponorogo-regency-api/repository"
+)
+
+// fuzzyThreshold is the default similarity threshold of pg_trgm.
+const fuzzyThreshold = 0.3
+
+func fuzzyQuery(keyword string, query model.ListQuery) (repository.Query, error) {
+	if keyword == "" {
+		return repository.Query{}, &QueryError{Reason: "fuzzy match needs a keyword"}
+	}
+
+	if query.After != "" || query.Sort != "" {
+		return repository.Query{}, &QueryError{Reason: "fuzzy matches are ordered by score and can't be combined with after or sort"}
+	}
+
+	return repository.Query{Filters: query.Filters, Expand: query.Expand}, nil
+}
+
+type fuzzyHit[T any] struct {
+	item  T
+	score float64
+}
+
+func rankFuzzy[T any](keyword string, candidates []T, nameOf func(T) string, query model.ListQuery) (hits []T, scores []float64, total int) {
+	keywordTrigrams := trigrams(keyword)
+
+	var ranked []fuzzyHit[T]
+	for _, candidate := range candidates {
+		if score := similarity(keywordTrigrams, trigrams(nameOf(candidate))); score >= fuzzyThreshold {
+			ranked = append(ranked, fuzzyHit[T]{item: candidate, score: score})
+		}
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool { return ranked[i].score > ranked[j].score })
+
+	total = len(ranked)
+
+	if query.Limit > 0 {
+		start := 0
+		if query.Page > 1 {
+			start = (query.Page - 1) * query.Limit
+		}
+		if start > len(ranked) {
+			start = len(ranked)
+		}
+		end := start + query.Limit
+		if end > len(ranked) {
+			end = len(ranked)
+		}
+		ranked = ranked[start:end]
+	}
+
+	hits = make([]T, len(ranked))
+	scores = make([]float64, len(ranked))
+	for i, h := range ranked {
+		hits[i] = h.item
+		scores[i] = math.Round(h.score*1000) / 1000
+	}
+
+	return
+}
+
+func similarity(a, b map[string]bool) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+
+	common := 0
+	for trigram := range a {
+		if b[trigram] {
+			common++
+		}
+	}
+
+	return float64(common) / float64(len(a)+len(b)-common)
+}
+
+// trigrams pads each word the way pg_trgm does, two spaces before and one after, so that its first
+// letters weigh more.
+func trigrams(s string) map[string]bool {
+	set := make(map[string]bool)
+
+	words := strings.FieldsFunc(normalize.Fold(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	for _, word := range words {
+		padded := []rune("  " + word + " ")
+		for i := 0; i+3 <= len(padded); i++ {
+			set[string(padded[i:i+3])] = true
+		}
+	}
+
+	return set
+}
@@ -0,0 +1,92 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/erikrios/ponorogo-regency-api/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFuzzy(t *testing.T) {
+	// districts are the districts of Ponorogo, as inserted by the migrations.
+	districts := []string{
+		"NGRAYUN", "SLAHUNG", "BUNGKAL", "SAMBIT", "SAWOO", "SOOKO", "PUDAK", "PULUNG", "MLARAK", "SIMAN", "JETIS",
+		"BALONG", "KAUMAN", "JAMBON", "BADEGAN", "SAMPUNG", "SUKOREJO", "PONOROGO", "BABADAN", "JENANGAN", "NGEBEL",
+	}
+	nameOf := func(name string) string { return name }
+
+	t.Run("TestRankFuzzy", func(t *testing.T) {
+		testCases := []struct {
+			name     string
+			keyword  string
+			expected string
+		}{
+			{name: "it should tolerate a missing letter", keyword: "Babdan", expected: "BABADAN"},
+			{name: "it should tolerate an extra word", keyword: "Ponorogo kota", expected: "PONOROGO"},
+			{name: "it should tolerate swapped letters", keyword: "Jenagnan", expected: "JENANGAN"},
+			{name: "it should tolerate an old spelling", keyword: "Sukoredjo", expected: "SUKOREJO"},
+			{name: "it should tolerate a wrong letter", keyword: "ngrayon", expected: "NGRAYUN"},
+			{name: "it should tolerate a truncated name", keyword: "Slahun", expected: "SLAHUNG"},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				hits, scores, total := rankFuzzy(testCase.keyword, districts, nameOf, model.ListQuery{})
+				if assert.NotZero(t, total) {
+					assert.Equal(t, testCase.expected, hits[0])
+					assert.GreaterOrEqual(t, scores[0], fuzzyThreshold)
+					assert.LessOrEqual(t, scores[0], 1.0)
+				}
+			})
+		}
+
+		t.Run("it should score an exact match 1, when given the name in another case", func(t *testing.T) {
+			hits, scores, _ := rankFuzzy("babadan", districts, nameOf, model.ListQuery{})
+			assert.Equal(t, "BABADAN", hits[0])
+			assert.Equal(t, 1.0, scores[0])
+		})
+
+		t.Run("it should rank the hits by descending score", func(t *testing.T) {
+			_, scores, _ := rankFuzzy("sambong", districts, nameOf, model.ListQuery{})
+			for i := 1; i < len(scores); i++ {
+				assert.GreaterOrEqual(t, scores[i-1], scores[i])
+			}
+		})
+
+		t.Run("it should find nothing, when no name is similar enough", func(t *testing.T) {
+			hits, _, total := rankFuzzy("Surabaya", districts, nameOf, model.ListQuery{})
+			assert.Empty(t, hits)
+			assert.Zero(t, total)
+		})
+
+		t.Run("it should return the requested page with the total of hits, when given page and limit", func(t *testing.T) {
+			all, _, total := rankFuzzy("sambong", districts, nameOf, model.ListQuery{})
+
+			hits, scores, pageTotal := rankFuzzy("sambong", districts, nameOf, model.ListQuery{Page: 2, Limit: 1})
+			assert.Equal(t, total, pageTotal)
+			if assert.Greater(t, total, 1) {
+				assert.Equal(t, all[1:2], hits)
+				assert.Len(t, scores, 1)
+			}
+		})
+	})
+
+	t.Run("TestFuzzyQuery", func(t *testing.T) {
+		t.Run("it should keep filters and expand without paginating, when given valid query", func(t *testing.T) {
+			got, err := fuzzyQuery("babdan", model.ListQuery{Page: 2, Limit: 10, Filters: map[string]string{"regency_id": "3502"}, Expand: []string{}})
+			assert.NoError(t, err)
+			assert.Equal(t, map[string]string{"regency_id": "3502"}, got.Filters)
+			assert.Equal(t, []string{}, got.Expand)
+			assert.Zero(t, got.Limit)
+			assert.Zero(t, got.Offset)
+		})
+
+		for _, query := range []model.ListQuery{{After: "3502010"}, {Sort: "name"}} {
+			_, err := fuzzyQuery("babdan", query)
+			assert.ErrorIs(t, err, ErrInvalidQuery)
+		}
+
+		_, err := fuzzyQuery("", model.ListQuery{})
+		assert.ErrorIs(t, err, ErrInvalidQuery)
+	})
+}
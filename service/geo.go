@@ -0,0 +1,29 @@
+package service
+
+import "math"
+
+const earthRadius = 6371008.8
+
+func haversine(lat1, lng1, lat2, lng2 float64) float64 {
+	toRadians := func(degrees float64) float64 { return degrees * math.Pi / 180 }
+
+	dLat := toRadians(lat2 - lat1)
+	dLng := toRadians(lng2 - lng1)
+
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRadians(lat1))*math.Cos(toRadians(lat2))*math.Sin(dLng/2)*math.Sin(dLng/2)
+
+	return 2 * earthRadius * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+func validatePoint(lat, lng float64) error {
+	if math.IsNaN(lat) || lat < -90 || lat > 90 {
+		return &QueryError{Reason: "lat must be between -90 and 90"}
+	}
+
+	if math.IsNaN(lng) || lng < -180 || lng > 180 {
+		return &QueryError{Reason: "lng must be between -180 and 180"}
+	}
+
+	return nil
+}
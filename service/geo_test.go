@@ -0,0 +1,39 @@
+package service
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGeo(t *testing.T) {
+	t.Run("TestHaversine", func(t *testing.T) {
+		testCases := []struct {
+			name     string
+			lat1     float64
+			lng1     float64
+			lat2     float64
+			lng2     float64
+			expected float64
+		}{
+			{name: "it should return zero, when given the same point", lat1: -7.8651, lng1: 111.4696, lat2: -7.8651, lng2: 111.4696, expected: 0},
+			{name: "it should return the length of a degree, when given points a degree of latitude apart", lat1: -8, lng1: 111.5, lat2: -7, lng2: 111.5, expected: 111195},
+			{name: "it should return half the circumference, when given antipodal points", lat1: 0, lng1: 0, lat2: 0, lng2: 180, expected: math.Pi * earthRadius},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				assert.InDelta(t, testCase.expected, haversine(testCase.lat1, testCase.lng1, testCase.lat2, testCase.lng2), 1)
+			})
+		}
+	})
+
+	t.Run("TestValidatePoint", func(t *testing.T) {
+		assert.NoError(t, validatePoint(-7.8651, 111.4696))
+		assert.NoError(t, validatePoint(90, -180))
+		assert.ErrorIs(t, validatePoint(-90.5, 111.4696), ErrInvalidQuery)
+		assert.ErrorIs(t, validatePoint(-7.8651, 180.5), ErrInvalidQuery)
+		assert.ErrorIs(t, validatePoint(math.NaN(), 111.4696), ErrInvalidQuery)
+	})
+}
@@ -0,0 +1,42 @@
+package service
+
+import (
+	"log"
+	"math"
+
+	"github.com/erikrios/ponorogo-regency-api/display"
+	"github.com/erikrios/ponorogo-regency-api/entity"
+	"github.com/erikrios/ponorogo-regency-api/geojson"
+)
+
+func validateTolerance(tolerance float64) error {
+	if math.IsNaN(tolerance) || math.IsInf(tolerance, 0) || tolerance < 0 {
+		return &QueryError{Reason: "tolerance must be a non-negative number of degrees"}
+	}
+
+	return nil
+}
+
+func newFeature(boundary entity.Boundary, tolerance float64) (feature geojson.Feature, err error) {
+	properties := map[string]any{
+		"id":           boundary.ID,
+		"name":         boundary.Name,
+		"display_name": display.Name(boundary.Name),
+	}
+
+	if boundary.Geometry == nil {
+		feature = geojson.NewFeature(boundary.ID, nil, properties)
+		return
+	}
+
+	geometry, parseErr := geojson.Parse([]byte(*boundary.Geometry))
+	if parseErr != nil {
+		log.Println(parseErr)
+		err = ErrRepository
+		return
+	}
+
+	simplified := geometry.Simplify(tolerance)
+	feature = geojson.NewFeature(boundary.ID, &simplified, properties)
+	return
+}
@@ -0,0 +1,125 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	LevelProvince = "province"
+	LevelRegency  = "regency"
+	LevelDistrict = "district"
+	LevelVillage  = "village"
+)
+
+var levels = []string{LevelProvince, LevelRegency, LevelDistrict, LevelVillage}
+
+var idLengths = map[string]int{
+	LevelProvince: 2,
+	LevelRegency:  4,
+	LevelDistrict: 7,
+	LevelVillage:  10,
+}
+
+var idFilters = []struct {
+	field string
+	level string
+}{
+	{field: "province_id", level: LevelProvince},
+	{field: "regency_id", level: LevelRegency},
+	{field: "district_id", level: LevelDistrict},
+}
+
+type IDError struct {
+	Level  string
+	Field  string
+	ID     string
+	Reason string
+}
+
+func (e *IDError) Error() string {
+	return fmt.Sprintf("%s: %s ID %q %s", ErrInvalidID, e.Level, e.ID, e.Reason)
+}
+
+func (e *IDError) Unwrap() error {
+	return ErrInvalidID
+}
+
+func ValidateID(level string, field string, id string) error {
+	length := idLengths[level]
+
+	if len(id) == length && isDigits(id) {
+		return nil
+	}
+
+	return &IDError{
+		Level:  level,
+		Field:  field,
+		ID:     id,
+		Reason: fmt.Sprintf("must consist of exactly %d digits", length),
+	}
+}
+
+func levelOf(code string) int {
+	if !isDigits(code) {
+		return -1
+	}
+
+	for i, level := range levels {
+		if len(code) == idLengths[level] {
+			return i
+		}
+	}
+	return -1
+}
+
+func validateIDs(level string, id string, filters map[string]string) error {
+	type param struct {
+		level string
+		field string
+		id    string
+	}
+
+	var params []param
+	if level != "" {
+		params = append(params, param{level: level, field: "id", id: id})
+	}
+	for _, filter := range idFilters {
+		if value, ok := filters[filter.field]; ok {
+			params = append(params, param{level: filter.level, field: filter.field, id: value})
+		}
+	}
+
+	for i, p := range params {
+		if err := ValidateID(p.level, p.field, p.id); err != nil {
+			return err
+		}
+
+		for _, other := range params[:i] {
+			narrow, wide := p.id, other.id
+			if len(narrow) < len(wide) {
+				narrow, wide = wide, narrow
+			}
+
+			if !strings.HasPrefix(narrow, wide) {
+				return &IDError{
+					Level:  p.level,
+					Field:  p.field,
+					ID:     p.id,
+					Reason: fmt.Sprintf("doesn't match %s ID %q", other.level, other.id),
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
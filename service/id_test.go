@@ -0,0 +1,113 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestID(t *testing.T) {
+	t.Run("TestValidateID", func(t *testing.T) {
+		validTestCases := []struct {
+			name  string
+			level string
+			id    string
+		}{
+			{name: "it should accept a province ID", level: LevelProvince, id: "35"},
+			{name: "it should accept a regency ID", level: LevelRegency, id: "3502"},
+			{name: "it should accept a district ID", level: LevelDistrict, id: "3502010"},
+			{name: "it should accept a village ID", level: LevelVillage, id: "3502010001"},
+		}
+
+		for _, testCase := range validTestCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				assert.NoError(t, ValidateID(testCase.level, "id", testCase.id))
+			})
+		}
+
+		invalidTestCases := []struct {
+			name  string
+			level string
+			id    string
+		}{
+			{name: "it should reject an empty ID", level: LevelProvince, id: ""},
+			{name: "it should reject an ID with non-digit characters", level: LevelRegency, id: "35O2"},
+			{name: "it should reject an ID with a wrong length", level: LevelDistrict, id: "35020100"},
+		}
+
+		for _, testCase := range invalidTestCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				err := ValidateID(testCase.level, "id", testCase.id)
+				assert.ErrorIs(t, err, ErrInvalidID)
+
+				var idErr *IDError
+				if assert.ErrorAs(t, err, &idErr) {
+					assert.Equal(t, testCase.level, idErr.Level)
+					assert.Equal(t, testCase.id, idErr.ID)
+					assert.Equal(t, "id", idErr.Field)
+				}
+			})
+		}
+	})
+
+	t.Run("TestValidateIDs", func(t *testing.T) {
+		t.Run("it should accept IDs starting with the wider ones, when given path ID and filters", func(t *testing.T) {
+			err := validateIDs(LevelRegency, "3502", map[string]string{"province_id": "35", "district_id": "3502010", "name": "NGRA"})
+			assert.NoError(t, err)
+		})
+
+		t.Run("it should accept anything, when given neither path ID nor ID filters", func(t *testing.T) {
+			assert.NoError(t, validateIDs("", "", map[string]string{"name": "NGRA"}))
+		})
+
+		testCases := []struct {
+			name           string
+			level          string
+			id             string
+			filters        map[string]string
+			expectedField  string
+			expectedReason string
+		}{
+			{
+				name:           "it should reject a malformed ID filter",
+				filters:        map[string]string{"regency_id": "35x2"},
+				expectedField:  "regency_id",
+				expectedReason: "must consist of exactly 4 digits",
+			},
+			{
+				name:           "it should reject a filter out of the path ID, when the filter is narrower",
+				level:          LevelRegency,
+				id:             "3502",
+				filters:        map[string]string{"district_id": "3503010"},
+				expectedField:  "district_id",
+				expectedReason: `doesn't match regency ID "3502"`,
+			},
+			{
+				name:           "it should reject a filter out of the path ID, when the filter is wider",
+				level:          LevelDistrict,
+				id:             "3502010",
+				filters:        map[string]string{"province_id": "36"},
+				expectedField:  "province_id",
+				expectedReason: `doesn't match district ID "3502010"`,
+			},
+			{
+				name:           "it should reject filters contradicting each other",
+				filters:        map[string]string{"province_id": "35", "district_id": "3602010"},
+				expectedField:  "district_id",
+				expectedReason: `doesn't match province ID "35"`,
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				err := validateIDs(testCase.level, testCase.id, testCase.filters)
+
+				var idErr *IDError
+				if assert.ErrorAs(t, err, &idErr) {
+					assert.Equal(t, testCase.expectedField, idErr.Field)
+					assert.Equal(t, testCase.expectedReason, idErr.Reason)
+				}
+			})
+		}
+	})
+}
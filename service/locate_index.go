@@ -0,0 +1,98 @@
+package service
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/erikrios/ponorogo-regency-api/entity"
+	"github.com/erikrios/ponorogo-regency-api/geojson"
+)
+
+const (
+	locateCellSize = 0.01
+	locateMaxSpan  = 1.0
+)
+
+type locateIndex struct {
+	entries []locateEntry
+	cells   map[locateCell][]int
+}
+
+type locateEntry struct {
+	id       string
+	bounds   geojson.BBox
+	geometry geojson.Geometry
+}
+
+type locateCell [2]int
+
+func cellOf(position geojson.Position) locateCell {
+	return locateCell{
+		int(math.Floor(position[0] / locateCellSize)),
+		int(math.Floor(position[1] / locateCellSize)),
+	}
+}
+
+func newLocateIndex(boundaries []entity.Boundary) (*locateIndex, error) {
+	index := &locateIndex{cells: make(map[locateCell][]int)}
+
+	for _, boundary := range boundaries {
+		if boundary.Geometry == nil {
+			continue
+		}
+
+		geometry, err := geojson.Parse([]byte(*boundary.Geometry))
+		if err != nil {
+			return nil, fmt.Errorf("boundary of %s: %w", boundary.ID, err)
+		}
+
+		if err = validateBoundary(geometry); err != nil {
+			return nil, fmt.Errorf("boundary of %s: %w", boundary.ID, err)
+		}
+
+		entry := locateEntry{id: boundary.ID, bounds: geometry.Bounds(), geometry: geometry}
+		index.entries = append(index.entries, entry)
+
+		min, max := cellOf(entry.bounds.Min), cellOf(entry.bounds.Max)
+		for column := min[0]; column <= max[0]; column++ {
+			for row := min[1]; row <= max[1]; row++ {
+				cell := locateCell{column, row}
+				index.cells[cell] = append(index.cells[cell], len(index.entries)-1)
+			}
+		}
+	}
+
+	return index, nil
+}
+
+func validateBoundary(geometry geojson.Geometry) error {
+	for _, polygon := range geometry.Polygons {
+		for _, ring := range polygon {
+			for _, position := range ring {
+				if !(position[0] >= -180 && position[0] <= 180 && position[1] >= -90 && position[1] <= 90) {
+					return fmt.Errorf("position %v out of range", position)
+				}
+			}
+		}
+	}
+
+	bounds := geometry.Bounds()
+	if bounds.Max[0]-bounds.Min[0] > locateMaxSpan || bounds.Max[1]-bounds.Min[1] > locateMaxSpan {
+		return fmt.Errorf("bounding box %v spans more than %g degrees", bounds, locateMaxSpan)
+	}
+
+	return nil
+}
+
+func (i *locateIndex) lookup(lat, lng float64) (id string, found bool) {
+	position := geojson.Position{lng, lat}
+
+	for _, candidate := range i.cells[cellOf(position)] {
+		entry := i.entries[candidate]
+		if entry.bounds.Contains(position) && entry.geometry.Contains(position) {
+			return entry.id, true
+		}
+	}
+
+	return "", false
+}
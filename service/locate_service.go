@@ -0,0 +1,12 @@
+package service
+
+import (
+	"context"
+
+	"github.com/erikrios/ponorogo-regency-api/model"
+)
+
+type LocateService interface {
+	Locate(ctx context.Context, lat, lng float64) (location model.Location, err error)
+	Refresh(ctx context.Context) (err error)
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"log"
+	"sync"
+
+	"github.com/erikrios/ponorogo-regency-api/model"
+	"github.com/erikrios/ponorogo-regency-api/repository"
+)
+
+type locateServiceImpl struct {
+	villageRepository  repository.VillageRepository
+	districtRepository repository.DistrictRepository
+
+	mu        sync.RWMutex
+	villages  *locateIndex
+	districts *locateIndex
+}
+
+func NewLocateServiceImpl(villageRepository repository.VillageRepository, districtRepository repository.DistrictRepository) *locateServiceImpl {
+	return &locateServiceImpl{villageRepository: villageRepository, districtRepository: districtRepository}
+}
+
+func (l *locateServiceImpl) Locate(ctx context.Context, lat, lng float64) (location model.Location, err error) {
+	if err = validatePoint(lat, lng); err != nil {
+		return
+	}
+
+	l.mu.RLock()
+	villages, districts := l.villages, l.districts
+	l.mu.RUnlock()
+
+	if villages == nil {
+		if err = l.Refresh(ctx); err != nil {
+			return
+		}
+
+		l.mu.RLock()
+		villages, districts = l.villages, l.districts
+		l.mu.RUnlock()
+	}
+
+	if id, found := villages.lookup(lat, lng); found {
+		village, repoErr := l.villageRepository.FindByID(ctx, id)
+		if repoErr != nil {
+			err = mapError(repoErr)
+			return
+		}
+
+		response := mapVillage(village)
+		location = model.Location{
+			Village:  &response,
+			District: response.District,
+			Regency:  response.District.Regency,
+			Province: response.District.Regency.Province,
+		}
+		return
+	}
+
+	// The boundary of a district may be known while the ones of its villages aren't.
+	id, found := districts.lookup(lat, lng)
+	if !found {
+		err = ErrNotLocated
+		return
+	}
+
+	district, repoErr := l.districtRepository.FindByID(ctx, id)
+	if repoErr != nil {
+		err = mapError(repoErr)
+		return
+	}
+
+	response := mapDistrict(district)
+	location = model.Location{
+		District: response,
+		Regency:  response.Regency,
+		Province: response.Regency.Province,
+	}
+	return
+}
+
+func (l *locateServiceImpl) Refresh(ctx context.Context) (err error) {
+	villageBoundaries, repoErr := l.villageRepository.FindBoundaries(ctx)
+	if repoErr != nil {
+		return mapError(repoErr)
+	}
+
+	districtBoundaries, repoErr := l.districtRepository.FindBoundaries(ctx)
+	if repoErr != nil {
+		return mapError(repoErr)
+	}
+
+	villages, indexErr := newLocateIndex(villageBoundaries)
+	if indexErr != nil {
+		log.Println(indexErr)
+		return ErrRepository
+	}
+
+	districts, indexErr := newLocateIndex(districtBoundaries)
+	if indexErr != nil {
+		log.Println(indexErr)
+		return ErrRepository
+	}
+
+	l.mu.Lock()
+	l.villages, l.districts = villages, districts
+	l.mu.Unlock()
+	return
+}
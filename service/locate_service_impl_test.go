@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/erikrios/ponorogo-regency-api/entity"
+	"github.com/erikrios/ponorogo-regency-api/repository"
+	"github.com/erikrios/ponorogo-regency-api/repository/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestLocateServiceImpl(t *testing.T) {
+	// Two villages side by side, the first one west of longitude 111.45 and the second one east of it,
+	// inside a district stretching further north than them.
+	west := `{"type": "Polygon", "coordinates": [[[111.40, -7.90], [111.45, -7.90], [111.45, -7.85], [111.40, -7.85], [111.40, -7.90]]]}`
+	east := `{"type": "MultiPolygon", "coordinates": [[[[111.45, -7.90], [111.50, -7.90], [111.50, -7.85], [111.45, -7.85], [111.45, -7.90]]]]}`
+	whole := `{"type": "Polygon", "coordinates": [[[111.40, -7.90], [111.50, -7.90], [111.50, -7.75], [111.40, -7.75], [111.40, -7.90]]]}`
+	villageBoundaries := []entity.Boundary{
+		{ID: "3502180002", Name: "CEKOK", Geometry: &west},
+		{ID: "3502180001", Name: "KERTOSARI", Geometry: &east},
+		{ID: "3502180003", Name: "PATIHAN WETAN"},
+	}
+	districtBoundaries := []entity.Boundary{
+		{ID: "3502180", Name: "BABADAN", Geometry: &whole},
+	}
+
+	district := entity.District{
+		ID:   "3502180",
+		Name: "BABADAN",
+		Regency: entity.Regency{
+			ID:       "3502",
+			Name:     "KABUPATEN PONOROGO",
+			Province: entity.Province{ID: "35", Name: "JAWA TIMUR"},
+		},
+	}
+	village := entity.Village{ID: "3502180001", Name: "KERTOSARI", Type: "kelurahan", District: district}
+
+	t.Run("TestNewLocateServiceImpl", func(t *testing.T) {
+		t.Run("it should return valid locate service instance, when invoke the function", func(t *testing.T) {
+			var service LocateService = NewLocateServiceImpl(&mocks.VillageRepository{}, &mocks.DistrictRepository{})
+			assert.NotNil(t, service)
+		})
+	})
+
+	t.Run("TestLocate", func(t *testing.T) {
+		mockVillageRepo := &mocks.VillageRepository{}
+		mockVillageRepo.On("FindBoundaries", mock.AnythingOfType(fmt.Sprintf("%T", context.Background()))).Return(villageBoundaries, nil).Once()
+		mockVillageRepo.On("FindByID", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), village.ID).Return(village, nil).Once()
+
+		mockDistrictRepo := &mocks.DistrictRepository{}
+		mockDistrictRepo.On("FindBoundaries", mock.AnythingOfType(fmt.Sprintf("%T", context.Background()))).Return(districtBoundaries, nil).Once()
+		mockDistrictRepo.On("FindByID", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), district.ID).Return(district, nil).Once()
+
+		var service LocateService = NewLocateServiceImpl(mockVillageRepo, mockDistrictRepo)
+
+		t.Run("it should return the village containing the point with its parents, when the point is inside a village boundary", func(t *testing.T) {
+			got, err := service.Locate(context.Background(), -7.87, 111.47)
+			assert.NoError(t, err)
+			if assert.NotNil(t, got.Village) {
+				assert.Equal(t, mapVillage(village), *got.Village)
+			}
+			assert.Equal(t, "3502180", got.District.ID)
+			assert.Equal(t, "3502", got.Regency.ID)
+			assert.Equal(t, "35", got.Province.ID)
+		})
+
+		t.Run("it should return the district containing the point with its parents, when the point is only inside a district boundary", func(t *testing.T) {
+			got, err := service.Locate(context.Background(), -7.80, 111.47)
+			assert.NoError(t, err)
+			assert.Nil(t, got.Village)
+			assert.Equal(t, mapDistrict(district), got.District)
+			assert.Equal(t, "3502", got.Regency.ID)
+			assert.Equal(t, "35", got.Province.ID)
+		})
+
+		t.Run("it should return ErrNotLocated instance, when no boundary contains the point", func(t *testing.T) {
+			_, err := service.Locate(context.Background(), -7.70, 111.47)
+			assert.ErrorIs(t, err, ErrNotLocated)
+		})
+
+		t.Run("it should return ErrInvalidQuery instance, when given a point out of range", func(t *testing.T) {
+			_, err := service.Locate(context.Background(), -97.87, 111.47)
+			assert.ErrorIs(t, err, ErrInvalidQuery)
+		})
+
+		t.Run("it should build the indexes only once, when invoked several times", func(t *testing.T) {
+			mockVillageRepo.AssertNumberOfCalls(t, "FindBoundaries", 1)
+			mockDistrictRepo.AssertNumberOfCalls(t, "FindBoundaries", 1)
+		})
+	})
+
+	t.Run("TestRefresh", func(t *testing.T) {
+		t.Run("it should return ErrRepository instance, when the village repository fails", func(t *testing.T) {
+			mockVillageRepo := &mocks.VillageRepository{}
+			mockVillageRepo.On("FindBoundaries", mock.AnythingOfType(fmt.Sprintf("%T", context.Background()))).Return(nil, repository.ErrDatabase).Once()
+
+			var service LocateService = NewLocateServiceImpl(mockVillageRepo, &mocks.DistrictRepository{})
+			assert.ErrorIs(t, service.Refresh(context.Background()), ErrRepository)
+		})
+
+		t.Run("it should return ErrRepository instance, when the district repository fails", func(t *testing.T) {
+			mockVillageRepo := &mocks.VillageRepository{}
+			mockVillageRepo.On("FindBoundaries", mock.AnythingOfType(fmt.Sprintf("%T", context.Background()))).Return(villageBoundaries, nil).Once()
+			mockDistrictRepo := &mocks.DistrictRepository{}
+			mockDistrictRepo.On("FindBoundaries", mock.AnythingOfType(fmt.Sprintf("%T", context.Background()))).Return(nil, repository.ErrDatabase).Once()
+
+			var service LocateService = NewLocateServiceImpl(mockVillageRepo, mockDistrictRepo)
+			assert.ErrorIs(t, service.Refresh(context.Background()), ErrRepository)
+		})
+
+		t.Run("it should return ErrRepository instance, when a stored geometry is invalid", func(t *testing.T) {
+			corrupt := `{"type": "Point", "coordinates": [111.40, -7.90]}`
+			mockVillageRepo := &mocks.VillageRepository{}
+			mockVillageRepo.On("FindBoundaries", mock.AnythingOfType(fmt.Sprintf("%T", context.Background()))).Return(villageBoundaries, nil).Once()
+			mockDistrictRepo := &mocks.DistrictRepository{}
+			mockDistrictRepo.On("FindBoundaries", mock.AnythingOfType(fmt.Sprintf("%T", context.Background()))).Return([]entity.Boundary{{ID: "3502180", Geometry: &corrupt}}, nil).Once()
+
+			var service LocateService = NewLocateServiceImpl(mockVillageRepo, mockDistrictRepo)
+			assert.ErrorIs(t, service.Refresh(context.Background()), ErrRepository)
+		})
+	})
+}
+
+func TestLocateIndex(t *testing.T) {
+	square := `{"type": "Polygon", "coordinates": [[[111.40, -7.90], [111.45, -7.90], [111.45, -7.85], [111.40, -7.85], [111.40, -7.90]]]}`
+	index, err := newLocateIndex([]entity.Boundary{{ID: "3502180001", Geometry: &square}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("it should list the boundary in every cell its bounding box overlaps, when indexing it", func(t *testing.T) {
+		assert.Len(t, index.cells, 36)
+	})
+
+	t.Run("it should find the boundary from any of its cells, when given points inside it", func(t *testing.T) {
+		for _, point := range [][2]float64{{-7.899, 111.401}, {-7.875, 111.425}, {-7.851, 111.449}} {
+			id, found := index.lookup(point[0], point[1])
+			assert.True(t, found)
+			assert.Equal(t, "3502180001", id)
+		}
+	})
+
+	t.Run("it should not find any boundary, when given a point outside of the grid", func(t *testing.T) {
+		_, found := index.lookup(-8.5, 111.425)
+		assert.False(t, found)
+	})
+
+	t.Run("it should return error, when a boundary has a position out of range", func(t *testing.T) {
+		swapped := `{"type": "Polygon", "coordinates": [[[-7.90, 111.40], [-7.90, 111.45], [-7.85, 111.45], [-7.85, 111.40], [-7.90, 111.40]]]}`
+		_, err := newLocateIndex([]entity.Boundary{{ID: "3502180001", Geometry: &swapped}})
+		assert.Error(t, err)
+	})
+
+	t.Run("it should return error, when a boundary spans too many cells", func(t *testing.T) {
+		unsigned := `{"type": "Polygon", "coordinates": [[[111.40, -7.90], [111.45, -7.90], [111.45, 7.85], [111.40, -7.85], [111.40, -7.90]]]}`
+		_, err := newLocateIndex([]entity.Boundary{{ID: "3502180001", Geometry: &unsigned}})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,36 @@
+// Code generated by mockery v2.10.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/erikrios/ponorogo-regency-api/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CodeService is an autogenerated mock type for the CodeService type
+type CodeService struct {
+	mock.Mock
+}
+
+// Decode provides a mock function with given fields: ctx, code
+func (_m *CodeService) Decode(ctx context.Context, code string) (model.Code, error) {
+	ret := _m.Called(ctx, code)
+
+	var r0 model.Code
+	if rf, ok := ret.Get(0).(func(context.Context, string) model.Code); ok {
+		r0 = rf(ctx, code)
+	} else {
+		r0 = ret.Get(0).(model.Code)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
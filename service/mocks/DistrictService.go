@@ -5,8 +5,10 @@ package mocks
 import (
 	context "context"
 
-	model "github.com/erikrios/ponorogo-regency-api/model"
+	geojson "github.com/erikrios/ponorogo-regency-api/geojson"
 	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/erikrios/ponorogo-regency-api/model"
 )
 
 // DistrictService is an autogenerated mock type for the DistrictService type
@@ -14,27 +16,34 @@ type DistrictService struct {
 	mock.Mock
 }
 
-// GetAll provides a mock function with given fields: ctx, keyword
-func (_m *DistrictService) GetAll(ctx context.Context, keyword string) ([]model.District, error) {
-	ret := _m.Called(ctx, keyword)
+// GetAll provides a mock function with given fields: ctx, keyword, query
+func (_m *DistrictService) GetAll(ctx context.Context, keyword string, query model.ListQuery) ([]model.District, int, error) {
+	ret := _m.Called(ctx, keyword, query)
 
 	var r0 []model.District
-	if rf, ok := ret.Get(0).(func(context.Context, string) []model.District); ok {
-		r0 = rf(ctx, keyword)
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.ListQuery) []model.District); ok {
+		r0 = rf(ctx, keyword, query)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.District)
 		}
 	}
 
-	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, keyword)
+	var r1 int
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.ListQuery) int); ok {
+		r1 = rf(ctx, keyword, query)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(int)
 	}
 
-	return r0, r1
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, model.ListQuery) error); ok {
+		r2 = rf(ctx, keyword, query)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
 // GetByID provides a mock function with given fields: ctx, id
@@ -58,22 +67,61 @@ func (_m *DistrictService) GetByID(ctx context.Context, id string) (model.Distri
 	return r0, r1
 }
 
-// GetVillagesByDistrictID provides a mock function with given fields: ctx, id
-func (_m *DistrictService) GetVillagesByDistrictID(ctx context.Context, id string) ([]model.Village, error) {
-	ret := _m.Called(ctx, id)
+// GetByIDs provides a mock function with given fields: ctx, ids
+func (_m *DistrictService) GetByIDs(ctx context.Context, ids []string) ([]model.District, []string, []string, error) {
+	ret := _m.Called(ctx, ids)
 
-	var r0 []model.Village
-	if rf, ok := ret.Get(0).(func(context.Context, string) []model.Village); ok {
-		r0 = rf(ctx, id)
+	var r0 []model.District
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []model.District); ok {
+		r0 = rf(ctx, ids)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]model.Village)
+			r0 = ret.Get(0).([]model.District)
 		}
 	}
 
+	var r1 []string
+	if rf, ok := ret.Get(1).(func(context.Context, []string) []string); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]string)
+		}
+	}
+
+	var r2 []string
+	if rf, ok := ret.Get(2).(func(context.Context, []string) []string); ok {
+		r2 = rf(ctx, ids)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).([]string)
+		}
+	}
+
+	var r3 error
+	if rf, ok := ret.Get(3).(func(context.Context, []string) error); ok {
+		r3 = rf(ctx, ids)
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
+}
+
+// GetGeometry provides a mock function with given fields: ctx, id, tolerance
+func (_m *DistrictService) GetGeometry(ctx context.Context, id string, tolerance float64) (geojson.Feature, error) {
+	ret := _m.Called(ctx, id, tolerance)
+
+	var r0 geojson.Feature
+	if rf, ok := ret.Get(0).(func(context.Context, string, float64) geojson.Feature); ok {
+		r0 = rf(ctx, id, tolerance)
+	} else {
+		r0 = ret.Get(0).(geojson.Feature)
+	}
+
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, string, float64) error); ok {
+		r1 = rf(ctx, id, tolerance)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -81,22 +129,80 @@ func (_m *DistrictService) GetVillagesByDistrictID(ctx context.Context, id strin
 	return r0, r1
 }
 
-// GetVillagesByDistrictName provides a mock function with given fields: ctx, keyword
-func (_m *DistrictService) GetVillagesByDistrictName(ctx context.Context, keyword string) ([]model.Village, error) {
-	ret := _m.Called(ctx, keyword)
+// GetVillagesByDistrictID provides a mock function with given fields: ctx, id, query
+func (_m *DistrictService) GetVillagesByDistrictID(ctx context.Context, id string, query model.ListQuery) ([]model.Village, int, error) {
+	ret := _m.Called(ctx, id, query)
+
+	var r0 []model.Village
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.ListQuery) []model.Village); ok {
+		r0 = rf(ctx, id, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Village)
+		}
+	}
+
+	var r1 int
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.ListQuery) int); ok {
+		r1 = rf(ctx, id, query)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, model.ListQuery) error); ok {
+		r2 = rf(ctx, id, query)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetVillagesByDistrictName provides a mock function with given fields: ctx, keyword, query
+func (_m *DistrictService) GetVillagesByDistrictName(ctx context.Context, keyword string, query model.ListQuery) ([]model.Village, int, error) {
+	ret := _m.Called(ctx, keyword, query)
 
 	var r0 []model.Village
-	if rf, ok := ret.Get(0).(func(context.Context, string) []model.Village); ok {
-		r0 = rf(ctx, keyword)
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.ListQuery) []model.Village); ok {
+		r0 = rf(ctx, keyword, query)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.Village)
 		}
 	}
 
+	var r1 int
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.ListQuery) int); ok {
+		r1 = rf(ctx, keyword, query)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, model.ListQuery) error); ok {
+		r2 = rf(ctx, keyword, query)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetVillagesGeometry provides a mock function with given fields: ctx, id, tolerance
+func (_m *DistrictService) GetVillagesGeometry(ctx context.Context, id string, tolerance float64) (geojson.FeatureCollection, error) {
+	ret := _m.Called(ctx, id, tolerance)
+
+	var r0 geojson.FeatureCollection
+	if rf, ok := ret.Get(0).(func(context.Context, string, float64) geojson.FeatureCollection); ok {
+		r0 = rf(ctx, id, tolerance)
+	} else {
+		r0 = ret.Get(0).(geojson.FeatureCollection)
+	}
+
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, keyword)
+	if rf, ok := ret.Get(1).(func(context.Context, string, float64) error); ok {
+		r1 = rf(ctx, id, tolerance)
 	} else {
 		r1 = ret.Error(1)
 	}
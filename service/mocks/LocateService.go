@@ -0,0 +1,50 @@
+// Code generated by mockery v2.10.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/erikrios/ponorogo-regency-api/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// LocateService is an autogenerated mock type for the LocateService type
+type LocateService struct {
+	mock.Mock
+}
+
+// Locate provides a mock function with given fields: ctx, lat, lng
+func (_m *LocateService) Locate(ctx context.Context, lat float64, lng float64) (model.Location, error) {
+	ret := _m.Called(ctx, lat, lng)
+
+	var r0 model.Location
+	if rf, ok := ret.Get(0).(func(context.Context, float64, float64) model.Location); ok {
+		r0 = rf(ctx, lat, lng)
+	} else {
+		r0 = ret.Get(0).(model.Location)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, float64, float64) error); ok {
+		r1 = rf(ctx, lat, lng)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Refresh provides a mock function with given fields: ctx
+func (_m *LocateService) Refresh(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -14,27 +14,34 @@ type ProvinceService struct {
 	mock.Mock
 }
 
-// GetAll provides a mock function with given fields: ctx, keyword
-func (_m *ProvinceService) GetAll(ctx context.Context, keyword string) ([]model.Province, error) {
-	ret := _m.Called(ctx, keyword)
+// GetAll provides a mock function with given fields: ctx, keyword, query
+func (_m *ProvinceService) GetAll(ctx context.Context, keyword string, query model.ListQuery) ([]model.Province, int, error) {
+	ret := _m.Called(ctx, keyword, query)
 
 	var r0 []model.Province
-	if rf, ok := ret.Get(0).(func(context.Context, string) []model.Province); ok {
-		r0 = rf(ctx, keyword)
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.ListQuery) []model.Province); ok {
+		r0 = rf(ctx, keyword, query)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.Province)
 		}
 	}
 
-	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, keyword)
+	var r1 int
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.ListQuery) int); ok {
+		r1 = rf(ctx, keyword, query)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(int)
 	}
 
-	return r0, r1
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, model.ListQuery) error); ok {
+		r2 = rf(ctx, keyword, query)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
 // GetByID provides a mock function with given fields: ctx, id
@@ -57,3 +64,54 @@ func (_m *ProvinceService) GetByID(ctx context.Context, id string) (model.Provin
 
 	return r0, r1
 }
+
+// GetRegenciesByProvinceID provides a mock function with given fields: ctx, id, query
+func (_m *ProvinceService) GetRegenciesByProvinceID(ctx context.Context, id string, query model.ListQuery) ([]model.Regency, int, error) {
+	ret := _m.Called(ctx, id, query)
+
+	var r0 []model.Regency
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.ListQuery) []model.Regency); ok {
+		r0 = rf(ctx, id, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Regency)
+		}
+	}
+
+	var r1 int
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.ListQuery) int); ok {
+		r1 = rf(ctx, id, query)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, model.ListQuery) error); ok {
+		r2 = rf(ctx, id, query)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetTree provides a mock function with given fields: ctx, id, depth
+func (_m *ProvinceService) GetTree(ctx context.Context, id string, depth int) (model.ProvinceTree, error) {
+	ret := _m.Called(ctx, id, depth)
+
+	var r0 model.ProvinceTree
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) model.ProvinceTree); ok {
+		r0 = rf(ctx, id, depth)
+	} else {
+		r0 = ret.Get(0).(model.ProvinceTree)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, id, depth)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,28 @@
+// Code generated by mockery v2.10.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Refresher is an autogenerated mock type for the Refresher type
+type Refresher struct {
+	mock.Mock
+}
+
+// Refresh provides a mock function with given fields: ctx
+func (_m *Refresher) Refresh(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
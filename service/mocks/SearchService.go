@@ -0,0 +1,45 @@
+// Code generated by mockery v2.10.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/erikrios/ponorogo-regency-api/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SearchService is an autogenerated mock type for the SearchService type
+type SearchService struct {
+	mock.Mock
+}
+
+// Search provides a mock function with given fields: ctx, q, levels, limit
+func (_m *SearchService) Search(ctx context.Context, q string, levels []string, limit int) ([]model.SearchHit, int, error) {
+	ret := _m.Called(ctx, q, levels, limit)
+
+	var r0 []model.SearchHit
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, int) []model.SearchHit); ok {
+		r0 = rf(ctx, q, levels, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.SearchHit)
+		}
+	}
+
+	var r1 int
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string, int) int); ok {
+		r1 = rf(ctx, q, levels, limit)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, []string, int) error); ok {
+		r2 = rf(ctx, q, levels, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
@@ -0,0 +1,52 @@
+// Code generated by mockery v2.10.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/erikrios/ponorogo-regency-api/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SuggestService is an autogenerated mock type for the SuggestService type
+type SuggestService struct {
+	mock.Mock
+}
+
+// Refresh provides a mock function with given fields: ctx
+func (_m *SuggestService) Refresh(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Suggest provides a mock function with given fields: ctx, prefix, limit
+func (_m *SuggestService) Suggest(ctx context.Context, prefix string, limit int) ([]model.Suggestion, error) {
+	ret := _m.Called(ctx, prefix, limit)
+
+	var r0 []model.Suggestion
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) []model.Suggestion); ok {
+		r0 = rf(ctx, prefix, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Suggestion)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, prefix, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -5,8 +5,10 @@ package mocks
 import (
 	context "context"
 
-	model "github.com/erikrios/ponorogo-regency-api/model"
+	geojson "github.com/erikrios/ponorogo-regency-api/geojson"
 	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/erikrios/ponorogo-regency-api/model"
 )
 
 // VillageService is an autogenerated mock type for the VillageService type
@@ -14,27 +16,34 @@ type VillageService struct {
 	mock.Mock
 }
 
-// GetAll provides a mock function with given fields: ctx, keyword
-func (_m *VillageService) GetAll(ctx context.Context, keyword string) ([]model.Village, error) {
-	ret := _m.Called(ctx, keyword)
+// GetAll provides a mock function with given fields: ctx, keyword, query
+func (_m *VillageService) GetAll(ctx context.Context, keyword string, query model.ListQuery) ([]model.Village, int, error) {
+	ret := _m.Called(ctx, keyword, query)
 
 	var r0 []model.Village
-	if rf, ok := ret.Get(0).(func(context.Context, string) []model.Village); ok {
-		r0 = rf(ctx, keyword)
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.ListQuery) []model.Village); ok {
+		r0 = rf(ctx, keyword, query)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.Village)
 		}
 	}
 
-	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, keyword)
+	var r1 int
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.ListQuery) int); ok {
+		r1 = rf(ctx, keyword, query)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(int)
 	}
 
-	return r0, r1
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, model.ListQuery) error); ok {
+		r2 = rf(ctx, keyword, query)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
 // GetByID provides a mock function with given fields: ctx, id
@@ -57,3 +66,125 @@ func (_m *VillageService) GetByID(ctx context.Context, id string) (model.Village
 
 	return r0, r1
 }
+
+// GetByIDs provides a mock function with given fields: ctx, ids
+func (_m *VillageService) GetByIDs(ctx context.Context, ids []string) ([]model.Village, []string, []string, error) {
+	ret := _m.Called(ctx, ids)
+
+	var r0 []model.Village
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []model.Village); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Village)
+		}
+	}
+
+	var r1 []string
+	if rf, ok := ret.Get(1).(func(context.Context, []string) []string); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]string)
+		}
+	}
+
+	var r2 []string
+	if rf, ok := ret.Get(2).(func(context.Context, []string) []string); ok {
+		r2 = rf(ctx, ids)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).([]string)
+		}
+	}
+
+	var r3 error
+	if rf, ok := ret.Get(3).(func(context.Context, []string) error); ok {
+		r3 = rf(ctx, ids)
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
+}
+
+// GetByPostalCode provides a mock function with given fields: ctx, code
+func (_m *VillageService) GetByPostalCode(ctx context.Context, code string) ([]model.Village, error) {
+	ret := _m.Called(ctx, code)
+
+	var r0 []model.Village
+	if rf, ok := ret.Get(0).(func(context.Context, string) []model.Village); ok {
+		r0 = rf(ctx, code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Village)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetGeometry provides a mock function with given fields: ctx, id, tolerance
+func (_m *VillageService) GetGeometry(ctx context.Context, id string, tolerance float64) (geojson.Feature, error) {
+	ret := _m.Called(ctx, id, tolerance)
+
+	var r0 geojson.Feature
+	if rf, ok := ret.Get(0).(func(context.Context, string, float64) geojson.Feature); ok {
+		r0 = rf(ctx, id, tolerance)
+	} else {
+		r0 = ret.Get(0).(geojson.Feature)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, float64) error); ok {
+		r1 = rf(ctx, id, tolerance)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetNearest provides a mock function with given fields: ctx, lat, lng, limit
+func (_m *VillageService) GetNearest(ctx context.Context, lat float64, lng float64, limit int) ([]model.Village, error) {
+	ret := _m.Called(ctx, lat, lng, limit)
+
+	var r0 []model.Village
+	if rf, ok := ret.Get(0).(func(context.Context, float64, float64, int) []model.Village); ok {
+		r0 = rf(ctx, lat, lng, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Village)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, float64, float64, int) error); ok {
+		r1 = rf(ctx, lat, lng, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Stream provides a mock function with given fields: ctx, districtID, districtKeyword, fn
+func (_m *VillageService) Stream(ctx context.Context, districtID string, districtKeyword string, fn func(model.Village) error) error {
+	ret := _m.Called(ctx, districtID, districtKeyword, fn)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, func(model.Village) error) error); ok {
+		r0 = rf(ctx, districtID, districtKeyword, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -7,6 +7,8 @@ import (
 )
 
 type ProvinceService interface {
-	GetAll(ctx context.Context, keyword string) (responses []model.Province, err error)
+	GetAll(ctx context.Context, keyword string, query model.ListQuery) (responses []model.Province, total int, err error)
 	GetByID(ctx context.Context, id string) (response model.Province, err error)
+	GetRegenciesByProvinceID(ctx context.Context, id string, query model.ListQuery) (responses []model.Regency, total int, err error)
+	GetTree(ctx context.Context, id string, depth int) (response model.ProvinceTree, err error)
 }
@@ -3,27 +3,43 @@ package service
 import (
 	"context"
 
+	"github.com/erikrios/ponorogo-regency-api/display"
 	"github.com/erikrios/ponorogo-regency-api/entity"
 	"github.com/erikrios/ponorogo-regency-api/model"
 	"github.com/erikrios/ponorogo-regency-api/repository"
 )
 
 type provinceServiceImpl struct {
-	repository repository.ProvinceRepository
+	provinceRepository repository.ProvinceRepository
+	regencyRepository  repository.RegencyRepository
 }
 
-func NewProvinceServiceImpl(repository repository.ProvinceRepository) *provinceServiceImpl {
-	return &provinceServiceImpl{repository: repository}
+func NewProvinceServiceImpl(
+	provinceRepository repository.ProvinceRepository,
+	regencyRepository repository.RegencyRepository,
+) *provinceServiceImpl {
+	return &provinceServiceImpl{
+		provinceRepository: provinceRepository,
+		regencyRepository:  regencyRepository,
+	}
 }
 
-func (p *provinceServiceImpl) GetAll(ctx context.Context, keyword string) (responses []model.Province, err error) {
+func (p *provinceServiceImpl) GetAll(ctx context.Context, keyword string, query model.ListQuery) (responses []model.Province, total int, err error) {
+	if err = validateIDs("", "", query.Filters); err != nil {
+		return
+	}
+
+	if query.Match == model.MatchFuzzy {
+		return p.getAllFuzzy(ctx, keyword, query)
+	}
+
 	var provinces []entity.Province
 	var repoErr error
 
 	if keyword == "" {
-		provinces, repoErr = p.repository.FindAll(ctx)
+		provinces, total, repoErr = p.provinceRepository.FindAll(ctx, mapQuery(query))
 	} else {
-		provinces, repoErr = p.repository.FindByName(ctx, keyword)
+		provinces, total, repoErr = p.provinceRepository.FindByName(ctx, keyword, mapQuery(query))
 	}
 
 	if repoErr != nil {
@@ -40,7 +56,11 @@ func (p *provinceServiceImpl) GetAll(ctx context.Context, keyword string) (respo
 }
 
 func (p *provinceServiceImpl) GetByID(ctx context.Context, id string) (response model.Province, err error) {
-	province, repoErr := p.repository.FindByID(ctx, id)
+	if err = validateIDs(LevelProvince, id, nil); err != nil {
+		return
+	}
+
+	province, repoErr := p.provinceRepository.FindByID(ctx, id)
 	if repoErr != nil {
 		err = mapError(repoErr)
 		return
@@ -50,9 +70,82 @@ func (p *provinceServiceImpl) GetByID(ctx context.Context, id string) (response
 	return
 }
 
+func (p *provinceServiceImpl) GetRegenciesByProvinceID(ctx context.Context, id string, query model.ListQuery) (responses []model.Regency, total int, err error) {
+	if err = validateIDs(LevelProvince, id, query.Filters); err != nil {
+		return
+	}
+
+	regencies, total, repoErr := p.regencyRepository.FindByProvinceID(ctx, id, mapQuery(query))
+	if repoErr != nil {
+		err = mapError(repoErr)
+		return
+	}
+
+	responses = p.mapToRegencyModels(regencies)
+	return
+}
+
+func (p *provinceServiceImpl) GetTree(ctx context.Context, id string, depth int) (response model.ProvinceTree, err error) {
+	if err = validateIDs(LevelProvince, id, nil); err != nil {
+		return
+	}
+
+	rows, repoErr := p.provinceRepository.FindTree(ctx, id, depth)
+	if repoErr != nil {
+		err = mapError(repoErr)
+		return
+	}
+
+	province := rows[0].District.Regency.Province
+	response = model.ProvinceTree{ID: province.ID, Name: province.Name, DisplayName: display.Name(province.Name)}
+
+	for _, row := range rows {
+		response.Regencies = appendRegencyRow(response.Regencies, row)
+	}
+	return
+}
+
+func (p *provinceServiceImpl) getAllFuzzy(ctx context.Context, keyword string, query model.ListQuery) (responses []model.Province, total int, err error) {
+	repoQuery, err := fuzzyQuery(keyword, query)
+	if err != nil {
+		return
+	}
+
+	provinces, _, repoErr := p.provinceRepository.FindAll(ctx, repoQuery)
+	if repoErr != nil {
+		err = mapError(repoErr)
+		return
+	}
+
+	provinces, scores, total := rankFuzzy(keyword, provinces, func(province entity.Province) string { return province.Name }, query)
+
+	responses = make([]model.Province, len(provinces))
+	for i, province := range provinces {
+		responses[i] = p.mapToModel(province)
+		responses[i].Score = scores[i]
+	}
+	return
+}
+
 func (p *provinceServiceImpl) mapToModel(e entity.Province) model.Province {
 	return model.Province{
-		ID:   e.ID,
-		Name: e.Name,
+		ID:          e.ID,
+		Name:        e.Name,
+		DisplayName: display.Name(e.Name),
+	}
+}
+
+func (p *provinceServiceImpl) mapToRegencyModels(entities []entity.Regency) []model.Regency {
+	regencies := make([]model.Regency, len(entities))
+
+	for i, e := range entities {
+		regencies[i] = model.Regency{
+			ID:          e.ID,
+			Name:        e.Name,
+			DisplayName: display.Name(e.Name),
+			Province:    p.mapToModel(e.Province),
+		}
 	}
+
+	return regencies
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/erikrios/ponorogo-regency-api/display"
 	"github.com/erikrios/ponorogo-regency-api/entity"
 	"github.com/erikrios/ponorogo-regency-api/model"
 	"github.com/erikrios/ponorogo-regency-api/repository"
@@ -16,16 +17,18 @@ import (
 func TestProvinceServiceImpl(t *testing.T) {
 
 	t.Run("TestNewProvinceServiceImpl", func(t *testing.T) {
-		mockRepo := &mocks.ProvinceRepository{}
+		mockProvinceRepo := &mocks.ProvinceRepository{}
+		mockRegencyRepo := &mocks.RegencyRepository{}
 
 		t.Run("it should return valid province service instance, when invoke the function", func(t *testing.T) {
-			var service ProvinceService = NewProvinceServiceImpl(mockRepo)
+			var service ProvinceService = NewProvinceServiceImpl(mockProvinceRepo, mockRegencyRepo)
 			assert.NotNil(t, service)
 		})
 	})
 
 	t.Run("TestGetAll", func(t *testing.T) {
-		mockRepo := &mocks.ProvinceRepository{}
+		mockProvinceRepo := &mocks.ProvinceRepository{}
+		mockRegencyRepo := &mocks.RegencyRepository{}
 
 		dummyProvinces := []entity.Province{
 			{
@@ -35,19 +38,25 @@ func TestProvinceServiceImpl(t *testing.T) {
 		}
 
 		t.Run("success scenario", func(t *testing.T) {
-			mockRepo.On("FindAll", mock.AnythingOfType(fmt.Sprintf("%T", context.Background()))).Return(
-				func(ctx context.Context) []entity.Province {
+			mockProvinceRepo.On("FindAll", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("repository.Query")).Return(
+				func(ctx context.Context, query repository.Query) []entity.Province {
 					return dummyProvinces
 				},
-				func(ctx context.Context) error {
+				func(ctx context.Context, query repository.Query) int {
+					return len(dummyProvinces)
+				},
+				func(ctx context.Context, query repository.Query) error {
 					return nil
 				},
 			).Once()
-			mockRepo.On("FindByName", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string")).Return(
-				func(ctx context.Context, keyword string) []entity.Province {
+			mockProvinceRepo.On("FindByName", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string"), mock.AnythingOfType("repository.Query")).Return(
+				func(ctx context.Context, keyword string, query repository.Query) []entity.Province {
 					return dummyProvinces
 				},
-				func(ctx context.Context, keyword string) error {
+				func(ctx context.Context, keyword string, query repository.Query) int {
+					return len(dummyProvinces)
+				},
+				func(ctx context.Context, keyword string, query repository.Query) error {
 					return nil
 				},
 			).Once()
@@ -69,31 +78,38 @@ func TestProvinceServiceImpl(t *testing.T) {
 				},
 			}
 
-			var service ProvinceService = NewProvinceServiceImpl(mockRepo)
+			var service ProvinceService = NewProvinceServiceImpl(mockProvinceRepo, mockRegencyRepo)
 
 			for _, testCase := range testCases {
 				t.Run(testCase.name, func(t *testing.T) {
-					got, err := service.GetAll(context.Background(), testCase.keyword)
+					got, total, err := service.GetAll(context.Background(), testCase.keyword, model.ListQuery{})
 					assert.NoError(t, err)
 					assert.ElementsMatch(t, testCase.expected, got)
+					assert.Equal(t, len(testCase.expected), total)
 				})
 			}
 		})
 
 		t.Run("failed scenario", func(t *testing.T) {
-			mockRepo.On("FindAll", mock.AnythingOfType(fmt.Sprintf("%T", context.Background()))).Return(
-				func(ctx context.Context) []entity.Province {
+			mockProvinceRepo.On("FindAll", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("repository.Query")).Return(
+				func(ctx context.Context, query repository.Query) []entity.Province {
 					return []entity.Province{}
 				},
-				func(ctx context.Context) error {
+				func(ctx context.Context, query repository.Query) int {
+					return 0
+				},
+				func(ctx context.Context, query repository.Query) error {
 					return repository.ErrDatabase
 				},
 			).Once()
-			mockRepo.On("FindByName", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string")).Return(
-				func(ctx context.Context, keyword string) []entity.Province {
+			mockProvinceRepo.On("FindByName", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string"), mock.AnythingOfType("repository.Query")).Return(
+				func(ctx context.Context, keyword string, query repository.Query) []entity.Province {
 					return []entity.Province{}
 				},
-				func(ctx context.Context, keyword string) error {
+				func(ctx context.Context, keyword string, query repository.Query) int {
+					return 0
+				},
+				func(ctx context.Context, keyword string, query repository.Query) error {
 					return repository.ErrDatabase
 				},
 			).Once()
@@ -115,11 +131,11 @@ func TestProvinceServiceImpl(t *testing.T) {
 				},
 			}
 
-			var service ProvinceService = NewProvinceServiceImpl(mockRepo)
+			var service ProvinceService = NewProvinceServiceImpl(mockProvinceRepo, mockRegencyRepo)
 
 			for _, testCase := range testCases {
 				t.Run(testCase.name, func(t *testing.T) {
-					_, err := service.GetAll(context.Background(), testCase.keyword)
+					_, _, err := service.GetAll(context.Background(), testCase.keyword, model.ListQuery{})
 					assert.ErrorIs(t, err, testCase.expected)
 				})
 			}
@@ -127,7 +143,8 @@ func TestProvinceServiceImpl(t *testing.T) {
 	})
 
 	t.Run("TestGetByID", func(t *testing.T) {
-		mockRepo := &mocks.ProvinceRepository{}
+		mockProvinceRepo := &mocks.ProvinceRepository{}
+		mockRegencyRepo := &mocks.RegencyRepository{}
 
 		dummyProvince := entity.Province{
 			ID:   "33",
@@ -135,7 +152,7 @@ func TestProvinceServiceImpl(t *testing.T) {
 		}
 
 		t.Run("success scenario", func(t *testing.T) {
-			mockRepo.On("FindByID", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string")).Return(
+			mockProvinceRepo.On("FindByID", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string")).Return(
 				func(ctx context.Context, id string) entity.Province {
 					return dummyProvince
 				},
@@ -156,7 +173,7 @@ func TestProvinceServiceImpl(t *testing.T) {
 				},
 			}
 
-			var service ProvinceService = NewProvinceServiceImpl(mockRepo)
+			var service ProvinceService = NewProvinceServiceImpl(mockProvinceRepo, mockRegencyRepo)
 
 			for _, testCase := range testCases {
 				t.Run(testCase.name, func(t *testing.T) {
@@ -169,7 +186,7 @@ func TestProvinceServiceImpl(t *testing.T) {
 		})
 
 		t.Run("failed scenario", func(t *testing.T) {
-			mockRepo.On("FindByID", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string")).Return(
+			mockProvinceRepo.On("FindByID", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string")).Return(
 				func(ctx context.Context, id string) entity.Province {
 					return entity.Province{}
 				},
@@ -197,9 +214,14 @@ func TestProvinceServiceImpl(t *testing.T) {
 					id:       "90",
 					expected: ErrDataNotFound,
 				},
+				{
+					name:     "it should return ErrInvalidID instance, when ID is malformed",
+					id:       "3x",
+					expected: ErrInvalidID,
+				},
 			}
 
-			var service ProvinceService = NewProvinceServiceImpl(mockRepo)
+			var service ProvinceService = NewProvinceServiceImpl(mockProvinceRepo, mockRegencyRepo)
 
 			for _, testCase := range testCases {
 				t.Run(testCase.name, func(t *testing.T) {
@@ -209,12 +231,209 @@ func TestProvinceServiceImpl(t *testing.T) {
 			}
 		})
 	})
+
+	t.Run("TestGetRegenciesByProvinceID", func(t *testing.T) {
+		mockProvinceRepo := &mocks.ProvinceRepository{}
+		mockRegencyRepo := &mocks.RegencyRepository{}
+
+		dummyRegencies := []entity.Regency{
+			{
+				ID:   "3502",
+				Name: "KABUPATEN PONOROGO",
+				Province: entity.Province{
+					ID:   "35",
+					Name: "JAWA TIMUR",
+				},
+			},
+		}
+
+		t.Run("success scenario", func(t *testing.T) {
+			mockRegencyRepo.On("FindByProvinceID", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), "35", mock.AnythingOfType("repository.Query")).Return(
+				func(ctx context.Context, id string, query repository.Query) []entity.Regency {
+					return dummyRegencies
+				},
+				func(ctx context.Context, id string, query repository.Query) int {
+					return len(dummyRegencies)
+				},
+				func(ctx context.Context, id string, query repository.Query) error {
+					return nil
+				},
+			).Once()
+
+			t.Run("it should return valid regencies, when provinceID is valid", func(t *testing.T) {
+				var service ProvinceService = NewProvinceServiceImpl(mockProvinceRepo, mockRegencyRepo)
+
+				got, total, err := service.GetRegenciesByProvinceID(context.Background(), "35", model.ListQuery{})
+				assert.NoError(t, err)
+				assert.Equal(t, []model.Regency{
+					{
+						ID:          "3502",
+						Name:        "KABUPATEN PONOROGO",
+						DisplayName: "Kabupaten Ponorogo",
+						Province:    model.Province{ID: "35", Name: "JAWA TIMUR", DisplayName: "Jawa Timur"},
+					},
+				}, got)
+				assert.Equal(t, len(dummyRegencies), total)
+			})
+		})
+
+		t.Run("failed scenario", func(t *testing.T) {
+			mockRegencyRepo.On("FindByProvinceID", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string"), mock.AnythingOfType("repository.Query")).Return(
+				func(ctx context.Context, id string, query repository.Query) []entity.Regency {
+					return nil
+				},
+				func(ctx context.Context, id string, query repository.Query) int {
+					return 0
+				},
+				func(ctx context.Context, id string, query repository.Query) error {
+					if id != "35" {
+						return repository.ErrQueryNotFound
+					} else {
+						return repository.ErrDatabase
+					}
+				},
+			).Twice()
+
+			testCases := []struct {
+				name     string
+				id       string
+				expected error
+			}{
+				{
+					name:     "it should return ErrRepository instance, when provinceID is match and error happened",
+					id:       "35",
+					expected: ErrRepository,
+				},
+				{
+					name:     "it should return ErrDataNotFound instance, when province doesn't exist",
+					id:       "90",
+					expected: ErrDataNotFound,
+				},
+			}
+
+			var service ProvinceService = NewProvinceServiceImpl(mockProvinceRepo, mockRegencyRepo)
+
+			for _, testCase := range testCases {
+				t.Run(testCase.name, func(t *testing.T) {
+					_, _, err := service.GetRegenciesByProvinceID(context.Background(), testCase.id, model.ListQuery{})
+					assert.ErrorIs(t, err, testCase.expected)
+				})
+			}
+		})
+	})
+
+	t.Run("TestGetTree", func(t *testing.T) {
+		mockProvinceRepo := &mocks.ProvinceRepository{}
+		mockRegencyRepo := &mocks.RegencyRepository{}
+
+		province := entity.Province{ID: "35", Name: "JAWA TIMUR"}
+		regency := entity.Regency{ID: "3502", Name: "KABUPATEN PONOROGO", Province: province}
+		ngrayun := entity.District{ID: "3502010", Name: "NGRAYUN", Regency: regency}
+		slahung := entity.District{ID: "3502020", Name: "SLAHUNG", Regency: regency}
+
+		dummyRows := []entity.Village{
+			{ID: "3502010001", Name: "BAOSANKIDUL", District: ngrayun},
+			{ID: "3502010002", Name: "WONODADI", District: ngrayun},
+			{ID: "3502020001", Name: "BROTO", District: slahung},
+		}
+
+		t.Run("success scenario", func(t *testing.T) {
+			mockProvinceRepo.On("FindTree", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), province.ID, 3).Return(
+				func(ctx context.Context, id string, depth int) []entity.Village {
+					return dummyRows
+				},
+				func(ctx context.Context, id string, depth int) error {
+					return nil
+				},
+			).Once()
+
+			t.Run("it should assemble the rows into a nested tree, when there is no error", func(t *testing.T) {
+				var service ProvinceService = NewProvinceServiceImpl(mockProvinceRepo, mockRegencyRepo)
+
+				got, err := service.GetTree(context.Background(), province.ID, 3)
+				assert.NoError(t, err)
+				assert.Equal(t, model.ProvinceTree{
+					ID:          "35",
+					Name:        "JAWA TIMUR",
+					DisplayName: "Jawa Timur",
+					Regencies: []model.RegencyTree{
+						{
+							ID:          "3502",
+							Name:        "KABUPATEN PONOROGO",
+							DisplayName: "Kabupaten Ponorogo",
+							Districts: []model.DistrictTree{
+								{
+									ID:          "3502010",
+									Name:        "NGRAYUN",
+									DisplayName: "Ngrayun",
+									Villages: []model.VillageTree{
+										{ID: "3502010001", Name: "BAOSANKIDUL", DisplayName: "Baosankidul"},
+										{ID: "3502010002", Name: "WONODADI", DisplayName: "Wonodadi"},
+									},
+								},
+								{
+									ID:          "3502020",
+									Name:        "SLAHUNG",
+									DisplayName: "Slahung",
+									Villages: []model.VillageTree{
+										{ID: "3502020001", Name: "BROTO", DisplayName: "Broto"},
+									},
+								},
+							},
+						},
+					},
+				}, got)
+			})
+		})
+
+		t.Run("failed scenario", func(t *testing.T) {
+			mockProvinceRepo.On("FindTree", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string"), mock.AnythingOfType("int")).Return(
+				func(ctx context.Context, id string, depth int) []entity.Village {
+					return nil
+				},
+				func(ctx context.Context, id string, depth int) error {
+					if id != province.ID {
+						return repository.ErrQueryNotFound
+					} else {
+						return repository.ErrDatabase
+					}
+				},
+			).Twice()
+
+			testCases := []struct {
+				name     string
+				id       string
+				expected error
+			}{
+				{
+					name:     "it should return ErrRepository instance, when ID is match and error happened",
+					id:       province.ID,
+					expected: ErrRepository,
+				},
+				{
+					name:     "it should return ErrDataNotFound instance, when ID is not match and error happened",
+					id:       "90",
+					expected: ErrDataNotFound,
+				},
+			}
+
+			var service ProvinceService = NewProvinceServiceImpl(mockProvinceRepo, mockRegencyRepo)
+
+			for _, testCase := range testCases {
+				t.Run(testCase.name, func(t *testing.T) {
+					_, err := service.GetTree(context.Background(), testCase.id, 3)
+					assert.ErrorIs(t, err, testCase.expected)
+				})
+			}
+		})
+	})
 }
 
 func mapToProvinceModel(e entity.Province) model.Province {
 	return model.Province{
-		ID:   e.ID,
-		Name: e.Name,
+		ID:          e.ID,
+		Name:        e.Name,
+		DisplayName: display.Name(e.Name),
 	}
 }
 
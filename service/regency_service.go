@@ -7,6 +7,8 @@ import (
 )
 
 type RegencyService interface {
-	GetAll(ctx context.Context, keyword string) (responses []model.Regency, err error)
+	GetAll(ctx context.Context, keyword string, query model.ListQuery) (responses []model.Regency, total int, err error)
 	GetByID(ctx context.Context, id string) (response model.Regency, err error)
+	GetDistrictsByRegencyID(ctx context.Context, id string, query model.ListQuery) (responses []model.District, total int, err error)
+	GetTree(ctx context.Context, id string, depth int) (response model.RegencyTree, err error)
 }
@@ -3,27 +3,43 @@ package service
 import (
 	"context"
 
+	"github.com/erikrios/ponorogo-regency-api/display"
 	"github.com/erikrios/ponorogo-regency-api/entity"
 	"github.com/erikrios/ponorogo-regency-api/model"
 	"github.com/erikrios/ponorogo-regency-api/repository"
 )
 
 type regencyServiceImpl struct {
-	repository repository.RegencyRepository
+	regencyRepository  repository.RegencyRepository
+	districtRepository repository.DistrictRepository
 }
 
-func NewRegencyServiceImpl(repository repository.RegencyRepository) *regencyServiceImpl {
-	return &regencyServiceImpl{repository: repository}
+func NewRegencyServiceImpl(
+	regencyRepository repository.RegencyRepository,
+	districtRepository repository.DistrictRepository,
+) *regencyServiceImpl {
+	return &regencyServiceImpl{
+		regencyRepository:  regencyRepository,
+		districtRepository: districtRepository,
+	}
 }
 
-func (r *regencyServiceImpl) GetAll(ctx context.Context, keyword string) (responses []model.Regency, err error) {
+func (r *regencyServiceImpl) GetAll(ctx context.Context, keyword string, query model.ListQuery) (responses []model.Regency, total int, err error) {
+	if err = validateIDs("", "", query.Filters); err != nil {
+		return
+	}
+
+	if query.Match == model.MatchFuzzy {
+		return r.getAllFuzzy(ctx, keyword, query)
+	}
+
 	var regencies []entity.Regency
 	var repoErr error
 
 	if keyword == "" {
-		regencies, repoErr = r.repository.FindAll(ctx)
+		regencies, total, repoErr = r.regencyRepository.FindAll(ctx, mapQuery(query))
 	} else {
-		regencies, repoErr = r.repository.FindByName(ctx, keyword)
+		regencies, total, repoErr = r.regencyRepository.FindByName(ctx, keyword, mapQuery(query))
 	}
 
 	if repoErr != nil {
@@ -39,7 +55,11 @@ func (r *regencyServiceImpl) GetAll(ctx context.Context, keyword string) (respon
 	return
 }
 func (r *regencyServiceImpl) GetByID(ctx context.Context, id string) (response model.Regency, err error) {
-	regency, repoErr := r.repository.FindByID(ctx, id)
+	if err = validateIDs(LevelRegency, id, nil); err != nil {
+		return
+	}
+
+	regency, repoErr := r.regencyRepository.FindByID(ctx, id)
 	if repoErr != nil {
 		err = mapError(repoErr)
 		return
@@ -49,13 +69,96 @@ func (r *regencyServiceImpl) GetByID(ctx context.Context, id string) (response m
 	return
 }
 
-func (p *regencyServiceImpl) mapToModel(e entity.Regency) model.Regency {
+func (r *regencyServiceImpl) GetDistrictsByRegencyID(ctx context.Context, id string, query model.ListQuery) (responses []model.District, total int, err error) {
+	if err = validateIDs(LevelRegency, id, query.Filters); err != nil {
+		return
+	}
+
+	districts, total, repoErr := r.districtRepository.FindByRegencyID(ctx, id, mapQuery(query))
+	if repoErr != nil {
+		err = mapError(repoErr)
+		return
+	}
+
+	responses = r.mapToDistrictModels(districts)
+	return
+}
+
+func (r *regencyServiceImpl) GetTree(ctx context.Context, id string, depth int) (response model.RegencyTree, err error) {
+	if err = validateIDs(LevelRegency, id, nil); err != nil {
+		return
+	}
+
+	rows, repoErr := r.regencyRepository.FindTree(ctx, id, depth)
+	if repoErr != nil {
+		err = mapError(repoErr)
+		return
+	}
+
+	regency := rows[0].District.Regency
+	response = model.RegencyTree{
+		ID:          regency.ID,
+		Name:        regency.Name,
+		DisplayName: display.Name(regency.Name),
+		Province: &model.Province{
+			ID:          regency.Province.ID,
+			Name:        regency.Province.Name,
+			DisplayName: display.Name(regency.Province.Name),
+		},
+	}
+
+	for _, row := range rows {
+		response.Districts = appendDistrictRow(response.Districts, row)
+	}
+	return
+}
+
+func (r *regencyServiceImpl) getAllFuzzy(ctx context.Context, keyword string, query model.ListQuery) (responses []model.Regency, total int, err error) {
+	repoQuery, err := fuzzyQuery(keyword, query)
+	if err != nil {
+		return
+	}
+
+	regencies, _, repoErr := r.regencyRepository.FindAll(ctx, repoQuery)
+	if repoErr != nil {
+		err = mapError(repoErr)
+		return
+	}
+
+	regencies, scores, total := rankFuzzy(keyword, regencies, func(regency entity.Regency) string { return regency.Name }, query)
+
+	responses = make([]model.Regency, len(regencies))
+	for i, regency := range regencies {
+		responses[i] = r.mapToModel(regency)
+		responses[i].Score = scores[i]
+	}
+	return
+}
+
+func (r *regencyServiceImpl) mapToModel(e entity.Regency) model.Regency {
 	return model.Regency{
-		ID:   e.ID,
-		Name: e.Name,
+		ID:          e.ID,
+		Name:        e.Name,
+		DisplayName: display.Name(e.Name),
 		Province: model.Province{
-			ID:   e.Province.ID,
-			Name: e.Province.Name,
+			ID:          e.Province.ID,
+			Name:        e.Province.Name,
+			DisplayName: display.Name(e.Province.Name),
 		},
 	}
 }
+
+func (r *regencyServiceImpl) mapToDistrictModels(entities []entity.District) []model.District {
+	districts := make([]model.District, len(entities))
+
+	for i, e := range entities {
+		districts[i] = model.District{
+			ID:          e.ID,
+			Name:        e.Name,
+			DisplayName: display.Name(e.Name),
+			Regency:     r.mapToModel(e.Regency),
+		}
+	}
+
+	return districts
+}
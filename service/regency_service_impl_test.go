@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/erikrios/ponorogo-regency-api/display"
 	"github.com/erikrios/ponorogo-regency-api/entity"
 	"github.com/erikrios/ponorogo-regency-api/model"
 	"github.com/erikrios/ponorogo-regency-api/repository"
@@ -16,16 +17,18 @@ import (
 func TestRegencyServiceImpl(t *testing.T) {
 
 	t.Run("TestNewRegencyServiceImpl", func(t *testing.T) {
-		mockRepo := &mocks.RegencyRepository{}
+		mockRegencyRepo := &mocks.RegencyRepository{}
+		mockDistrictRepo := &mocks.DistrictRepository{}
 
 		t.Run("it should return valid regency service instance, when invoke the function", func(t *testing.T) {
-			var service RegencyService = NewRegencyServiceImpl(mockRepo)
+			var service RegencyService = NewRegencyServiceImpl(mockRegencyRepo, mockDistrictRepo)
 			assert.NotNil(t, service)
 		})
 	})
 
 	t.Run("TestGetAll", func(t *testing.T) {
-		mockRepo := &mocks.RegencyRepository{}
+		mockRegencyRepo := &mocks.RegencyRepository{}
+		mockDistrictRepo := &mocks.DistrictRepository{}
 
 		dummyRegencies := []entity.Regency{
 			{
@@ -39,19 +42,25 @@ func TestRegencyServiceImpl(t *testing.T) {
 		}
 
 		t.Run("success scenario", func(t *testing.T) {
-			mockRepo.On("FindAll", mock.AnythingOfType(fmt.Sprintf("%T", context.Background()))).Return(
-				func(ctx context.Context) []entity.Regency {
+			mockRegencyRepo.On("FindAll", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("repository.Query")).Return(
+				func(ctx context.Context, query repository.Query) []entity.Regency {
 					return dummyRegencies
 				},
-				func(ctx context.Context) error {
+				func(ctx context.Context, query repository.Query) int {
+					return len(dummyRegencies)
+				},
+				func(ctx context.Context, query repository.Query) error {
 					return nil
 				},
 			).Once()
-			mockRepo.On("FindByName", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string")).Return(
-				func(ctx context.Context, keyword string) []entity.Regency {
+			mockRegencyRepo.On("FindByName", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string"), mock.AnythingOfType("repository.Query")).Return(
+				func(ctx context.Context, keyword string, query repository.Query) []entity.Regency {
 					return dummyRegencies
 				},
-				func(ctx context.Context, keyword string) error {
+				func(ctx context.Context, keyword string, query repository.Query) int {
+					return len(dummyRegencies)
+				},
+				func(ctx context.Context, keyword string, query repository.Query) error {
 					return nil
 				},
 			).Once()
@@ -73,31 +82,38 @@ func TestRegencyServiceImpl(t *testing.T) {
 				},
 			}
 
-			var service RegencyService = NewRegencyServiceImpl(mockRepo)
+			var service RegencyService = NewRegencyServiceImpl(mockRegencyRepo, mockDistrictRepo)
 
 			for _, testCase := range testCases {
 				t.Run(testCase.name, func(t *testing.T) {
-					got, err := service.GetAll(context.Background(), testCase.keyword)
+					got, total, err := service.GetAll(context.Background(), testCase.keyword, model.ListQuery{})
 					assert.NoError(t, err)
 					assert.ElementsMatch(t, testCase.expected, got)
+					assert.Equal(t, len(testCase.expected), total)
 				})
 			}
 		})
 
 		t.Run("failed scenario", func(t *testing.T) {
-			mockRepo.On("FindAll", mock.AnythingOfType(fmt.Sprintf("%T", context.Background()))).Return(
-				func(ctx context.Context) []entity.Regency {
+			mockRegencyRepo.On("FindAll", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("repository.Query")).Return(
+				func(ctx context.Context, query repository.Query) []entity.Regency {
 					return []entity.Regency{}
 				},
-				func(ctx context.Context) error {
+				func(ctx context.Context, query repository.Query) int {
+					return 0
+				},
+				func(ctx context.Context, query repository.Query) error {
 					return repository.ErrDatabase
 				},
 			).Once()
-			mockRepo.On("FindByName", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string")).Return(
-				func(ctx context.Context, keyword string) []entity.Regency {
+			mockRegencyRepo.On("FindByName", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string"), mock.AnythingOfType("repository.Query")).Return(
+				func(ctx context.Context, keyword string, query repository.Query) []entity.Regency {
 					return []entity.Regency{}
 				},
-				func(ctx context.Context, keyword string) error {
+				func(ctx context.Context, keyword string, query repository.Query) int {
+					return 0
+				},
+				func(ctx context.Context, keyword string, query repository.Query) error {
 					return repository.ErrDatabase
 				},
 			).Once()
@@ -119,11 +135,11 @@ func TestRegencyServiceImpl(t *testing.T) {
 				},
 			}
 
-			var service RegencyService = NewRegencyServiceImpl(mockRepo)
+			var service RegencyService = NewRegencyServiceImpl(mockRegencyRepo, mockDistrictRepo)
 
 			for _, testCase := range testCases {
 				t.Run(testCase.name, func(t *testing.T) {
-					_, err := service.GetAll(context.Background(), testCase.keyword)
+					_, _, err := service.GetAll(context.Background(), testCase.keyword, model.ListQuery{})
 					assert.ErrorIs(t, err, testCase.expected)
 				})
 			}
@@ -131,7 +147,8 @@ func TestRegencyServiceImpl(t *testing.T) {
 	})
 
 	t.Run("TestGetByID", func(t *testing.T) {
-		mockRepo := &mocks.RegencyRepository{}
+		mockRegencyRepo := &mocks.RegencyRepository{}
+		mockDistrictRepo := &mocks.DistrictRepository{}
 
 		dummyRegency := entity.Regency{
 			ID:   "3302",
@@ -143,7 +160,7 @@ func TestRegencyServiceImpl(t *testing.T) {
 		}
 
 		t.Run("success scenario", func(t *testing.T) {
-			mockRepo.On("FindByID", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string")).Return(
+			mockRegencyRepo.On("FindByID", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string")).Return(
 				func(ctx context.Context, id string) entity.Regency {
 					return dummyRegency
 				},
@@ -164,7 +181,7 @@ func TestRegencyServiceImpl(t *testing.T) {
 				},
 			}
 
-			var service RegencyService = NewRegencyServiceImpl(mockRepo)
+			var service RegencyService = NewRegencyServiceImpl(mockRegencyRepo, mockDistrictRepo)
 
 			for _, testCase := range testCases {
 				t.Run(testCase.name, func(t *testing.T) {
@@ -177,7 +194,7 @@ func TestRegencyServiceImpl(t *testing.T) {
 		})
 
 		t.Run("failed scenario", func(t *testing.T) {
-			mockRepo.On("FindByID", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string")).Return(
+			mockRegencyRepo.On("FindByID", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string")).Return(
 				func(ctx context.Context, id string) entity.Regency {
 					return entity.Regency{}
 				},
@@ -207,7 +224,7 @@ func TestRegencyServiceImpl(t *testing.T) {
 				},
 			}
 
-			var service RegencyService = NewRegencyServiceImpl(mockRepo)
+			var service RegencyService = NewRegencyServiceImpl(mockRegencyRepo, mockDistrictRepo)
 
 			for _, testCase := range testCases {
 				t.Run(testCase.name, func(t *testing.T) {
@@ -217,15 +234,172 @@ func TestRegencyServiceImpl(t *testing.T) {
 			}
 		})
 	})
+
+	t.Run("TestGetDistrictsByRegencyID", func(t *testing.T) {
+		mockRegencyRepo := &mocks.RegencyRepository{}
+		mockDistrictRepo := &mocks.DistrictRepository{}
+
+		dummyDistricts := []entity.District{
+			{
+				ID:   "3502010",
+				Name: "NGRAYUN",
+				Regency: entity.Regency{
+					ID:   "3502",
+					Name: "KABUPATEN PONOROGO",
+					Province: entity.Province{
+						ID:   "35",
+						Name: "JAWA TIMUR",
+					},
+				},
+			},
+		}
+
+		t.Run("success scenario", func(t *testing.T) {
+			mockDistrictRepo.On("FindByRegencyID", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), "3502", mock.AnythingOfType("repository.Query")).Return(
+				func(ctx context.Context, id string, query repository.Query) []entity.District {
+					return dummyDistricts
+				},
+				func(ctx context.Context, id string, query repository.Query) int {
+					return len(dummyDistricts)
+				},
+				func(ctx context.Context, id string, query repository.Query) error {
+					return nil
+				},
+			).Once()
+
+			t.Run("it should return valid districts, when regencyID is valid", func(t *testing.T) {
+				var service RegencyService = NewRegencyServiceImpl(mockRegencyRepo, mockDistrictRepo)
+
+				got, total, err := service.GetDistrictsByRegencyID(context.Background(), "3502", model.ListQuery{})
+				assert.NoError(t, err)
+				assert.Equal(t, []model.District{
+					{
+						ID:          "3502010",
+						Name:        "NGRAYUN",
+						DisplayName: "Ngrayun",
+						Regency: model.Regency{
+							ID:          "3502",
+							Name:        "KABUPATEN PONOROGO",
+							DisplayName: "Kabupaten Ponorogo",
+							Province:    model.Province{ID: "35", Name: "JAWA TIMUR", DisplayName: "Jawa Timur"},
+						},
+					},
+				}, got)
+				assert.Equal(t, len(dummyDistricts), total)
+			})
+		})
+
+		t.Run("failed scenario", func(t *testing.T) {
+			mockDistrictRepo.On("FindByRegencyID", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string"), mock.AnythingOfType("repository.Query")).Return(
+				func(ctx context.Context, id string, query repository.Query) []entity.District {
+					return nil
+				},
+				func(ctx context.Context, id string, query repository.Query) int {
+					return 0
+				},
+				func(ctx context.Context, id string, query repository.Query) error {
+					if id != "3502" {
+						return repository.ErrQueryNotFound
+					} else {
+						return repository.ErrDatabase
+					}
+				},
+			).Twice()
+
+			testCases := []struct {
+				name     string
+				id       string
+				expected error
+			}{
+				{
+					name:     "it should return ErrRepository instance, when regencyID is match and error happened",
+					id:       "3502",
+					expected: ErrRepository,
+				},
+				{
+					name:     "it should return ErrDataNotFound instance, when regency doesn't exist",
+					id:       "9999",
+					expected: ErrDataNotFound,
+				},
+			}
+
+			var service RegencyService = NewRegencyServiceImpl(mockRegencyRepo, mockDistrictRepo)
+
+			for _, testCase := range testCases {
+				t.Run(testCase.name, func(t *testing.T) {
+					_, _, err := service.GetDistrictsByRegencyID(context.Background(), testCase.id, model.ListQuery{})
+					assert.ErrorIs(t, err, testCase.expected)
+				})
+			}
+		})
+	})
+
+	t.Run("TestGetTree", func(t *testing.T) {
+		mockRegencyRepo := &mocks.RegencyRepository{}
+		mockDistrictRepo := &mocks.DistrictRepository{}
+
+		regency := entity.Regency{ID: "3502", Name: "KABUPATEN PONOROGO", Province: entity.Province{ID: "35", Name: "JAWA TIMUR"}}
+
+		t.Run("success scenario", func(t *testing.T) {
+			mockRegencyRepo.On("FindTree", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), regency.ID, 1).Return(
+				func(ctx context.Context, id string, depth int) []entity.Village {
+					return []entity.Village{
+						{District: entity.District{ID: "3502010", Name: "NGRAYUN", Regency: regency}},
+						{District: entity.District{ID: "3502020", Name: "SLAHUNG", Regency: regency}},
+					}
+				},
+				func(ctx context.Context, id string, depth int) error {
+					return nil
+				},
+			).Once()
+
+			t.Run("it should assemble the districts without villages, when given depth 1", func(t *testing.T) {
+				var service RegencyService = NewRegencyServiceImpl(mockRegencyRepo, mockDistrictRepo)
+
+				got, err := service.GetTree(context.Background(), regency.ID, 1)
+				assert.NoError(t, err)
+				assert.Equal(t, model.RegencyTree{
+					ID:          "3502",
+					Name:        "KABUPATEN PONOROGO",
+					DisplayName: "Kabupaten Ponorogo",
+					Province:    &model.Province{ID: "35", Name: "JAWA TIMUR", DisplayName: "Jawa Timur"},
+					Districts: []model.DistrictTree{
+						{ID: "3502010", Name: "NGRAYUN", DisplayName: "Ngrayun"},
+						{ID: "3502020", Name: "SLAHUNG", DisplayName: "Slahung"},
+					},
+				}, got)
+			})
+		})
+
+		t.Run("failed scenario", func(t *testing.T) {
+			mockRegencyRepo.On("FindTree", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string"), mock.AnythingOfType("int")).Return(
+				func(ctx context.Context, id string, depth int) []entity.Village {
+					return nil
+				},
+				func(ctx context.Context, id string, depth int) error {
+					return repository.ErrQueryNotFound
+				},
+			).Once()
+
+			t.Run("it should return ErrDataNotFound instance, when ID is not found", func(t *testing.T) {
+				var service RegencyService = NewRegencyServiceImpl(mockRegencyRepo, mockDistrictRepo)
+
+				_, err := service.GetTree(context.Background(), "3503", 2)
+				assert.ErrorIs(t, err, ErrDataNotFound)
+			})
+		})
+	})
 }
 
 func mapToRegencyModel(e entity.Regency) model.Regency {
 	return model.Regency{
-		ID:   e.ID,
-		Name: e.Name,
+		ID:          e.ID,
+		Name:        e.Name,
+		DisplayName: display.Name(e.Name),
 		Province: model.Province{
-			ID:   e.Province.ID,
-			Name: e.Province.Name,
+			ID:          e.Province.ID,
+			Name:        e.Province.Name,
+			DisplayName: display.Name(e.Province.Name),
 		},
 	}
 }
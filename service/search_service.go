@@ -0,0 +1,11 @@
+package service
+
+import (
+	"context"
+
+	"github.com/erikrios/ponorogo-regency-api/model"
+)
+
+type SearchService interface {
+	Search(ctx context.Context, q string, levels []string, limit int) (hits []model.SearchHit, total int, err error)
+}
@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/erikrios/ponorogo-regency-api/display"
+	"github.com/erikrios/ponorogo-regency-api/model"
+	"github.com/erikrios/ponorogo-regency-api/normalize"
+	"github.com/erikrios/ponorogo-regency-api/repository"
+)
+
+type searchServiceImpl struct {
+	provinceRepository repository.ProvinceRepository
+	regencyRepository  repository.RegencyRepository
+	districtRepository repository.DistrictRepository
+	villageRepository  repository.VillageRepository
+}
+
+func NewSearchServiceImpl(
+	provinceRepository repository.ProvinceRepository,
+	regencyRepository repository.RegencyRepository,
+	districtRepository repository.DistrictRepository,
+	villageRepository repository.VillageRepository,
+) *searchServiceImpl {
+	return &searchServiceImpl{
+		provinceRepository: provinceRepository,
+		regencyRepository:  regencyRepository,
+		districtRepository: districtRepository,
+		villageRepository:  villageRepository,
+	}
+}
+
+func (s *searchServiceImpl) Search(ctx context.Context, q string, only []string, limit int) (hits []model.SearchHit, total int, err error) {
+	if normalize.Name(q) == "" {
+		err = &QueryError{Reason: "search needs a keyword"}
+		return
+	}
+
+	searched, err := searchedLevels(only)
+	if err != nil {
+		return
+	}
+
+	// Widest level first, so that a district ranks above its main village of the same name.
+	hits = make([]model.SearchHit, 0)
+	for _, level := range levels {
+		if !searched[level] {
+			continue
+		}
+
+		levelHits, repoErr := s.find(ctx, level, q)
+		if repoErr != nil {
+			err = mapError(repoErr)
+			return
+		}
+		hits = append(hits, levelHits...)
+	}
+
+	keywordTrigrams := trigrams(q)
+	for i := range hits {
+		hits[i].Score = math.Round(similarity(keywordTrigrams, trigrams(hits[i].Name))*1000) / 1000
+	}
+
+	sort.SliceStable(hits, func(i, j int) bool { return hits[i].Score > hits[j].Score })
+
+	total = len(hits)
+	if limit > 0 && len(hits) > limit {
+		hits = hits[:limit]
+	}
+	return
+}
+
+func searchedLevels(only []string) (searched map[string]bool, err error) {
+	searched = make(map[string]bool)
+
+	for _, level := range only {
+		if _, ok := idLengths[level]; !ok {
+			err = &QueryError{Reason: fmt.Sprintf("unknown level %q", level)}
+			return
+		}
+		searched[level] = true
+	}
+
+	if len(searched) == 0 {
+		for _, level := range levels {
+			searched[level] = true
+		}
+	}
+	return
+}
+
+func (s *searchServiceImpl) find(ctx context.Context, level string, q string) (hits []model.SearchHit, err error) {
+	switch level {
+	case LevelProvince:
+		provinces, _, repoErr := s.provinceRepository.FindByName(ctx, q, repository.Query{})
+		for _, province := range provinces {
+			hits = append(hits, newSearchHit(level, province.ID, province.Name))
+		}
+		err = repoErr
+	case LevelRegency:
+		regencies, _, repoErr := s.regencyRepository.FindByName(ctx, q, repository.Query{})
+		for _, regency := range regencies {
+			hits = append(hits, newSearchHit(level, regency.ID, regency.Name, regency.Province.Name))
+		}
+		err = repoErr
+	case LevelDistrict:
+		districts, _, repoErr := s.districtRepository.FindByName(ctx, q, repository.Query{})
+		for _, district := range districts {
+			hits = append(hits, newSearchHit(level, district.ID, district.Name, district.Regency.Name, district.Regency.Province.Name))
+		}
+		err = repoErr
+	case LevelVillage:
+		villages, _, repoErr := s.villageRepository.FindByName(ctx, q, repository.Query{})
+		for _, village := range villages {
+			hits = append(hits, newSearchHit(
+				level,
+				village.ID,
+				village.Name,
+				village.District.Name,
+				village.District.Regency.Name,
+				village.District.Regency.Province.Name,
+			))
+		}
+		err = repoErr
+	}
+	return
+}
+
+func newSearchHit(level string, id string, name string, ancestors ...string) model.SearchHit {
+	return model.SearchHit{
+		Level:       level,
+		ID:          id,
+		Name:        name,
+		DisplayName: display.Name(name),
+		Path:        pathOf(name, ancestors...),
+	}
+}
+
+func pathOf(name string, ancestors ...string) string {
+	return strings.Join(append([]string{name}, ancestors...), ", ")
+}
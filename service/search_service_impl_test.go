@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/erikrios/ponorogo-regency-api/entity"
+	"github.com/erikrios/ponorogo-regency-api/model"
+	"github.com/erikrios/ponorogo-regency-api/repository"
+	"github.com/erikrios/ponorogo-regency-api/repository/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSearchServiceImpl(t *testing.T) {
+	province := entity.Province{ID: "35", Name: "JAWA TIMUR"}
+	regency := entity.Regency{ID: "3502", Name: "KABUPATEN PONOROGO", Province: province}
+	district := entity.District{ID: "3502210", Name: "BABADAN", Regency: regency}
+	villages := []entity.Village{
+		{ID: "3502210001", Name: "BABADAN", District: district},
+		{ID: "3502040006", Name: "BABADAN LOR", District: entity.District{ID: "3502040", Name: "BUNGKAL", Regency: regency}},
+	}
+
+	t.Run("TestNewSearchServiceImpl", func(t *testing.T) {
+		t.Run("it should return valid search service instance, when invoke the function", func(t *testing.T) {
+			var service SearchService = NewSearchServiceImpl(
+				&mocks.ProvinceRepository{},
+				&mocks.RegencyRepository{},
+				&mocks.DistrictRepository{},
+				&mocks.VillageRepository{},
+			)
+			assert.NotNil(t, service)
+		})
+	})
+
+	t.Run("TestSearch", func(t *testing.T) {
+		t.Run("it should rank the hits of every level by relevance, when given no level", func(t *testing.T) {
+			mockProvinceRepo := &mocks.ProvinceRepository{}
+			mockProvinceRepo.On("FindByName", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), "babadan", repository.Query{}).Return([]entity.Province{}, 0, nil).Once()
+			mockRegencyRepo := &mocks.RegencyRepository{}
+			mockRegencyRepo.On("FindByName", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), "babadan", repository.Query{}).Return([]entity.Regency{}, 0, nil).Once()
+			mockDistrictRepo := &mocks.DistrictRepository{}
+			mockDistrictRepo.On("FindByName", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), "babadan", repository.Query{}).Return([]entity.District{district}, 1, nil).Once()
+			mockVillageRepo := &mocks.VillageRepository{}
+			mockVillageRepo.On("FindByName", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), "babadan", repository.Query{}).Return(villages, len(villages), nil).Once()
+
+			var service SearchService = NewSearchServiceImpl(mockProvinceRepo, mockRegencyRepo, mockDistrictRepo, mockVillageRepo)
+
+			got, total, err := service.Search(context.Background(), "babadan", nil, 2)
+			assert.NoError(t, err)
+			assert.Equal(t, 3, total)
+			assert.Equal(t, []model.SearchHit{
+				{Level: LevelDistrict, ID: "3502210", Name: "BABADAN", DisplayName: "Babadan", Path: "BABADAN, KABUPATEN PONOROGO, JAWA TIMUR", Score: 1},
+				{Level: LevelVillage, ID: "3502210001", Name: "BABADAN", DisplayName: "Babadan", Path: "BABADAN, BABADAN, KABUPATEN PONOROGO, JAWA TIMUR", Score: 1},
+			}, got)
+			mockProvinceRepo.AssertExpectations(t)
+			mockRegencyRepo.AssertExpectations(t)
+			mockDistrictRepo.AssertExpectations(t)
+			mockVillageRepo.AssertExpectations(t)
+		})
+
+		t.Run("it should only search the given levels, when given levels", func(t *testing.T) {
+			mockVillageRepo := &mocks.VillageRepository{}
+			mockVillageRepo.On("FindByName", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), "babadan", repository.Query{}).Return(villages, len(villages), nil).Once()
+
+			var service SearchService = NewSearchServiceImpl(
+				&mocks.ProvinceRepository{},
+				&mocks.RegencyRepository{},
+				&mocks.DistrictRepository{},
+				mockVillageRepo,
+			)
+
+			got, total, err := service.Search(context.Background(), "babadan", []string{LevelVillage}, 50)
+			assert.NoError(t, err)
+			assert.Equal(t, 2, total)
+			if assert.Len(t, got, 2) {
+				assert.Equal(t, "3502210001", got[0].ID)
+				assert.Equal(t, "3502040006", got[1].ID)
+				assert.Equal(t, "BABADAN LOR, BUNGKAL, KABUPATEN PONOROGO, JAWA TIMUR", got[1].Path)
+				assert.Less(t, got[1].Score, 1.0)
+			}
+			mockVillageRepo.AssertExpectations(t)
+		})
+
+		t.Run("it should return ErrRepository, when the repository fails", func(t *testing.T) {
+			mockDistrictRepo := &mocks.DistrictRepository{}
+			mockDistrictRepo.On("FindByName", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), "babadan", repository.Query{}).Return([]entity.District{}, 0, repository.ErrDatabase).Once()
+
+			var service SearchService = NewSearchServiceImpl(
+				&mocks.ProvinceRepository{},
+				&mocks.RegencyRepository{},
+				mockDistrictRepo,
+				&mocks.VillageRepository{},
+			)
+
+			_, _, err := service.Search(context.Background(), "babadan", []string{LevelDistrict}, 50)
+			assert.ErrorIs(t, err, ErrRepository)
+		})
+
+		testCases := []struct {
+			name   string
+			q      string
+			levels []string
+			reason string
+		}{
+			{name: "it should return QueryError, when given empty keyword", q: "", reason: "search needs a keyword"},
+			{name: "it should return QueryError, when given a keyword made of punctuation only", q: ".-", reason: "search needs a keyword"},
+			{name: "it should return QueryError, when given unknown level", q: "babadan", levels: []string{"hamlet"}, reason: `unknown level "hamlet"`},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				var service SearchService = NewSearchServiceImpl(
+					&mocks.ProvinceRepository{},
+					&mocks.RegencyRepository{},
+					&mocks.DistrictRepository{},
+					&mocks.VillageRepository{},
+				)
+
+				_, _, err := service.Search(context.Background(), testCase.q, testCase.levels, 50)
+
+				var queryErr *QueryError
+				if assert.ErrorAs(t, err, &queryErr) {
+					assert.Equal(t, testCase.reason, queryErr.Reason)
+				}
+			})
+		}
+	})
+}
@@ -2,19 +2,56 @@ package service
 
 import (
 	"errors"
+	"fmt"
 
+	"github.com/erikrios/ponorogo-regency-api/model"
 	"github.com/erikrios/ponorogo-regency-api/repository"
 )
 
 var (
 	ErrDataNotFound = errors.New("service: data with given params not found")
 	ErrRepository   = errors.New("service: repository error happened")
+	ErrInvalidQuery = errors.New("service: invalid list query")
+	ErrInvalidID    = errors.New("service: invalid ID")
+	ErrNotLocated   = errors.New("service: no village or district contains the given point")
 )
 
+type QueryError struct {
+	Reason string
+}
+
+func (e *QueryError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrInvalidQuery, e.Reason)
+}
+
+func (e *QueryError) Unwrap() error {
+	return ErrInvalidQuery
+}
+
 func mapError(from error) error {
+	var queryErr *repository.QueryError
+
 	if errors.Is(from, repository.ErrQueryNotFound) {
 		return ErrDataNotFound
+	} else if errors.As(from, &queryErr) {
+		return &QueryError{Reason: queryErr.Reason}
 	} else {
 		return ErrRepository
 	}
 }
+
+func mapQuery(from model.ListQuery) repository.Query {
+	query := repository.Query{
+		Limit:   from.Limit,
+		After:   from.After,
+		Sort:    from.Sort,
+		Filters: from.Filters,
+		Expand:  from.Expand,
+	}
+
+	if from.After == "" && from.Page > 1 && from.Limit > 0 {
+		query.Offset = (from.Page - 1) * from.Limit
+	}
+
+	return query
+}
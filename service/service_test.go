@@ -0,0 +1,69 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/erikrios/ponorogo-regency-api/model"
+	"github.com/erikrios/ponorogo-regency-api/repository"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapQuery(t *testing.T) {
+	testCases := []struct {
+		name     string
+		given    model.ListQuery
+		expected repository.Query
+	}{
+		{
+			name:     "it should return zero query, when given zero list query",
+			given:    model.ListQuery{},
+			expected: repository.Query{},
+		},
+		{
+			name:     "it should not skip any row, when given first page",
+			given:    model.ListQuery{Page: 1, Limit: 50},
+			expected: repository.Query{Limit: 50},
+		},
+		{
+			name:     "it should skip previous pages, when given later page",
+			given:    model.ListQuery{Page: 3, Limit: 10},
+			expected: repository.Query{Limit: 10, Offset: 20},
+		},
+		{
+			name:     "it should ignore page, when given after",
+			given:    model.ListQuery{Page: 3, Limit: 10, After: "3502010001"},
+			expected: repository.Query{Limit: 10, After: "3502010001"},
+		},
+		{
+			name:     "it should pass sort, filters and expand through, when given them",
+			given:    model.ListQuery{Sort: "-name", Filters: map[string]string{"district_id": "3502010"}, Expand: []string{}},
+			expected: repository.Query{Sort: "-name", Filters: map[string]string{"district_id": "3502010"}, Expand: []string{}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, mapQuery(testCase.given))
+		})
+	}
+}
+
+func TestMapError(t *testing.T) {
+	t.Run("it should return ErrDataNotFound, when given ErrQueryNotFound", func(t *testing.T) {
+		assert.ErrorIs(t, mapError(repository.ErrQueryNotFound), ErrDataNotFound)
+	})
+
+	t.Run("it should return QueryError with the same reason, when given repository QueryError", func(t *testing.T) {
+		err := mapError(&repository.QueryError{Reason: `unknown sort field "population"`})
+
+		var queryErr *QueryError
+		if assert.ErrorAs(t, err, &queryErr) {
+			assert.Equal(t, `unknown sort field "population"`, queryErr.Reason)
+		}
+		assert.ErrorIs(t, err, ErrInvalidQuery)
+	})
+
+	t.Run("it should return ErrRepository, when given any other error", func(t *testing.T) {
+		assert.ErrorIs(t, mapError(repository.ErrDatabase), ErrRepository)
+	})
+}
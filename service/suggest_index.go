@@ -0,0 +1,90 @@
+package service
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/erikrios/ponorogo-regency-api/model"
+	"github.com/erikrios/ponorogo-regency-api/normalize"
+)
+
+type suggestIndex struct {
+	entries []suggestEntry
+}
+
+type suggestEntry struct {
+	key        string
+	word       int
+	level      int
+	suggestion model.Suggestion
+}
+
+func newSuggestIndex(suggestions []model.Suggestion) *suggestIndex {
+	index := &suggestIndex{}
+
+	for _, suggestion := range suggestions {
+		name := strings.ToLower(suggestion.Name)
+		level := levelOf(suggestion.ID)
+
+		for word, start := 0, 0; start < len(name); word++ {
+			index.entries = append(index.entries, suggestEntry{
+				key:        normalize.Name(name[start:]),
+				word:       word,
+				level:      level,
+				suggestion: suggestion,
+			})
+
+			next := strings.IndexByte(name[start:], ' ')
+			if next < 0 {
+				break
+			}
+			start += next + 1
+		}
+	}
+
+	sort.Slice(index.entries, func(i, j int) bool { return index.entries[i].key < index.entries[j].key })
+	return index
+}
+
+func (s *suggestIndex) lookup(prefix string, limit int) []model.Suggestion {
+	prefix = normalize.Name(prefix)
+
+	start := sort.Search(len(s.entries), func(i int) bool { return s.entries[i].key >= prefix })
+
+	var matches []suggestEntry
+	for _, entry := range s.entries[start:] {
+		if !strings.HasPrefix(entry.key, prefix) {
+			break
+		}
+		matches = append(matches, entry)
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		a, b := matches[i], matches[j]
+		if (a.word == 0) != (b.word == 0) {
+			return a.word == 0
+		}
+		if a.level != b.level {
+			return a.level < b.level
+		}
+		if a.suggestion.Name != b.suggestion.Name {
+			return a.suggestion.Name < b.suggestion.Name
+		}
+		return a.suggestion.ID < b.suggestion.ID
+	})
+
+	suggestions := make([]model.Suggestion, 0, limit)
+	seen := make(map[string]bool)
+	for _, match := range matches {
+		if len(suggestions) == limit {
+			break
+		}
+		if seen[match.suggestion.ID] {
+			continue
+		}
+		seen[match.suggestion.ID] = true
+		suggestions = append(suggestions, match.suggestion)
+	}
+
+	return suggestions
+}
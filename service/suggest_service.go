@@ -0,0 +1,12 @@
+package service
+
+import (
+	"context"
+
+	"github.com/erikrios/ponorogo-regency-api/model"
+)
+
+type SuggestService interface {
+	Suggest(ctx context.Context, prefix string, limit int) (suggestions []model.Suggestion, err error)
+	Refresh(ctx context.Context) (err error)
+}
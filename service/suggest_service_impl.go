@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"sync"
+
+	"github.com/erikrios/ponorogo-regency-api/display"
+	"github.com/erikrios/ponorogo-regency-api/model"
+	"github.com/erikrios/ponorogo-regency-api/normalize"
+	"github.com/erikrios/ponorogo-regency-api/repository"
+)
+
+type suggestServiceImpl struct {
+	provinceRepository repository.ProvinceRepository
+	regencyRepository  repository.RegencyRepository
+	districtRepository repository.DistrictRepository
+	villageRepository  repository.VillageRepository
+
+	mu    sync.RWMutex
+	index *suggestIndex
+}
+
+func NewSuggestServiceImpl(
+	provinceRepository repository.ProvinceRepository,
+	regencyRepository repository.RegencyRepository,
+	districtRepository repository.DistrictRepository,
+	villageRepository repository.VillageRepository,
+) *suggestServiceImpl {
+	return &suggestServiceImpl{
+		provinceRepository: provinceRepository,
+		regencyRepository:  regencyRepository,
+		districtRepository: districtRepository,
+		villageRepository:  villageRepository,
+	}
+}
+
+func (s *suggestServiceImpl) Suggest(ctx context.Context, prefix string, limit int) (suggestions []model.Suggestion, err error) {
+	if normalize.Name(prefix) == "" {
+		err = &QueryError{Reason: "suggest needs a prefix"}
+		return
+	}
+
+	s.mu.RLock()
+	index := s.index
+	s.mu.RUnlock()
+
+	if index == nil {
+		if err = s.Refresh(ctx); err != nil {
+			return
+		}
+
+		s.mu.RLock()
+		index = s.index
+		s.mu.RUnlock()
+	}
+
+	suggestions = index.lookup(prefix, limit)
+	return
+}
+
+func (s *suggestServiceImpl) Refresh(ctx context.Context) (err error) {
+	var suggestions []model.Suggestion
+
+	provinces, _, repoErr := s.provinceRepository.FindAll(ctx, repository.Query{})
+	if repoErr != nil {
+		return mapError(repoErr)
+	}
+	for _, province := range provinces {
+		suggestions = append(suggestions, newSuggestion(LevelProvince, province.ID, province.Name))
+	}
+
+	regencies, _, repoErr := s.regencyRepository.FindAll(ctx, repository.Query{})
+	if repoErr != nil {
+		return mapError(repoErr)
+	}
+	for _, regency := range regencies {
+		suggestions = append(suggestions, newSuggestion(LevelRegency, regency.ID, regency.Name, regency.Province.Name))
+	}
+
+	districts, _, repoErr := s.districtRepository.FindAll(ctx, repository.Query{})
+	if repoErr != nil {
+		return mapError(repoErr)
+	}
+	for _, district := range districts {
+		suggestions = append(suggestions, newSuggestion(LevelDistrict, district.ID, district.Name, district.Regency.Name, district.Regency.Province.Name))
+	}
+
+	villages, _, repoErr := s.villageRepository.FindAll(ctx, repository.Query{})
+	if repoErr != nil {
+		return mapError(repoErr)
+	}
+	for _, village := range villages {
+		suggestions = append(suggestions, newSuggestion(
+			LevelVillage,
+			village.ID,
+			village.Name,
+			village.District.Name,
+			village.District.Regency.Name,
+			village.District.Regency.Province.Name,
+		))
+	}
+
+	index := newSuggestIndex(suggestions)
+
+	s.mu.Lock()
+	s.index = index
+	s.mu.Unlock()
+	return
+}
+
+func newSuggestion(level string, id string, name string, ancestors ...string) model.Suggestion {
+	return model.Suggestion{
+		Level:       level,
+		ID:          id,
+		Name:        name,
+		DisplayName: display.Name(name),
+		Path:        pathOf(name, ancestors...),
+	}
+}
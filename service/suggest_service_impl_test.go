@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/erikrios/ponorogo-regency-api/entity"
+	"github.com/erikrios/ponorogo-regency-api/model"
+	"github.com/erikrios/ponorogo-regency-api/repository"
+	"github.com/erikrios/ponorogo-regency-api/repository/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSuggestServiceImpl(t *testing.T) {
+	province := entity.Province{ID: "35", Name: "JAWA TIMUR"}
+	regency := entity.Regency{ID: "3502", Name: "KABUPATEN PONOROGO", Province: province}
+	districts := []entity.District{
+		{ID: "3502010", Name: "NGRAYUN", Regency: regency},
+		{ID: "3502170", Name: "PONOROGO", Regency: regency},
+	}
+	villages := []entity.Village{
+		{ID: "3502010001", Name: "BAOSANKIDUL", District: districts[0]},
+		{ID: "3502010012", Name: "BAOSAN LOR", District: districts[0]},
+		{ID: "3502170009", Name: "PONOROGO", District: districts[1]},
+	}
+
+	newMockRepos := func(err error) (*mocks.ProvinceRepository, *mocks.RegencyRepository, *mocks.DistrictRepository, *mocks.VillageRepository) {
+		mockProvinceRepo := &mocks.ProvinceRepository{}
+		mockProvinceRepo.On("FindAll", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), repository.Query{}).Return([]entity.Province{province}, 1, nil).Once()
+		mockRegencyRepo := &mocks.RegencyRepository{}
+		mockRegencyRepo.On("FindAll", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), repository.Query{}).Return([]entity.Regency{regency}, 1, nil).Once()
+		mockDistrictRepo := &mocks.DistrictRepository{}
+		mockDistrictRepo.On("FindAll", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), repository.Query{}).Return(districts, len(districts), nil).Once()
+		mockVillageRepo := &mocks.VillageRepository{}
+		mockVillageRepo.On("FindAll", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), repository.Query{}).Return(villages, len(villages), err).Once()
+		return mockProvinceRepo, mockRegencyRepo, mockDistrictRepo, mockVillageRepo
+	}
+
+	t.Run("TestNewSuggestServiceImpl", func(t *testing.T) {
+		t.Run("it should return valid suggest service instance, when invoke the function", func(t *testing.T) {
+			var service SuggestService = NewSuggestServiceImpl(
+				&mocks.ProvinceRepository{},
+				&mocks.RegencyRepository{},
+				&mocks.DistrictRepository{},
+				&mocks.VillageRepository{},
+			)
+			assert.NotNil(t, service)
+		})
+	})
+
+	t.Run("TestSuggest", func(t *testing.T) {
+		mockProvinceRepo, mockRegencyRepo, mockDistrictRepo, mockVillageRepo := newMockRepos(nil)
+
+		var service SuggestService = NewSuggestServiceImpl(mockProvinceRepo, mockRegencyRepo, mockDistrictRepo, mockVillageRepo)
+
+		t.Run("it should build the index from the repositories once, when invoked the first time", func(t *testing.T) {
+			got, err := service.Suggest(context.Background(), "Baosan", 10)
+			assert.NoError(t, err)
+			assert.Equal(t, []model.Suggestion{
+				{Level: LevelVillage, ID: "3502010012", Name: "BAOSAN LOR", DisplayName: "Baosan Lor", Path: "BAOSAN LOR, NGRAYUN, KABUPATEN PONOROGO, JAWA TIMUR"},
+				{Level: LevelVillage, ID: "3502010001", Name: "BAOSANKIDUL", DisplayName: "Baosankidul", Path: "BAOSANKIDUL, NGRAYUN, KABUPATEN PONOROGO, JAWA TIMUR"},
+			}, got)
+		})
+
+		t.Run("it should rank whole-name matches and wider levels first, when several words match", func(t *testing.T) {
+			got, err := service.Suggest(context.Background(), "pono", 10)
+			assert.NoError(t, err)
+			if assert.Len(t, got, 3) {
+				assert.Equal(t, "3502170", got[0].ID)
+				assert.Equal(t, "3502170009", got[1].ID)
+				assert.Equal(t, "3502", got[2].ID)
+			}
+		})
+
+		t.Run("it should match the following words of a name, when given their prefix", func(t *testing.T) {
+			got, err := service.Suggest(context.Background(), "lor", 10)
+			assert.NoError(t, err)
+			if assert.Len(t, got, 1) {
+				assert.Equal(t, "3502010012", got[0].ID)
+			}
+		})
+
+		t.Run("it should ignore spaces and legacy spellings, when given prefix written differently", func(t *testing.T) {
+			got, err := service.Suggest(context.Background(), "Baosan Kid", 10)
+			assert.NoError(t, err)
+			if assert.Len(t, got, 1) {
+				assert.Equal(t, "3502010001", got[0].ID)
+			}
+
+			got, err = service.Suggest(context.Background(), "Baosan Kidoel", 10)
+			assert.NoError(t, err)
+			if assert.Len(t, got, 1) {
+				assert.Equal(t, "3502010001", got[0].ID)
+			}
+		})
+
+		t.Run("it should return at most limit suggestions, when given limit", func(t *testing.T) {
+			got, err := service.Suggest(context.Background(), "b", 1)
+			assert.NoError(t, err)
+			assert.Len(t, got, 1)
+		})
+
+		t.Run("it should return empty suggestions, when nothing matches", func(t *testing.T) {
+			got, err := service.Suggest(context.Background(), "surabaya", 10)
+			assert.NoError(t, err)
+			assert.Empty(t, got)
+		})
+
+		t.Run("it should return QueryError, when given blank prefix", func(t *testing.T) {
+			_, err := service.Suggest(context.Background(), " ", 10)
+			assert.ErrorIs(t, err, ErrInvalidQuery)
+		})
+
+		mockVillageRepo.AssertExpectations(t)
+	})
+
+	t.Run("TestRefresh", func(t *testing.T) {
+		t.Run("it should return ErrRepository, when a repository fails", func(t *testing.T) {
+			mockProvinceRepo, mockRegencyRepo, mockDistrictRepo, mockVillageRepo := newMockRepos(repository.ErrDatabase)
+
+			var service SuggestService = NewSuggestServiceImpl(mockProvinceRepo, mockRegencyRepo, mockDistrictRepo, mockVillageRepo)
+
+			err := service.Refresh(context.Background())
+			assert.ErrorIs(t, err, ErrRepository)
+		})
+	})
+}
@@ -0,0 +1,40 @@
+package service
+
+import (
+	"github.com/erikrios/ponorogo-regency-api/display"
+	"github.com/erikrios/ponorogo-regency-api/entity"
+	"github.com/erikrios/ponorogo-regency-api/model"
+)
+
+func appendRegencyRow(regencies []model.RegencyTree, row entity.Village) []model.RegencyTree {
+	regency := row.District.Regency
+	if regency.ID == "" {
+		return regencies
+	}
+
+	if len(regencies) == 0 || regencies[len(regencies)-1].ID != regency.ID {
+		regencies = append(regencies, model.RegencyTree{ID: regency.ID, Name: regency.Name, DisplayName: display.Name(regency.Name)})
+	}
+
+	last := &regencies[len(regencies)-1]
+	last.Districts = appendDistrictRow(last.Districts, row)
+	return regencies
+}
+
+func appendDistrictRow(districts []model.DistrictTree, row entity.Village) []model.DistrictTree {
+	district := row.District
+	if district.ID == "" {
+		return districts
+	}
+
+	if len(districts) == 0 || districts[len(districts)-1].ID != district.ID {
+		districts = append(districts, model.DistrictTree{ID: district.ID, Name: district.Name, DisplayName: display.Name(district.Name)})
+	}
+
+	if row.ID != "" {
+		last := &districts[len(districts)-1]
+		last.Villages = append(last.Villages, model.VillageTree{ID: row.ID, Name: row.Name, DisplayName: display.Name(row.Name)})
+	}
+
+	return districts
+}
@@ -3,10 +3,16 @@ package service
 import (
 	"context"
 
+	"github.com/erikrios/ponorogo-regency-api/geojson"
 	"github.com/erikrios/ponorogo-regency-api/model"
 )
 
 type VillageService interface {
-	GetAll(ctx context.Context, keyword string) (responses []model.Village, err error)
+	GetAll(ctx context.Context, keyword string, query model.ListQuery) (responses []model.Village, total int, err error)
 	GetByID(ctx context.Context, id string) (response model.Village, err error)
+	GetByIDs(ctx context.Context, ids []string) (responses []model.Village, missing []string, invalid []string, err error)
+	GetNearest(ctx context.Context, lat, lng float64, limit int) (responses []model.Village, err error)
+	GetByPostalCode(ctx context.Context, code string) (responses []model.Village, err error)
+	GetGeometry(ctx context.Context, id string, tolerance float64) (response geojson.Feature, err error)
+	Stream(ctx context.Context, districtID string, districtKeyword string, fn func(response model.Village) error) (err error)
 }
@@ -2,8 +2,13 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"math"
+	"sort"
 
+	"github.com/erikrios/ponorogo-regency-api/display"
 	"github.com/erikrios/ponorogo-regency-api/entity"
+	"github.com/erikrios/ponorogo-regency-api/geojson"
 	"github.com/erikrios/ponorogo-regency-api/model"
 	"github.com/erikrios/ponorogo-regency-api/repository"
 )
@@ -16,14 +21,26 @@ func NewVillageServiceImpl(repository repository.VillageRepository) *villageServ
 	return &villageServiceImpl{repository: repository}
 }
 
-func (v *villageServiceImpl) GetAll(ctx context.Context, keyword string) (responses []model.Village, err error) {
+func (v *villageServiceImpl) GetAll(ctx context.Context, keyword string, query model.ListQuery) (responses []model.Village, total int, err error) {
+	if err = validateIDs("", "", query.Filters); err != nil {
+		return
+	}
+
+	if err = validateVillageType(query.Filters); err != nil {
+		return
+	}
+
+	if query.Match == model.MatchFuzzy {
+		return v.getAllFuzzy(ctx, keyword, query)
+	}
+
 	var villages []entity.Village
 	var repoErr error
 
 	if keyword == "" {
-		villages, repoErr = v.repository.FindAll(ctx)
+		villages, total, repoErr = v.repository.FindAll(ctx, mapQuery(query))
 	} else {
-		villages, repoErr = v.repository.FindByName(ctx, keyword)
+		villages, total, repoErr = v.repository.FindByName(ctx, keyword, mapQuery(query))
 	}
 
 	if repoErr != nil {
@@ -34,37 +51,207 @@ func (v *villageServiceImpl) GetAll(ctx context.Context, keyword string) (respon
 	responses = make([]model.Village, len(villages))
 
 	for i, village := range villages {
-		responses[i] = v.mapToModel(village)
+		responses[i] = mapVillage(village)
 	}
 	return
 }
 
 func (v *villageServiceImpl) GetByID(ctx context.Context, id string) (response model.Village, err error) {
+	if err = validateIDs(LevelVillage, id, nil); err != nil {
+		return
+	}
+
 	village, repoErr := v.repository.FindByID(ctx, id)
 	if repoErr != nil {
 		err = mapError(repoErr)
 		return
 	}
 
-	response = v.mapToModel(village)
+	response = mapVillage(village)
+	return
+}
+
+func (v *villageServiceImpl) GetByIDs(ctx context.Context, ids []string) (responses []model.Village, missing []string, invalid []string, err error) {
+	ids, invalid = batchIDs(LevelVillage, ids)
+	if len(ids) == 0 {
+		responses, missing = make([]model.Village, 0), make([]string, 0)
+		return
+	}
+
+	villages, repoErr := v.repository.FindByIDs(ctx, ids)
+	if repoErr != nil {
+		err = mapError(repoErr)
+		return
+	}
+
+	villages, missing = orderBatch(ids, villages, func(village entity.Village) string { return village.ID })
+
+	responses = make([]model.Village, len(villages))
+	for i, village := range villages {
+		responses[i] = mapVillage(village)
+	}
+	return
+}
+
+func (v *villageServiceImpl) GetNearest(ctx context.Context, lat, lng float64, limit int) (responses []model.Village, err error) {
+	if err = validatePoint(lat, lng); err != nil {
+		return
+	}
+
+	villages, repoErr := v.repository.FindLocated(ctx)
+	if repoErr != nil {
+		err = mapError(repoErr)
+		return
+	}
+
+	responses = make([]model.Village, len(villages))
+	for i, village := range villages {
+		distance := math.Round(haversine(lat, lng, *village.Latitude, *village.Longitude))
+
+		responses[i] = mapVillage(village)
+		responses[i].Distance = &distance
+	}
+
+	sort.SliceStable(responses, func(i, j int) bool { return *responses[i].Distance < *responses[j].Distance })
+
+	if limit > 0 && len(responses) > limit {
+		responses = responses[:limit]
+	}
 	return
 }
 
-func (v *villageServiceImpl) mapToModel(e entity.Village) model.Village {
+func (v *villageServiceImpl) GetByPostalCode(ctx context.Context, code string) (responses []model.Village, err error) {
+	if err = validatePostalCode(code); err != nil {
+		return
+	}
+
+	villages, repoErr := v.repository.FindByPostalCode(ctx, code)
+	if repoErr != nil {
+		err = mapError(repoErr)
+		return
+	}
+
+	if len(villages) == 0 {
+		err = ErrDataNotFound
+		return
+	}
+
+	responses = make([]model.Village, len(villages))
+	for i, village := range villages {
+		responses[i] = mapVillage(village)
+	}
+	return
+}
+
+func (v *villageServiceImpl) GetGeometry(ctx context.Context, id string, tolerance float64) (response geojson.Feature, err error) {
+	if err = validateIDs(LevelVillage, id, nil); err != nil {
+		return
+	}
+
+	if err = validateTolerance(tolerance); err != nil {
+		return
+	}
+
+	boundary, repoErr := v.repository.FindBoundaryByID(ctx, id)
+	if repoErr != nil {
+		err = mapError(repoErr)
+		return
+	}
+
+	return newFeature(boundary, tolerance)
+}
+
+func (v *villageServiceImpl) Stream(
+	ctx context.Context,
+	districtID string,
+	districtKeyword string,
+	fn func(response model.Village) error,
+) (err error) {
+	if districtID != "" {
+		if err = ValidateID(LevelDistrict, "district_id", districtID); err != nil {
+			return
+		}
+	}
+
+	var fnErr error
+
+	repoErr := v.repository.Stream(ctx, districtID, districtKeyword, func(village entity.Village) error {
+		fnErr = fn(mapVillage(village))
+		return fnErr
+	})
+	if repoErr != nil {
+		if fnErr != nil {
+			err = fnErr
+			return
+		}
+		err = mapError(repoErr)
+	}
+
+	return
+}
+
+func (v *villageServiceImpl) getAllFuzzy(ctx context.Context, keyword string, query model.ListQuery) (responses []model.Village, total int, err error) {
+	repoQuery, err := fuzzyQuery(keyword, query)
+	if err != nil {
+		return
+	}
+
+	villages, _, repoErr := v.repository.FindAll(ctx, repoQuery)
+	if repoErr != nil {
+		err = mapError(repoErr)
+		return
+	}
+
+	villages, scores, total := rankFuzzy(keyword, villages, func(village entity.Village) string { return village.Name }, query)
+
+	responses = make([]model.Village, len(villages))
+	for i, village := range villages {
+		responses[i] = mapVillage(village)
+		responses[i].Score = scores[i]
+	}
+	return
+}
+
+func mapVillage(e entity.Village) model.Village {
 	return model.Village{
-		ID:   e.ID,
-		Name: e.Name,
+		ID:          e.ID,
+		Name:        e.Name,
+		DisplayName: display.Name(e.Name),
+		Type:        e.Type,
+		Latitude:    e.Latitude,
+		Longitude:   e.Longitude,
+		PostalCodes: e.PostalCodes,
 		District: model.District{
-			ID:   e.District.ID,
-			Name: e.District.Name,
+			ID:          e.District.ID,
+			Name:        e.District.Name,
+			DisplayName: display.Name(e.District.Name),
 			Regency: model.Regency{
-				ID:   e.District.Regency.ID,
-				Name: e.District.Regency.Name,
+				ID:          e.District.Regency.ID,
+				Name:        e.District.Regency.Name,
+				DisplayName: display.Name(e.District.Regency.Name),
 				Province: model.Province{
-					ID:   e.District.Regency.Province.ID,
-					Name: e.District.Regency.Province.Name,
+					ID:          e.District.Regency.Province.ID,
+					Name:        e.District.Regency.Province.Name,
+					DisplayName: display.Name(e.District.Regency.Province.Name),
 				},
 			},
 		},
 	}
 }
+
+func validateVillageType(filters map[string]string) error {
+	villageType, ok := filters["type"]
+	if !ok || villageType == model.VillageTypeDesa || villageType == model.VillageTypeKelurahan {
+		return nil
+	}
+
+	return &QueryError{Reason: fmt.Sprintf("type must be %s or %s", model.VillageTypeDesa, model.VillageTypeKelurahan)}
+}
+
+func validatePostalCode(code string) error {
+	if len(code) == 5 && isDigits(code) && code[0] != '0' {
+		return nil
+	}
+
+	return &IDError{Level: "postal", Field: "code", ID: code, Reason: "must consist of 5 digits, not starting with 0"}
+}
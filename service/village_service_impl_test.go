@@ -2,9 +2,11 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 
+	"github.com/erikrios/ponorogo-regency-api/display"
 	"github.com/erikrios/ponorogo-regency-api/entity"
 	"github.com/erikrios/ponorogo-regency-api/model"
 	"github.com/erikrios/ponorogo-regency-api/repository"
@@ -31,6 +33,7 @@ func TestVillageServiceImpl(t *testing.T) {
 			{
 				ID:   "53000101",
 				Name: "Pager",
+				Type: "desa",
 				District: entity.District{
 					ID:   "530101",
 					Name: "Bungkal",
@@ -47,19 +50,25 @@ func TestVillageServiceImpl(t *testing.T) {
 		}
 
 		t.Run("success scenario", func(t *testing.T) {
-			mockRepo.On("FindAll", mock.AnythingOfType(fmt.Sprintf("%T", context.Background()))).Return(
-				func(ctx context.Context) []entity.Village {
+			mockRepo.On("FindAll", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("repository.Query")).Return(
+				func(ctx context.Context, query repository.Query) []entity.Village {
 					return dummyVillages
 				},
-				func(ctx context.Context) error {
+				func(ctx context.Context, query repository.Query) int {
+					return len(dummyVillages)
+				},
+				func(ctx context.Context, query repository.Query) error {
 					return nil
 				},
 			).Once()
-			mockRepo.On("FindByName", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string")).Return(
-				func(ctx context.Context, keyword string) []entity.Village {
+			mockRepo.On("FindByName", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string"), mock.AnythingOfType("repository.Query")).Return(
+				func(ctx context.Context, keyword string, query repository.Query) []entity.Village {
 					return dummyVillages
 				},
-				func(ctx context.Context, keyword string) error {
+				func(ctx context.Context, keyword string, query repository.Query) int {
+					return len(dummyVillages)
+				},
+				func(ctx context.Context, keyword string, query repository.Query) error {
 					return nil
 				},
 			).Once()
@@ -85,27 +94,34 @@ func TestVillageServiceImpl(t *testing.T) {
 
 			for _, testCase := range testCases {
 				t.Run(testCase.name, func(t *testing.T) {
-					got, err := service.GetAll(context.Background(), testCase.keyword)
+					got, total, err := service.GetAll(context.Background(), testCase.keyword, model.ListQuery{})
 					assert.NoError(t, err)
 					assert.ElementsMatch(t, testCase.expected, got)
+					assert.Equal(t, len(testCase.expected), total)
 				})
 			}
 		})
 
 		t.Run("failed scenario", func(t *testing.T) {
-			mockRepo.On("FindAll", mock.AnythingOfType(fmt.Sprintf("%T", context.Background()))).Return(
-				func(ctx context.Context) []entity.Village {
+			mockRepo.On("FindAll", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("repository.Query")).Return(
+				func(ctx context.Context, query repository.Query) []entity.Village {
 					return []entity.Village{}
 				},
-				func(ctx context.Context) error {
+				func(ctx context.Context, query repository.Query) int {
+					return 0
+				},
+				func(ctx context.Context, query repository.Query) error {
 					return repository.ErrDatabase
 				},
 			).Once()
-			mockRepo.On("FindByName", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string")).Return(
-				func(ctx context.Context, keyword string) []entity.Village {
+			mockRepo.On("FindByName", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string"), mock.AnythingOfType("repository.Query")).Return(
+				func(ctx context.Context, keyword string, query repository.Query) []entity.Village {
 					return []entity.Village{}
 				},
-				func(ctx context.Context, keyword string) error {
+				func(ctx context.Context, keyword string, query repository.Query) int {
+					return 0
+				},
+				func(ctx context.Context, keyword string, query repository.Query) error {
 					return repository.ErrDatabase
 				},
 			).Once()
@@ -131,27 +147,75 @@ func TestVillageServiceImpl(t *testing.T) {
 
 			for _, testCase := range testCases {
 				t.Run(testCase.name, func(t *testing.T) {
-					_, err := service.GetAll(context.Background(), testCase.keyword)
+					_, _, err := service.GetAll(context.Background(), testCase.keyword, model.ListQuery{})
 					assert.ErrorIs(t, err, testCase.expected)
 				})
 			}
 		})
+		t.Run("it should return QueryError, when given unknown type filter", func(t *testing.T) {
+			var service VillageService = NewVillageServiceImpl(&mocks.VillageRepository{})
+
+			_, _, err := service.GetAll(context.Background(), "", model.ListQuery{Filters: map[string]string{"type": "kota"}})
+
+			var queryErr *QueryError
+			if assert.ErrorAs(t, err, &queryErr) {
+				assert.Equal(t, "type must be desa or kelurahan", queryErr.Reason)
+			}
+		})
+
+		t.Run("fuzzy scenario", func(t *testing.T) {
+			candidates := []entity.Village{
+				{ID: "3502010001", Name: "BAOSANKIDUL"},
+				{ID: "3502010002", Name: "WONODADI"},
+				{ID: "3502010003", Name: "BAOSANLOR"},
+			}
+
+			mockRepo.On("FindAll", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), repository.Query{Expand: []string{}}).Return(
+				func(ctx context.Context, query repository.Query) []entity.Village {
+					return candidates
+				},
+				func(ctx context.Context, query repository.Query) int {
+					return len(candidates)
+				},
+				func(ctx context.Context, query repository.Query) error {
+					return nil
+				},
+			).Once()
+
+			var service VillageService = NewVillageServiceImpl(mockRepo)
+
+			t.Run("it should return the similar villages with their score, when given a misspelled keyword", func(t *testing.T) {
+				got, total, err := service.GetAll(context.Background(), "Baosan kidul", model.ListQuery{Page: 1, Limit: 50, Expand: []string{}, Match: model.MatchFuzzy})
+				assert.NoError(t, err)
+				assert.Equal(t, 2, total)
+				if assert.Len(t, got, 2) {
+					assert.Equal(t, "3502010001", got[0].ID)
+					assert.Equal(t, "3502010003", got[1].ID)
+					assert.Greater(t, got[0].Score, got[1].Score)
+				}
+			})
+
+			t.Run("it should return ErrInvalidQuery, when given sort", func(t *testing.T) {
+				_, _, err := service.GetAll(context.Background(), "Baosan kidul", model.ListQuery{Sort: "name", Match: model.MatchFuzzy})
+				assert.ErrorIs(t, err, ErrInvalidQuery)
+			})
+		})
 	})
 
 	t.Run("TestGetByID", func(t *testing.T) {
 		mockRepo := &mocks.VillageRepository{}
 
 		dummyVillage := entity.Village{
-			ID:   "53000101",
+			ID:   "3502010001",
 			Name: "Pager",
 			District: entity.District{
-				ID:   "530101",
+				ID:   "3502010",
 				Name: "Bungkal",
 				Regency: entity.Regency{
-					ID:   "5301",
+					ID:   "3502",
 					Name: "Ponorogo",
 					Province: entity.Province{
-						ID:   "53",
+						ID:   "35",
 						Name: "Jawa Timur",
 					},
 				},
@@ -218,7 +282,7 @@ func TestVillageServiceImpl(t *testing.T) {
 				},
 				{
 					name:     "it should return ErrDataNotFound instance, when ID is not match and error happened",
-					id:       "9090",
+					id:       "3502010999",
 					expected: ErrDataNotFound,
 				},
 			}
@@ -233,21 +297,442 @@ func TestVillageServiceImpl(t *testing.T) {
 			}
 		})
 	})
+
+	t.Run("TestGetByIDs", func(t *testing.T) {
+		mockRepo := &mocks.VillageRepository{}
+
+		district := entity.District{
+			ID:   "3502010",
+			Name: "NGRAYUN",
+			Regency: entity.Regency{
+				ID:       "3502",
+				Name:     "KABUPATEN PONOROGO",
+				Province: entity.Province{ID: "35", Name: "JAWA TIMUR"},
+			},
+		}
+		dummyVillages := []entity.Village{
+			{ID: "3502010001", Name: "BAOSANKIDUL", District: district},
+			{ID: "3502010002", Name: "WONODADI", District: district},
+		}
+
+		t.Run("success scenario", func(t *testing.T) {
+			mockRepo.On("FindByIDs", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), []string{"3502010002", "3502010999", "3502010001"}).Return(
+				func(ctx context.Context, ids []string) []entity.Village {
+					return dummyVillages
+				},
+				func(ctx context.Context, ids []string) error {
+					return nil
+				},
+			).Once()
+
+			t.Run("it should return the villages in the requested order with the missing and malformed IDs, when there is no error", func(t *testing.T) {
+				var service VillageService = NewVillageServiceImpl(mockRepo)
+
+				got, missing, invalid, err := service.GetByIDs(context.Background(), []string{"3502010002", "3502010", "3502010999", "3502010001", "3502010002"})
+				assert.NoError(t, err)
+				assert.Equal(t, []model.Village{
+					mapToVillageModel(dummyVillages[1]),
+					mapToVillageModel(dummyVillages[0]),
+				}, got)
+				assert.Equal(t, []string{"3502010999"}, missing)
+				assert.Equal(t, []string{"3502010"}, invalid)
+			})
+		})
+
+		t.Run("failed scenario", func(t *testing.T) {
+			mockRepo.On("FindByIDs", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("[]string")).Return(
+				func(ctx context.Context, ids []string) []entity.Village {
+					return nil
+				},
+				func(ctx context.Context, ids []string) error {
+					return repository.ErrDatabase
+				},
+			).Once()
+
+			testCases := []struct {
+				name     string
+				ids      []string
+				expected error
+			}{
+				{
+					name:     "it should return ErrRepository instance, when error happened",
+					ids:      []string{"3502010001"},
+					expected: ErrRepository,
+				},
+			}
+
+			var service VillageService = NewVillageServiceImpl(mockRepo)
+
+			for _, testCase := range testCases {
+				t.Run(testCase.name, func(t *testing.T) {
+					_, _, _, err := service.GetByIDs(context.Background(), testCase.ids)
+					assert.ErrorIs(t, err, testCase.expected)
+				})
+			}
+		})
+	})
+
+	t.Run("TestGetNearest", func(t *testing.T) {
+		mockRepo := &mocks.VillageRepository{}
+
+		district := entity.District{
+			ID:   "3502170",
+			Name: "PONOROGO",
+			Regency: entity.Regency{
+				ID:       "3502",
+				Name:     "KABUPATEN PONOROGO",
+				Province: entity.Province{ID: "35", Name: "JAWA TIMUR"},
+			},
+		}
+		coordinates := []float64{-7.8700, 111.4600, -7.8651, 111.4696, -7.8900, 111.4800}
+		dummyVillages := []entity.Village{
+			{ID: "3502170001", Name: "PAJU", Type: "kelurahan", Latitude: &coordinates[0], Longitude: &coordinates[1], District: district},
+			{ID: "3502170002", Name: "BROTONEGARAN", Type: "kelurahan", Latitude: &coordinates[2], Longitude: &coordinates[3], District: district},
+			{ID: "3502170003", Name: "PAKUNDEN", Type: "kelurahan", Latitude: &coordinates[4], Longitude: &coordinates[5], District: district},
+		}
+
+		t.Run("success scenario", func(t *testing.T) {
+			mockRepo.On("FindLocated", mock.AnythingOfType(fmt.Sprintf("%T", context.Background()))).Return(
+				func(ctx context.Context) []entity.Village {
+					return dummyVillages
+				},
+				func(ctx context.Context) error {
+					return nil
+				},
+			).Once()
+
+			t.Run("it should return the nearest villages first with their distance, when there is no error", func(t *testing.T) {
+				var service VillageService = NewVillageServiceImpl(mockRepo)
+
+				got, err := service.GetNearest(context.Background(), -7.8651, 111.4696, 2)
+				assert.NoError(t, err)
+				if assert.Len(t, got, 2) {
+					assert.Equal(t, "3502170002", got[0].ID)
+					assert.Equal(t, 0.0, *got[0].Distance)
+					assert.Equal(t, "3502170001", got[1].ID)
+					assert.Equal(t, 1190.0, *got[1].Distance)
+				}
+			})
+		})
+
+		t.Run("failed scenario", func(t *testing.T) {
+			mockRepo.On("FindLocated", mock.AnythingOfType(fmt.Sprintf("%T", context.Background()))).Return(
+				func(ctx context.Context) []entity.Village {
+					return nil
+				},
+				func(ctx context.Context) error {
+					return repository.ErrDatabase
+				},
+			).Once()
+
+			testCases := []struct {
+				name     string
+				lat      float64
+				lng      float64
+				expected error
+			}{
+				{
+					name:     "it should return ErrInvalidQuery instance, when latitude is out of range",
+					lat:      -97.8651,
+					lng:      111.4696,
+					expected: ErrInvalidQuery,
+				},
+				{
+					name:     "it should return ErrInvalidQuery instance, when longitude is out of range",
+					lat:      -7.8651,
+					lng:      211.4696,
+					expected: ErrInvalidQuery,
+				},
+				{
+					name:     "it should return ErrRepository instance, when error happened",
+					lat:      -7.8651,
+					lng:      111.4696,
+					expected: ErrRepository,
+				},
+			}
+
+			var service VillageService = NewVillageServiceImpl(mockRepo)
+
+			for _, testCase := range testCases {
+				t.Run(testCase.name, func(t *testing.T) {
+					_, err := service.GetNearest(context.Background(), testCase.lat, testCase.lng, 10)
+					assert.ErrorIs(t, err, testCase.expected)
+				})
+			}
+		})
+	})
+
+	t.Run("TestGetByPostalCode", func(t *testing.T) {
+		mockRepo := &mocks.VillageRepository{}
+
+		postalCode := "63472"
+		dummyVillages := []entity.Village{
+			{ID: "3502080003", Name: "TOTOKAN", Type: "desa", PostalCodes: []string{postalCode}, District: entity.District{ID: "3502080", Name: "MLARAK"}},
+			{ID: "3502080007", Name: "NGLUMPANG", Type: "desa", PostalCodes: []string{postalCode}, District: entity.District{ID: "3502080", Name: "MLARAK"}},
+		}
+
+		t.Run("success scenario", func(t *testing.T) {
+			mockRepo.On("FindByPostalCode", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), postalCode).Return(
+				func(ctx context.Context, code string) []entity.Village {
+					return dummyVillages
+				},
+				func(ctx context.Context, code string) error {
+					return nil
+				},
+			).Once()
+
+			t.Run("it should return every village served by the postal code, when there is no error", func(t *testing.T) {
+				var service VillageService = NewVillageServiceImpl(mockRepo)
+
+				got, err := service.GetByPostalCode(context.Background(), postalCode)
+				assert.NoError(t, err)
+				if assert.Len(t, got, 2) {
+					assert.Equal(t, "3502080003", got[0].ID)
+					assert.Equal(t, []string{postalCode}, got[0].PostalCodes)
+					assert.Equal(t, "Mlarak", got[1].District.DisplayName)
+				}
+			})
+		})
+
+		t.Run("failed scenario", func(t *testing.T) {
+			mockRepo.On("FindByPostalCode", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), "63499").Return(
+				func(ctx context.Context, code string) []entity.Village {
+					return []entity.Village{}
+				},
+				func(ctx context.Context, code string) error {
+					return nil
+				},
+			).Once()
+			mockRepo.On("FindByPostalCode", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), postalCode).Return(
+				func(ctx context.Context, code string) []entity.Village {
+					return nil
+				},
+				func(ctx context.Context, code string) error {
+					return repository.ErrDatabase
+				},
+			).Once()
+
+			testCases := []struct {
+				name     string
+				code     string
+				expected error
+			}{
+				{
+					name:     "it should return ErrInvalidID instance, when the code isn't made of 5 digits",
+					code:     "6347",
+					expected: ErrInvalidID,
+				},
+				{
+					name:     "it should return ErrInvalidID instance, when the code starts with 0",
+					code:     "03472",
+					expected: ErrInvalidID,
+				},
+				{
+					name:     "it should return ErrDataNotFound instance, when the code serves no village",
+					code:     "63499",
+					expected: ErrDataNotFound,
+				},
+				{
+					name:     "it should return ErrRepository instance, when error happened",
+					code:     postalCode,
+					expected: ErrRepository,
+				},
+			}
+
+			var service VillageService = NewVillageServiceImpl(mockRepo)
+
+			for _, testCase := range testCases {
+				t.Run(testCase.name, func(t *testing.T) {
+					_, err := service.GetByPostalCode(context.Background(), testCase.code)
+					assert.ErrorIs(t, err, testCase.expected)
+				})
+			}
+		})
+	})
+
+	t.Run("TestGetGeometry", func(t *testing.T) {
+		mockRepo := &mocks.VillageRepository{}
+
+		t.Run("success scenario", func(t *testing.T) {
+			mockRepo.On("FindBoundaryByID", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), "3502010001").Return(
+				func(ctx context.Context, id string) entity.Boundary {
+					return entity.Boundary{ID: id, Name: "BAOSANKIDUL"}
+				},
+				func(ctx context.Context, id string) error {
+					return nil
+				},
+			).Once()
+
+			t.Run("it should return a feature without geometry, when the boundary isn't known", func(t *testing.T) {
+				var service VillageService = NewVillageServiceImpl(mockRepo)
+
+				got, err := service.GetGeometry(context.Background(), "3502010001", 0)
+				assert.NoError(t, err)
+				assert.Equal(t, "3502010001", got.ID)
+				assert.Equal(t, "Baosankidul", got.Properties["display_name"])
+				assert.Nil(t, got.Geometry)
+			})
+		})
+
+		t.Run("failed scenario", func(t *testing.T) {
+			mockRepo.On("FindBoundaryByID", mock.AnythingOfType(fmt.Sprintf("%T", context.Background())), mock.AnythingOfType("string")).Return(
+				func(ctx context.Context, id string) entity.Boundary {
+					return entity.Boundary{}
+				},
+				func(ctx context.Context, id string) error {
+					return repository.ErrQueryNotFound
+				},
+			).Once()
+
+			testCases := []struct {
+				name      string
+				id        string
+				tolerance float64
+				expected  error
+			}{
+				{name: "it should return ErrInvalidID instance, when given malformed ID", id: "3502010", expected: ErrInvalidID},
+				{name: "it should return ErrInvalidQuery instance, when given negative tolerance", id: "3502010001", tolerance: -0.5, expected: ErrInvalidQuery},
+				{name: "it should return ErrDataNotFound instance, when given ID not found", id: "3502010999", expected: ErrDataNotFound},
+			}
+
+			var service VillageService = NewVillageServiceImpl(mockRepo)
+
+			for _, testCase := range testCases {
+				t.Run(testCase.name, func(t *testing.T) {
+					_, err := service.GetGeometry(context.Background(), testCase.id, testCase.tolerance)
+					assert.ErrorIs(t, err, testCase.expected)
+				})
+			}
+		})
+	})
+
+	t.Run("TestStream", func(t *testing.T) {
+		mockRepo := &mocks.VillageRepository{}
+
+		dummyVillages := []entity.Village{
+			{
+				ID:   "3502010001",
+				Name: "WONODADI",
+				District: entity.District{
+					ID:   "3502010",
+					Name: "NGRAYUN",
+					Regency: entity.Regency{
+						ID:   "3502",
+						Name: "KABUPATEN PONOROGO",
+						Province: entity.Province{
+							ID:   "35",
+							Name: "JAWA TIMUR",
+						},
+					},
+				},
+			},
+		}
+
+		t.Run("success scenario", func(t *testing.T) {
+			mockRepo.On(
+				"Stream",
+				mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+				dummyVillages[0].District.ID,
+				"",
+				mock.AnythingOfType("func(entity.Village) error"),
+			).Return(
+				func(ctx context.Context, districtID string, districtKeyword string, fn func(entity.Village) error) error {
+					for _, village := range dummyVillages {
+						if err := fn(village); err != nil {
+							return err
+						}
+					}
+					return nil
+				},
+			).Once()
+
+			t.Run("it should hand every village to the callback, when there is no error", func(t *testing.T) {
+				var service VillageService = NewVillageServiceImpl(mockRepo)
+
+				got := make([]model.Village, 0)
+				err := service.Stream(context.Background(), dummyVillages[0].District.ID, "", func(response model.Village) error {
+					got = append(got, response)
+					return nil
+				})
+				assert.NoError(t, err)
+				assert.Equal(t, mapToVillagesModel(dummyVillages), got)
+			})
+		})
+
+		t.Run("failed scenario", func(t *testing.T) {
+			callbackErr := errors.New("send failed")
+
+			mockRepo.On(
+				"Stream",
+				mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+				"",
+				mock.AnythingOfType("string"),
+				mock.AnythingOfType("func(entity.Village) error"),
+			).Return(
+				func(ctx context.Context, districtID string, districtKeyword string, fn func(entity.Village) error) error {
+					if districtKeyword != "" {
+						return repository.ErrDatabase
+					}
+					for _, village := range dummyVillages {
+						if err := fn(village); err != nil {
+							return err
+						}
+					}
+					return nil
+				},
+			).Twice()
+
+			testCases := []struct {
+				name     string
+				keyword  string
+				expected error
+			}{
+				{
+					name:     "it should return ErrRepository instance, when repository error happened",
+					keyword:  dummyVillages[0].District.Name,
+					expected: ErrRepository,
+				},
+				{
+					name:     "it should return the callback error, when the callback fails",
+					keyword:  "",
+					expected: callbackErr,
+				},
+			}
+
+			var service VillageService = NewVillageServiceImpl(mockRepo)
+
+			for _, testCase := range testCases {
+				t.Run(testCase.name, func(t *testing.T) {
+					err := service.Stream(context.Background(), "", testCase.keyword, func(response model.Village) error {
+						return callbackErr
+					})
+					assert.ErrorIs(t, err, testCase.expected)
+				})
+			}
+		})
+	})
 }
 
 func mapToVillageModel(e entity.Village) model.Village {
 	return model.Village{
-		ID:   e.ID,
-		Name: e.Name,
+		ID:          e.ID,
+		Name:        e.Name,
+		DisplayName: display.Name(e.Name),
+		Type:        e.Type,
+		Latitude:    e.Latitude,
+		Longitude:   e.Longitude,
 		District: model.District{
-			ID:   e.District.ID,
-			Name: e.District.Name,
+			ID:          e.District.ID,
+			Name:        e.District.Name,
+			DisplayName: display.Name(e.District.Name),
 			Regency: model.Regency{
-				ID:   e.District.Regency.ID,
-				Name: e.District.Regency.Name,
+				ID:          e.District.Regency.ID,
+				Name:        e.District.Regency.Name,
+				DisplayName: display.Name(e.District.Regency.Name),
 				Province: model.Province{
-					ID:   e.District.Regency.Province.ID,
-					Name: e.District.Regency.Province.Name,
+					ID:          e.District.Regency.Province.ID,
+					Name:        e.District.Regency.Province.Name,
+					DisplayName: display.Name(e.District.Regency.Province.Name),
 				},
 			},
 		},
@@ -0,0 +1,29 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+type Refresher interface {
+	Refresh(ctx context.Context) (err error)
+}
+
+// Watch keeps the previous index when a refresh fails.
+func Watch(ctx context.Context, name string, service Refresher, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if err := service.Refresh(ctx); err != nil {
+			log.Printf("%s index refresh failed: %s\n", name, err.Error())
+		}
+	}
+}